@@ -0,0 +1,129 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/vast-data/go-vast-client/core"
+)
+
+// ChangeResult holds the outcome of applying a single change.
+type ChangeResult struct {
+	Change *Change
+	// Record is the object returned by the API (or the final task record when waiting).
+	Record core.Record
+	Err    error
+}
+
+// Result contains one ChangeResult per applied change, in plan order.
+type Result []ChangeResult
+
+// Failed returns changes that failed.
+func (r Result) Failed() Result {
+	var out Result
+	for _, item := range r {
+		if item.Err != nil {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// Apply executes the plan in order using the resources' CRUD methods. References are
+// resolved as their targets get created. Apply stops at the first failing change, since
+// later changes may depend on it, and returns the results of the changes attempted so far.
+// Deleting an object that is already gone is not an error.
+func (p *Plan) Apply(ctx context.Context) (Result, error) {
+	var result Result
+	for i := range p.Changes {
+		change := &p.Changes[i]
+		if change.Action == ActionNoop {
+			continue
+		}
+		record, err := p.applyChange(ctx, change)
+		result = append(result, ChangeResult{Change: change, Record: record, Err: err})
+		if err != nil {
+			return result, fmt.Errorf("%s: %w", change, err)
+		}
+	}
+	return result, nil
+}
+
+func (p *Plan) applyChange(ctx context.Context, change *Change) (core.Record, error) {
+	resource := p.rest.GetResourceMap()[change.Kind]
+	var (
+		record core.Record
+		err    error
+	)
+	switch change.Action {
+	case ActionCreate:
+		var body core.Params
+		if body, err = p.resolveRefs(ctx, change.Manifest.createBody(), true); err != nil {
+			return nil, err
+		}
+		record, err = resource.CreateWithContext(ctx, body)
+	case ActionUpdate:
+		var spec core.Params
+		if spec, err = p.resolveRefs(ctx, change.Manifest.Spec, true); err != nil {
+			return nil, err
+		}
+		body := core.Params{}
+		for _, entry := range change.Diff {
+			field := topLevelField(entry.Path)
+			body[field] = spec[field]
+		}
+		record, err = resource.UpdateWithContext(ctx, change.ID(), body)
+	case ActionDelete:
+		record, err = resource.DeleteByIdWithContext(ctx, change.ID(), nil, nil)
+		if core.ExpectStatusCodes(err, http.StatusNotFound) {
+			return nil, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported action %q", change.Action)
+	}
+	if err != nil {
+		return nil, err
+	}
+	asyncResult := core.MaybeAsyncResultFromRecord(ctx, record, p.rest)
+	if change.Action == ActionCreate && change.Manifest.Name() != "" {
+		// A bare task record carries the task id, not the id of the created object:
+		// wait for the task and read the object back so later references resolve to it.
+		if _, nested := record["async_task"]; asyncResult != nil && !nested {
+			return p.awaitCreated(ctx, change, asyncResult)
+		}
+		p.ids[change.Manifest.Ref()] = record["id"]
+	}
+	if asyncResult != nil && p.opts.WaitTimeout > 0 {
+		return asyncResult.Wait(p.opts.WaitTimeout)
+	}
+	return record, nil
+}
+
+// awaitCreated waits for the task that creates change's object and records the id of the created object.
+func (p *Plan) awaitCreated(ctx context.Context, change *Change, asyncResult *core.AsyncResult) (core.Record, error) {
+	task, err := asyncResult.Wait(p.opts.WaitTimeout)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := p.resolveRefs(ctx, change.Manifest.Identity, true)
+	if err != nil {
+		return nil, err
+	}
+	live, err := p.rest.GetResourceMap()[change.Kind].GetWithContext(ctx, identity)
+	if err != nil {
+		return nil, fmt.Errorf("created by async task %d but cannot be read back: %w", asyncResult.TaskId, err)
+	}
+	p.ids[change.Manifest.Ref()] = live["id"]
+	return task, nil
+}
+
+// topLevelField returns the first segment of a diff path: "quota.hard_limit" -> "quota", "hosts[0]" -> "hosts".
+func topLevelField(path string) string {
+	for i, r := range path {
+		if r == '.' || r == '[' {
+			return path[:i]
+		}
+	}
+	return path
+}
@@ -0,0 +1,153 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/vast-data/go-vast-client/core"
+)
+
+func TestPlan_ApplyCreatesWithResolvedRefs(t *testing.T) {
+	srv := newTestServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	plan, err := BuildPlan(context.Background(), rest, labManifests(), nil)
+	if err != nil {
+		t.Fatalf("BuildPlan: %v", err)
+	}
+	result, err := plan.Apply(context.Background())
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if len(result) != 3 || len(result.Failed()) != 0 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	want := []string{"POST tenants", "POST viewpolicies", "POST views"}
+	if strings.Join(srv.requests, ", ") != strings.Join(want, ", ") {
+		t.Fatalf("requests = %v, want %v", srv.requests, want)
+	}
+	tenantID := fmt.Sprintf("%v", srv.bodies[0]["id"])
+	if got := fmt.Sprintf("%v", srv.bodies[1]["tenant_id"]); got != tenantID {
+		t.Errorf("policy tenant_id = %s, want %s", got, tenantID)
+	}
+	if srv.bodies[0]["name"] != "lab" {
+		t.Errorf("identity must be part of the create body, got %v", srv.bodies[0])
+	}
+	policyID := fmt.Sprintf("%v", srv.bodies[1]["id"])
+	if got := fmt.Sprintf("%v", srv.bodies[2]["policy_id"]); got != policyID {
+		t.Errorf("view policy_id = %s, want %s", got, policyID)
+	}
+
+	// A second plan against the new state is empty.
+	plan, err = BuildPlan(context.Background(), rest, labManifests(), nil)
+	if err != nil {
+		t.Fatalf("BuildPlan: %v", err)
+	}
+	if plan.HasChanges() {
+		t.Errorf("expected no changes after apply, got\n%s", plan)
+	}
+}
+
+func TestPlan_ApplyAsyncCreateRecordsObjectID(t *testing.T) {
+	srv := newTestServer()
+	srv.asyncCreates = map[string]bool{"tenants": true}
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+	rest.resourceMap[core.VTaskKey] = core.NewVastResource("vtasks", core.VTaskKey, rest, core.NewResourceOps(core.L, core.R), nil)
+
+	plan, err := BuildPlan(context.Background(), rest, labManifests(), nil)
+	if err != nil {
+		t.Fatalf("BuildPlan: %v", err)
+	}
+	result, err := plan.Apply(context.Background())
+	if err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if len(result.Failed()) != 0 {
+		t.Fatalf("unexpected failures %+v", result.Failed())
+	}
+	tenantID := fmt.Sprintf("%v", srv.bodies[0]["id"])
+	if got := fmt.Sprintf("%v", srv.bodies[1]["tenant_id"]); got != tenantID {
+		t.Errorf("policy tenant_id = %s, want the created tenant %s, not the task id", got, tenantID)
+	}
+	if got := fmt.Sprintf("%v", plan.ids["Tenant/lab"]); got != tenantID {
+		t.Errorf("recorded id = %s, want %s", got, tenantID)
+	}
+}
+
+func TestPlan_ApplyUpdateSendsChangedFields(t *testing.T) {
+	srv := newTestServer()
+	srv.add("tenants", map[string]any{"id": 1, "name": "lab"})
+	srv.add("viewpolicies", map[string]any{"id": 2, "name": "lab-policy", "flavor": "NFS", "tenant_id": 1})
+	srv.add("views", map[string]any{"id": 3, "path": "/lab", "protocols": []any{"NFS", "S3"}, "policy_id": 2})
+	srv.add("views", map[string]any{"id": 4, "path": "/old", "name": "gitops-old"})
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	plan, err := BuildPlan(context.Background(), rest, labManifests(), &Options{
+		Prune:  true,
+		Marker: &Marker{Field: "name", Prefix: "gitops-"},
+	})
+	if err != nil {
+		t.Fatalf("BuildPlan: %v", err)
+	}
+	if _, err := plan.Apply(context.Background()); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	want := []string{"PATCH views/3", "DELETE views/4"}
+	if strings.Join(srv.requests, ", ") != strings.Join(want, ", ") {
+		t.Fatalf("requests = %v, want %v", srv.requests, want)
+	}
+	if body := srv.bodies[0]; len(body) != 1 || body["protocols"] == nil {
+		t.Errorf("update body must contain only changed fields, got %v", body)
+	}
+
+	// Deleting an object that is already gone is not an error.
+	delete(srv.data["views"], 4)
+	plan.Changes = plan.Changes[len(plan.Changes)-1:]
+	if _, err := plan.Apply(context.Background()); err != nil {
+		t.Errorf("Apply: %v", err)
+	}
+}
+
+func TestPlan_ApplyStopsOnError(t *testing.T) {
+	srv := newTestServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	manifests := []Manifest{
+		{Kind: "Tenant", Identity: core.Params{"name": "a"}, Spec: core.Params{"x": core.Params{refKey: "Tenant/missing"}}},
+		{Kind: "Tenant", Identity: core.Params{"name": "b"}},
+	}
+	plan, err := BuildPlan(context.Background(), rest, manifests, nil)
+	if err != nil {
+		t.Fatalf("BuildPlan: %v", err)
+	}
+	result, err := plan.Apply(context.Background())
+	if err == nil || !strings.Contains(err.Error(), `resolve reference "Tenant/missing"`) {
+		t.Fatalf("expected unresolved reference error, got %v", err)
+	}
+	if len(result) != 1 || len(result.Failed()) != 1 || len(srv.requests) != 0 {
+		t.Errorf("apply must stop at the first failure, result %+v, requests %v", result, srv.requests)
+	}
+}
+
+func TestTopLevelField(t *testing.T) {
+	for path, want := range map[string]string{
+		"name":             "name",
+		"quota.hard_limit": "quota",
+		"hosts[0]":         "hosts",
+	} {
+		if got := topLevelField(path); got != want {
+			t.Errorf("topLevelField(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,354 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/vast-data/go-vast-client/core"
+	"github.com/vast-data/go-vast-client/openapi_schema"
+	"gopkg.in/yaml.v3"
+)
+
+// BundleVersion is the version of the bundle format written by Export.
+const BundleVersion = 1
+
+// DefaultExportKinds are the resource types exported when ExportOptions.Kinds is empty,
+// in dependency order.
+var DefaultExportKinds = []string{
+	"Tenant",
+	"QosPolicy",
+	"ViewPolicy",
+	"ProtectionPolicy",
+	"View",
+	"Quota",
+	"S3LifeCycleRule",
+	"User",
+}
+
+// serverManagedFields are populated by the server and never exported, even when the
+// request schema accepts them (e.g. "guid" for protection policies). Cluster references
+// are dropped as well since the target is a different cluster.
+var serverManagedFields = map[string]struct{}{
+	"id":         {},
+	"guid":       {},
+	"url":        {},
+	"created":    {},
+	"updated":    {},
+	"modified":   {},
+	"sync_time":  {},
+	"cluster":    {},
+	"cluster_id": {},
+}
+
+// Bundle is a portable export of cluster configuration. Objects are manifests whose
+// foreign keys are {"$ref": "Kind/name"} references, so the bundle can be applied to
+// another cluster with Import.
+type Bundle struct {
+	Version    int       `json:"version" yaml:"version"`
+	Source     string    `json:"source,omitempty" yaml:"source,omitempty"`
+	ExportedAt time.Time `json:"exported_at" yaml:"exported_at"`
+	// Objects are in dependency order.
+	Objects []Manifest `json:"objects" yaml:"objects"`
+	// IDs maps "Kind/name" references to the ids of the objects on the source cluster.
+	IDs map[string]any `json:"ids,omitempty" yaml:"ids,omitempty"`
+	// Warnings lists objects and fields that could not be exported.
+	Warnings []string `json:"warnings,omitempty" yaml:"warnings,omitempty"`
+}
+
+// ExportOptions controls Export.
+type ExportOptions struct {
+	// Kinds are the resource types to export, in dependency order (default DefaultExportKinds).
+	Kinds []string
+	// Params optionally filters the objects of a kind, e.g. {"Tenant": {"name": "lab"}}.
+	Params map[string]core.Params
+	// PageSize for the iterators (0 uses the session page size).
+	PageSize int
+	// StripFields are additional fields to omit, either "field" or "Kind.field".
+	StripFields []string
+}
+
+// Export walks the selected resources and returns a portable bundle. Only fields accepted
+// by the create/update request schema are kept, minus server-managed fields (ids, guids,
+// timestamps, usage counters). Foreign keys listed in the relation registry are replaced
+// with {"$ref": "Kind/name"} references; referenced objects outside the exported kinds are
+// looked up by id to get their name.
+//
+// Objects are identified by name (or path when they have no name). When several objects of
+// a kind share a name, their identity also includes a reference to their tenant and
+// references to them are dropped with a warning.
+func Export(ctx context.Context, rest core.VastRest, opts *ExportOptions) (*Bundle, error) {
+	if opts == nil {
+		opts = &ExportOptions{}
+	}
+	kinds := opts.Kinds
+	if len(kinds) == 0 {
+		kinds = DefaultExportKinds
+	}
+	resources := rest.GetResourceMap()
+	e := &exporter{
+		ctx:       ctx,
+		rest:      rest,
+		names:     map[string]map[string]string{},
+		ambiguous: map[string]bool{},
+		strip:     map[string]struct{}{},
+	}
+	for _, field := range opts.StripFields {
+		e.strip[field] = struct{}{}
+	}
+
+	listed := make([]core.RecordSet, len(kinds))
+	for i, kind := range kinds {
+		resource, ok := resources[kind]
+		if !ok {
+			return nil, fmt.Errorf("export: unknown kind %q", kind)
+		}
+		params := opts.Params[kind]
+		if params == nil {
+			params = core.Params{}
+		}
+		for record, err := range core.Records(core.NewResourceIterator(ctx, resource, params, opts.PageSize)) {
+			if err != nil {
+				return nil, fmt.Errorf("export %s: %w", kind, err)
+			}
+			listed[i] = append(listed[i], record)
+			e.setName(kind, record["id"], recordName(record))
+		}
+	}
+
+	bundle := &Bundle{
+		Version:    BundleVersion,
+		ExportedAt: time.Now().UTC(),
+		IDs:        map[string]any{},
+	}
+	if config := rest.GetSession().GetConfig(); config != nil {
+		bundle.Source = config.Host
+	}
+	for i, kind := range kinds {
+		seen := map[string]bool{}
+		for _, record := range listed[i] {
+			if name := recordName(record); name != "" && seen[name] {
+				e.ambiguous[kind+"/"+name] = true
+			} else {
+				seen[name] = true
+			}
+		}
+	}
+	for i, kind := range kinds {
+		writable := writableFields(resources[kind].GetResourcePath())
+		for _, record := range listed[i] {
+			manifest, ok := e.manifest(kind, record, writable)
+			if !ok {
+				continue
+			}
+			bundle.Objects = append(bundle.Objects, manifest)
+			if name := manifest.Name(); name != "" {
+				bundle.IDs[manifest.Ref()] = record["id"]
+			}
+		}
+	}
+	bundle.Warnings = e.warnings
+	return bundle, nil
+}
+
+// exporter converts records to manifests, caching the names of referenced objects.
+type exporter struct {
+	ctx       context.Context
+	rest      core.VastRest
+	names     map[string]map[string]string // kind -> id -> name ("" when the object has no name)
+	ambiguous map[string]bool              // "Kind/name" shared by several exported objects
+	strip     map[string]struct{}
+	warnings  []string
+}
+
+func (e *exporter) warnf(format string, args ...any) {
+	e.warnings = append(e.warnings, fmt.Sprintf(format, args...))
+}
+
+func (e *exporter) setName(kind string, id any, name string) {
+	if e.names[kind] == nil {
+		e.names[kind] = map[string]string{}
+	}
+	e.names[kind][fmt.Sprintf("%v", normalizeID(id))] = name
+}
+
+// refTo returns a {"$ref": "Kind/name"} reference to the object of kind with the given id.
+func (e *exporter) refTo(kind string, id any) (core.Params, error) {
+	key := fmt.Sprintf("%v", normalizeID(id))
+	name, ok := e.names[kind][key]
+	if !ok {
+		resource, known := e.rest.GetResourceMap()[kind]
+		if !known {
+			return nil, fmt.Errorf("unknown kind %q", kind)
+		}
+		record, err := resource.GetByIdWithContext(e.ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		name = recordName(record)
+		e.setName(kind, id, name)
+	}
+	if name == "" {
+		return nil, fmt.Errorf("%s %s has no name", kind, key)
+	}
+	ref := kind + "/" + name
+	if e.ambiguous[ref] {
+		return nil, fmt.Errorf("reference %s is ambiguous", ref)
+	}
+	return core.Params{refKey: ref}, nil
+}
+
+func (e *exporter) manifest(kind string, record core.Record, writable map[string]bool) (Manifest, bool) {
+	manifest := Manifest{Kind: kind, Identity: core.Params{}, Spec: core.Params{}}
+	if name := recordName(record); name != "" {
+		manifest.Identity["name"] = name
+	} else if path, ok := record["path"].(string); ok && path != "" {
+		manifest.Identity["path"] = path
+	} else {
+		e.warnf("%s %v skipped: no name or path to identify it", kind, record["id"])
+		return manifest, false
+	}
+
+	relations := map[string]core.Relation{}
+	for _, relation := range core.GetAllRelationsForResource(kind) {
+		relations[relation.Field] = relation
+	}
+	for key, value := range record {
+		if value == nil || strings.HasPrefix(key, "@") || e.stripped(kind, key) {
+			continue
+		}
+		if writable != nil && !writable[key] {
+			continue
+		}
+		relation, isRelation := relations[key]
+		if !isRelation {
+			manifest.Spec[key] = value
+			continue
+		}
+		ref, err := e.convertRef(relation, value)
+		if err != nil {
+			e.warnf("%s: field %s dropped: %v", manifest.describe(), key, err)
+			continue
+		}
+		manifest.Spec[key] = ref
+	}
+
+	if e.ambiguous[manifest.Ref()] {
+		// Names are unique per tenant: the tenant reference tells the objects apart.
+		if tenant, ok := manifest.Spec["tenant_id"].(core.Params); ok {
+			manifest.Identity["tenant_id"] = tenant
+		} else {
+			e.warnf("%s: several objects share this name", manifest.describe())
+		}
+	}
+	return manifest, true
+}
+
+func (e *exporter) stripped(kind, field string) bool {
+	if _, ok := serverManagedFields[field]; ok {
+		return true
+	}
+	if strings.HasSuffix(field, "_usage") || strings.HasPrefix(field, "used_") {
+		return true
+	}
+	_, ok := e.strip[field]
+	if !ok {
+		_, ok = e.strip[kind+"."+field]
+	}
+	return ok
+}
+
+// convertRef replaces an id (or a list of ids for "_ids" fields) with references.
+func (e *exporter) convertRef(relation core.Relation, value any) (any, error) {
+	ids, isList := value.([]any)
+	if !isList {
+		return e.refTo(relation.ResourceType, value)
+	}
+	refs := make([]any, 0, len(ids))
+	for _, id := range ids {
+		ref, err := e.refTo(relation.ResourceType, id)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+// writableFields returns the properties of the POST and PATCH request bodies of resourcePath,
+// or nil when the schema does not describe them.
+func writableFields(resourcePath string) map[string]bool {
+	fields := map[string]bool{}
+	for _, method := range []string{"POST", "PATCH"} {
+		schema, err := openapi_schema.GetRequestBodySchema(method, resourcePath)
+		if err != nil || schema.Value == nil {
+			continue
+		}
+		for name, prop := range schema.Value.Properties {
+			if prop.Value != nil && prop.Value.ReadOnly {
+				continue
+			}
+			fields[name] = true
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+func recordName(record core.Record) string {
+	name, _ := record["name"].(string)
+	return name
+}
+
+// normalizeID makes float64 ids decoded from JSON compare equal to integer ids.
+func normalizeID(id any) any {
+	if f, ok := id.(float64); ok && f == float64(int64(f)) {
+		return int64(f)
+	}
+	return id
+}
+
+// Save writes the bundle to path as JSON (.json extension) or YAML (any other extension).
+func (b *Bundle) Save(path string) error {
+	var (
+		data []byte
+		err  error
+	)
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		data, err = json.MarshalIndent(b, "", "  ")
+	} else {
+		data, err = yaml.Marshal(b)
+	}
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// LoadBundle reads a bundle written by Bundle.Save.
+func LoadBundle(path string) (*Bundle, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var bundle Bundle
+	if err := yaml.Unmarshal(data, &bundle); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if bundle.Version > BundleVersion {
+		return nil, fmt.Errorf("%s: unsupported bundle version %d (max %d)", path, bundle.Version, BundleVersion)
+	}
+	for i := range bundle.Objects {
+		bundle.Objects[i].Source = fmt.Sprintf("%s#%d", path, i+1)
+		if err := bundle.Objects[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &bundle, nil
+}
@@ -0,0 +1,158 @@
+package apply
+
+import (
+	"context"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vast-data/go-vast-client/core"
+)
+
+// newSourceServer returns a source cluster with two tenants, a view policy name shared
+// by both tenants and a view referencing a QoS policy that is not exported.
+func newSourceServer() *testServer {
+	srv := newTestServer()
+	srv.add("tenants", map[string]any{"id": 1, "name": "t1", "guid": "g-1", "created": "2025-01-01T00:00:00Z"})
+	srv.add("tenants", map[string]any{"id": 2, "name": "t2"})
+	srv.add("viewpolicies", map[string]any{"id": 5, "name": "default", "flavor": "NFS", "tenant_id": 1})
+	srv.add("viewpolicies", map[string]any{"id": 6, "name": "default", "flavor": "S3_NATIVE", "tenant_id": 2})
+	srv.add("viewpolicies", map[string]any{"id": 7, "name": "lab", "flavor": "NFS", "tenant_id": 1})
+	srv.add("qospolicies", map[string]any{"id": 8, "name": "gold"})
+	srv.add("views", map[string]any{
+		"id": 10, "name": "v1", "path": "/v1", "protocols": []any{"NFS"}, "tenant_id": 1, "policy_id": 7,
+		"logical_capacity": 1024, "cluster_id": 1, "sync": "SYNCED",
+	})
+	srv.add("views", map[string]any{"id": 11, "name": "v2", "path": "/v2", "tenant_id": 2, "policy_id": 6, "qos_policy_id": 8})
+	return srv
+}
+
+// manifestByRef returns the bundle object with the given ref whose identity references
+// tenant ("" for objects identified without a tenant).
+func manifestByRef(t *testing.T, bundle *Bundle, ref string, tenant string) *Manifest {
+	t.Helper()
+	for i := range bundle.Objects {
+		m := &bundle.Objects[i]
+		tenantRef, _ := refOf(asMapParams(t, m.Identity["tenant_id"]))
+		if m.Ref() == ref && tenantRef == tenant {
+			return m
+		}
+	}
+	t.Fatalf("%s not found in bundle %+v", ref, bundle.Objects)
+	return nil
+}
+
+func TestExport(t *testing.T) {
+	srv := newSourceServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	bundle, err := Export(context.Background(), rest, &ExportOptions{Kinds: []string{"Tenant", "ViewPolicy", "View"}})
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	if bundle.Version != BundleVersion || len(bundle.Objects) != 7 {
+		t.Fatalf("unexpected bundle %+v", bundle)
+	}
+
+	tenant := manifestByRef(t, bundle, "Tenant/t1", "")
+	if len(tenant.Spec) != 1 || tenant.Spec["name"] != "t1" {
+		t.Errorf("server-managed fields must be stripped, got %v", tenant.Spec)
+	}
+	view := manifestByRef(t, bundle, "View/v1", "")
+	for _, field := range []string{"id", "logical_capacity", "cluster_id", "sync"} {
+		if _, ok := view.Spec[field]; ok {
+			t.Errorf("field %s must not be exported: %v", field, view.Spec)
+		}
+	}
+	if ref, _ := refOf(view.Spec["policy_id"].(core.Params)); ref != "ViewPolicy/lab" {
+		t.Errorf("policy_id = %v", view.Spec["policy_id"])
+	}
+	if ref, _ := refOf(view.Spec["tenant_id"].(core.Params)); ref != "Tenant/t1" {
+		t.Errorf("tenant_id = %v", view.Spec["tenant_id"])
+	}
+
+	// Shared names are told apart by tenant; references to them are dropped.
+	policy := manifestByRef(t, bundle, "ViewPolicy/default", "Tenant/t2")
+	if policy.Spec["flavor"] != "S3_NATIVE" {
+		t.Errorf("unexpected policy %v", policy)
+	}
+	v2 := manifestByRef(t, bundle, "View/v2", "")
+	if _, ok := v2.Spec["policy_id"]; ok {
+		t.Errorf("ambiguous reference must be dropped, got %v", v2.Spec["policy_id"])
+	}
+	if ref, _ := refOf(v2.Spec["qos_policy_id"].(core.Params)); ref != "QosPolicy/gold" {
+		t.Errorf("references outside the exported kinds must be looked up, got %v", v2.Spec["qos_policy_id"])
+	}
+	if len(bundle.Warnings) != 1 || !strings.Contains(bundle.Warnings[0], "ViewPolicy/default is ambiguous") {
+		t.Errorf("warnings = %v", bundle.Warnings)
+	}
+	if bundle.IDs["View/v1"] == nil {
+		t.Errorf("source ids = %v", bundle.IDs)
+	}
+}
+
+func TestExport_UnknownKind(t *testing.T) {
+	srv := newTestServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	if _, err := Export(context.Background(), rest, &ExportOptions{Kinds: []string{"Nope"}}); err == nil {
+		t.Error("expected error for unknown kind")
+	}
+}
+
+func TestBundle_SaveLoad(t *testing.T) {
+	srv := newSourceServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	bundle, err := Export(context.Background(), rest, &ExportOptions{
+		Kinds:       []string{"Tenant", "View"},
+		Params:      map[string]core.Params{"View": {"name": "v1"}},
+		StripFields: []string{"View.protocols"},
+	})
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	if len(bundle.Objects) != 3 {
+		t.Fatalf("expected 2 tenants and 1 view, got %+v", bundle.Objects)
+	}
+	if _, ok := manifestByRef(t, bundle, "View/v1", "").Spec["protocols"]; ok {
+		t.Error("StripFields must be honored")
+	}
+
+	for _, name := range []string{"bundle.yaml", "bundle.json"} {
+		path := filepath.Join(t.TempDir(), name)
+		if err := bundle.Save(path); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+		loaded, err := LoadBundle(path)
+		if err != nil {
+			t.Fatalf("LoadBundle(%s): %v", name, err)
+		}
+		if len(loaded.Objects) != 3 || !loaded.ExportedAt.Equal(bundle.ExportedAt) || loaded.Source != bundle.Source {
+			t.Errorf("%s: round trip mismatch %+v", name, loaded)
+		}
+		view := manifestByRef(t, loaded, "View/v1", "")
+		if ref, ok := refOf(asMapParams(t, view.Spec["policy_id"])); !ok || ref != "ViewPolicy/lab" {
+			t.Errorf("%s: policy_id = %v", name, view.Spec["policy_id"])
+		}
+	}
+}
+
+func asMapParams(t *testing.T, v any) core.Params {
+	t.Helper()
+	if v == nil {
+		return nil
+	}
+	params, ok := asParams(v)
+	if !ok {
+		t.Fatalf("expected an object, got %#v", v)
+	}
+	return params
+}
@@ -0,0 +1,139 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/vast-data/go-vast-client/core"
+)
+
+// ImportOptions controls Import.
+type ImportOptions struct {
+	// Overwrite updates existing objects whose fields differ from the bundle.
+	// By default they are left untouched and reported as conflicts.
+	Overwrite bool
+	// DryRun computes the plan and conflicts without modifying the target.
+	DryRun bool
+	// DiffOptions is passed to core.Diff when comparing bundle objects with existing ones.
+	DiffOptions *core.DiffOptions
+	// WaitTimeout, when > 0, waits for async tasks returned by create/update.
+	// Named creates that return a bare task are always waited for (with the default timeout when unset).
+	WaitTimeout time.Duration
+}
+
+// Conflict is a bundle object that was not imported.
+type Conflict struct {
+	Manifest *Manifest
+	Reason   string
+	// Diff is set for objects that already exist on the target with different fields.
+	Diff core.RecordDiff
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s: %s", c.Manifest.describe(), c.Reason)
+}
+
+// IDMapping maps the id of an object on the source cluster to its id on the target.
+type IDMapping struct {
+	Ref      string
+	SourceID any
+	TargetID any
+}
+
+// ImportReport describes the outcome of Import.
+type ImportReport struct {
+	// Plan holds the changes that were (or, for a dry run, would be) applied.
+	Plan *Plan
+	// Result is empty for a dry run.
+	Result    Result
+	Conflicts []Conflict
+	// IDs maps source ids to target ids of the bundle objects present on the target.
+	IDs []IDMapping
+}
+
+func (r *ImportReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "import: %d to create, %d to update, %d unchanged, %d conflicts\n",
+		r.Plan.Count(ActionCreate), r.Plan.Count(ActionUpdate), r.Plan.Count(ActionNoop), len(r.Conflicts))
+	for _, conflict := range r.Conflicts {
+		fmt.Fprintf(&sb, "conflict %s\n", conflict)
+	}
+	return sb.String()
+}
+
+// Import creates the bundle objects on the target cluster. References are resolved by
+// name, so objects get new ids on the target; existing objects with the same identity
+// are reused. An object is reported as a conflict instead of being imported when:
+//   - it already exists with different fields (unless Overwrite is set);
+//   - it references an object that is neither in the bundle nor on the target;
+//   - it references an object that is not imported because of a conflict.
+//
+// Conflicts do not prevent the remaining objects from being imported.
+func Import(ctx context.Context, rest core.VastRest, bundle *Bundle, opts *ImportOptions) (*ImportReport, error) {
+	if opts == nil {
+		opts = &ImportOptions{}
+	}
+	plan, err := BuildPlan(ctx, rest, bundle.Objects, &Options{DiffOptions: opts.DiffOptions, WaitTimeout: opts.WaitTimeout})
+	if err != nil {
+		return nil, err
+	}
+	report := &ImportReport{Plan: plan}
+
+	inBundle := make(map[string]bool, len(bundle.Objects))
+	for _, manifest := range bundle.Objects {
+		if manifest.Name() != "" {
+			inBundle[manifest.Ref()] = true
+		}
+	}
+	blocked := map[string]bool{}
+	changes := plan.Changes[:0]
+	for _, change := range plan.Changes {
+		conflict := Conflict{Manifest: change.Manifest}
+		var refs []string
+		refsOf(map[string]any(change.Manifest.Identity), &refs)
+		refsOf(map[string]any(change.Manifest.Spec), &refs)
+		for _, ref := range refs {
+			if blocked[ref] {
+				conflict.Reason = fmt.Sprintf("depends on conflicting %s", ref)
+				break
+			}
+			if inBundle[ref] {
+				continue
+			}
+			if _, err := plan.lookupRef(ctx, ref, true); err != nil {
+				conflict.Reason = fmt.Sprintf("missing reference: %v", err)
+				break
+			}
+		}
+		if conflict.Reason == "" && change.Action == ActionUpdate && !opts.Overwrite {
+			// The object exists, so dependents can still reference it.
+			conflict.Reason = "exists with different fields"
+			conflict.Diff = change.Diff
+			report.Conflicts = append(report.Conflicts, conflict)
+			continue
+		}
+		if conflict.Reason != "" {
+			blocked[change.Manifest.Ref()] = true
+			report.Conflicts = append(report.Conflicts, conflict)
+			continue
+		}
+		changes = append(changes, change)
+	}
+	plan.Changes = changes
+
+	if !opts.DryRun {
+		report.Result, err = plan.Apply(ctx)
+	}
+	for _, manifest := range bundle.Objects {
+		if manifest.Name() == "" {
+			continue
+		}
+		ref := manifest.Ref()
+		if target, ok := plan.ids[ref]; ok {
+			report.IDs = append(report.IDs, IDMapping{Ref: ref, SourceID: bundle.IDs[ref], TargetID: target})
+		}
+	}
+	return report, err
+}
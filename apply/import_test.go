@@ -0,0 +1,135 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func exportSource(t *testing.T) *Bundle {
+	t.Helper()
+	srv := newSourceServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	bundle, err := Export(context.Background(), rest, &ExportOptions{Kinds: []string{"Tenant", "ViewPolicy", "View"}})
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	return bundle
+}
+
+// newTargetServer returns a target cluster that already has tenant t1 (same fields)
+// and view policy lab (different flavor), but no QoS policy gold.
+func newTargetServer() *testServer {
+	srv := newTestServer()
+	srv.add("tenants", map[string]any{"id": 50, "name": "t1"})
+	srv.add("viewpolicies", map[string]any{"id": 51, "name": "lab", "flavor": "SMB", "tenant_id": 50})
+	return srv
+}
+
+func TestImport(t *testing.T) {
+	bundle := exportSource(t)
+	srv := newTargetServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	report, err := Import(context.Background(), rest, bundle, &ImportOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	if len(srv.requests) != 0 || len(report.Result) != 0 {
+		t.Fatalf("dry run must not modify the target, got %v", srv.requests)
+	}
+	if len(report.Conflicts) != 2 {
+		t.Fatalf("conflicts = %v", report.Conflicts)
+	}
+	conflicts := fmt.Sprint(report.Conflicts)
+	if !strings.Contains(conflicts, "ViewPolicy/lab: exists with different fields") ||
+		!strings.Contains(conflicts, `View/v2: missing reference: resolve reference "QosPolicy/gold"`) {
+		t.Errorf("unexpected conflicts %s", conflicts)
+	}
+	if report.Conflicts[0].Diff.Empty() {
+		t.Error("expected the field diff of the existing object")
+	}
+	if out := report.String(); !strings.HasPrefix(out, "import: 4 to create, 0 to update, 1 unchanged, 2 conflicts") {
+		t.Errorf("unexpected report:\n%s", out)
+	}
+
+	report, err = Import(context.Background(), rest, bundle, nil)
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	want := []string{"POST tenants", "POST viewpolicies", "POST viewpolicies", "POST views"}
+	if strings.Join(srv.requests, ", ") != strings.Join(want, ", ") {
+		t.Fatalf("requests = %v, want %v", srv.requests, want)
+	}
+	view := srv.bodies[3]
+	if fmt.Sprint(view["policy_id"]) != "51" || fmt.Sprint(view["tenant_id"]) != "50" {
+		t.Errorf("view references must be remapped to target ids, got %v", view)
+	}
+	t2 := fmt.Sprint(srv.bodies[0]["id"])
+	for _, body := range srv.bodies[1:3] {
+		if body["name"] != "default" {
+			t.Errorf("unexpected policy body %v", body)
+		}
+	}
+	if fmt.Sprint(srv.bodies[1]["tenant_id"]) != "50" || fmt.Sprint(srv.bodies[2]["tenant_id"]) != t2 {
+		t.Errorf("policies sharing a name must keep their tenants, got %v", srv.bodies[1:3])
+	}
+
+	ids := map[string]string{}
+	for _, mapping := range report.IDs {
+		ids[mapping.Ref] = fmt.Sprintf("%v->%v", mapping.SourceID, mapping.TargetID)
+	}
+	if ids["Tenant/t1"] != "1->50" || ids["ViewPolicy/lab"] != "7->51" || ids["Tenant/t2"] != "2->"+t2 {
+		t.Errorf("id mappings = %v", ids)
+	}
+	if _, ok := ids["View/v2"]; ok {
+		t.Error("conflicting objects have no target id")
+	}
+}
+
+func TestImport_Overwrite(t *testing.T) {
+	bundle := exportSource(t)
+	srv := newTargetServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	report, err := Import(context.Background(), rest, bundle, &ImportOptions{Overwrite: true})
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	if len(report.Conflicts) != 1 {
+		t.Errorf("conflicts = %v", report.Conflicts)
+	}
+	if srv.requests[3] != "PATCH viewpolicies/51" || len(srv.bodies[3]) != 1 || srv.bodies[3]["flavor"] != "NFS" {
+		t.Errorf("expected the existing policy to be updated, got %v %v", srv.requests, srv.bodies)
+	}
+}
+
+func TestImport_DependsOnConflict(t *testing.T) {
+	srv := newTestServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	bundle := &Bundle{Objects: labManifests()}
+	bundle.Objects[2].Spec["tenant_id"] = map[string]any{refKey: "Tenant/missing"}
+	report, err := Import(context.Background(), rest, bundle, nil)
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	conflicts := fmt.Sprint(report.Conflicts)
+	if len(report.Conflicts) != 3 || !strings.Contains(conflicts, "depends on conflicting ViewPolicy/lab-policy") {
+		t.Errorf("conflicts must propagate to dependents, got %s", conflicts)
+	}
+	if len(srv.requests) != 0 {
+		t.Errorf("nothing must be created, got %v", srv.requests)
+	}
+}
@@ -0,0 +1,198 @@
+// Package apply implements declarative, desired-state management of VMS objects.
+//
+// Manifests describe objects by kind (a resource type of the REST client resource map,
+// e.g. "View"), identity (search params locating the live object) and spec (the
+// create/update body). BuildPlan compares manifests with the live cluster and returns
+// an ordered list of create/update/delete changes with field diffs; Plan.Apply executes
+// it through the regular VastResource CRUD methods.
+//
+//	manifests, err := apply.LoadManifests("clusters/lab1/")
+//	plan, err := apply.BuildPlan(ctx, rest, manifests, &apply.Options{
+//	    Prune:  true,
+//	    Marker: &apply.Marker{Field: "name", Prefix: "gitops-"},
+//	})
+//	fmt.Println(plan) // dry run
+//	result, err := plan.Apply(ctx)
+//
+// A spec or identity value of the form {"$ref": "Kind/name"} is replaced with the id of the
+// referenced object, which may itself be created by the same plan:
+//
+//	kind: View
+//	identity: {path: /data}
+//	spec:
+//	  path: /data
+//	  policy_id: {$ref: ViewPolicy/data-policy}
+//
+// Export and Import copy configuration between clusters as a Bundle of such manifests.
+package apply
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/vast-data/go-vast-client/core"
+	"gopkg.in/yaml.v3"
+)
+
+// refKey is the spec key marking a reference to another object ({"$ref": "Kind/name"}).
+const refKey = "$ref"
+
+// Manifest is the desired state of a single VMS object.
+type Manifest struct {
+	// Kind is the resource type as registered in the resource map (e.g. "View").
+	Kind string `json:"kind" yaml:"kind"`
+	// Identity holds search params that locate the live object (e.g. {"name": "default"}).
+	Identity core.Params `json:"identity" yaml:"identity"`
+	// Spec is the create/update body. Only fields present in Spec are compared and updated.
+	Spec core.Params `json:"spec" yaml:"spec"`
+
+	// Source describes where the manifest was loaded from (e.g. "views.yaml#2").
+	Source string `json:"-" yaml:"-"`
+}
+
+// Name returns the name used to reference the manifest as "Kind/name":
+// identity "name" if present, otherwise spec "name".
+func (m *Manifest) Name() string {
+	for _, params := range []core.Params{m.Identity, m.Spec} {
+		if name, ok := params["name"]; ok && name != nil {
+			return fmt.Sprintf("%v", name)
+		}
+	}
+	return ""
+}
+
+// Ref returns the "Kind/name" reference of the manifest.
+func (m *Manifest) Ref() string {
+	return m.Kind + "/" + m.Name()
+}
+
+func (m *Manifest) String() string {
+	if m.Source != "" {
+		return fmt.Sprintf("%s (%s)", m.describe(), m.Source)
+	}
+	return m.describe()
+}
+
+func (m *Manifest) describe() string {
+	if name := m.Name(); name != "" {
+		return m.Kind + "/" + name
+	}
+	params := m.Identity
+	return fmt.Sprintf("%s[%s]", m.Kind, params.ToQuery())
+}
+
+// Validate checks that the manifest has a kind and an identity.
+func (m *Manifest) Validate() error {
+	if m.Kind == "" {
+		return fmt.Errorf("manifest %s: kind is required", m.Source)
+	}
+	if len(m.Identity) == 0 {
+		return fmt.Errorf("manifest %s: identity is required", m)
+	}
+	return nil
+}
+
+// createBody merges exact-match identity fields with spec (spec wins).
+func (m *Manifest) createBody() core.Params {
+	body := make(core.Params, len(m.Identity)+len(m.Spec))
+	for key, value := range m.Identity {
+		if field, _ := core.SplitLookupKey(key); field == key {
+			body[key] = value
+		}
+	}
+	for key, value := range m.Spec {
+		body[key] = value
+	}
+	return body
+}
+
+// ParseManifests decodes YAML or JSON manifests. The input may contain several YAML
+// documents separated by "---"; each document is a single manifest or a list of manifests.
+func ParseManifests(data []byte, source string) ([]Manifest, error) {
+	var manifests []Manifest
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for doc := 0; ; doc++ {
+		var node yaml.Node
+		if err := decoder.Decode(&node); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("%s: %w", source, err)
+		}
+		if len(node.Content) == 0 || node.Content[0].Tag == "!!null" {
+			continue // empty document
+		}
+		var batch []Manifest
+		if node.Content[0].Kind == yaml.SequenceNode {
+			if err := node.Decode(&batch); err != nil {
+				return nil, fmt.Errorf("%s: %w", source, err)
+			}
+		} else {
+			var manifest Manifest
+			if err := node.Decode(&manifest); err != nil {
+				return nil, fmt.Errorf("%s: %w", source, err)
+			}
+			batch = []Manifest{manifest}
+		}
+		for i := range batch {
+			batch[i].Source = fmt.Sprintf("%s#%d", source, len(manifests)+i+1)
+			if err := batch[i].Validate(); err != nil {
+				return nil, err
+			}
+		}
+		manifests = append(manifests, batch...)
+	}
+	return manifests, nil
+}
+
+// LoadManifests reads manifests from files and directories. Directories are walked
+// recursively and files with .yaml, .yml and .json extensions are loaded in lexical order.
+func LoadManifests(paths ...string) ([]Manifest, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			switch strings.ToLower(filepath.Ext(p)) {
+			case ".yaml", ".yml", ".json":
+				if !d.IsDir() {
+					files = append(files, p)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(files)
+
+	var manifests []Manifest
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		parsed, err := ParseManifests(data, file)
+		if err != nil {
+			return nil, err
+		}
+		manifests = append(manifests, parsed...)
+	}
+	return manifests, nil
+}
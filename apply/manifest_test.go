@@ -0,0 +1,128 @@
+package apply
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseManifests_YAML(t *testing.T) {
+	data := []byte(`
+kind: Tenant
+identity: {name: lab}
+spec: {name: lab}
+---
+- kind: ViewPolicy
+  identity: {name: lab-policy}
+  spec:
+    tenant_id: {$ref: Tenant/lab}
+    flavor: NFS
+- kind: View
+  identity: {path: /lab}
+  spec:
+    path: /lab
+    protocols: [NFS, S3]
+    policy_id: {$ref: ViewPolicy/lab-policy}
+---
+`)
+	manifests, err := ParseManifests(data, "lab.yaml")
+	if err != nil {
+		t.Fatalf("ParseManifests: %v", err)
+	}
+	if len(manifests) != 3 {
+		t.Fatalf("got %d manifests, want 3", len(manifests))
+	}
+	if manifests[0].Ref() != "Tenant/lab" || manifests[1].Ref() != "ViewPolicy/lab-policy" {
+		t.Errorf("unexpected refs %q, %q", manifests[0].Ref(), manifests[1].Ref())
+	}
+	if manifests[2].Source != "lab.yaml#3" {
+		t.Errorf("Source = %q", manifests[2].Source)
+	}
+	if manifests[2].Name() != "" || manifests[2].String() != "View[path=%2Flab] (lab.yaml#3)" {
+		t.Errorf("String() = %q", manifests[2].String())
+	}
+	policy, _ := asParams(manifests[2].Spec["policy_id"])
+	ref, ok := refOf(policy)
+	if !ok || ref != "ViewPolicy/lab-policy" {
+		t.Errorf("policy_id ref = %q, %v", ref, ok)
+	}
+	if protocols, ok := manifests[2].Spec["protocols"].([]any); !ok || len(protocols) != 2 {
+		t.Errorf("protocols = %#v", manifests[2].Spec["protocols"])
+	}
+}
+
+func TestParseManifests_JSON(t *testing.T) {
+	data := []byte(`[{"kind": "Quota", "identity": {"name": "q1"}, "spec": {"path": "/q1", "hard_limit": 1024}}]`)
+	manifests, err := ParseManifests(data, "quotas.json")
+	if err != nil {
+		t.Fatalf("ParseManifests: %v", err)
+	}
+	if len(manifests) != 1 || manifests[0].Kind != "Quota" || manifests[0].Spec["hard_limit"] != 1024 {
+		t.Errorf("unexpected manifests %+v", manifests)
+	}
+	body := manifests[0].createBody()
+	if body["name"] != "q1" || body["path"] != "/q1" {
+		t.Errorf("createBody() = %v", body)
+	}
+}
+
+func TestParseManifests_Invalid(t *testing.T) {
+	tests := []struct {
+		name, data, wantErr string
+	}{
+		{"missing kind", "identity: {name: a}", "kind is required"},
+		{"missing identity", "kind: View", "identity is required"},
+		{"bad yaml", "kind: [View", "bad.yaml"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseManifests([]byte(tt.data), "bad.yaml")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestManifest_CreateBodySkipsLookups(t *testing.T) {
+	m := Manifest{Kind: "View", Identity: map[string]any{"path": "/a", "name__icontains": "a"}, Spec: map[string]any{"path": "/b"}}
+	body := m.createBody()
+	if body["path"] != "/b" {
+		t.Errorf("spec must win over identity, got %v", body["path"])
+	}
+	if _, ok := body["name__icontains"]; ok {
+		t.Error("lookup identity keys must not be sent in the create body")
+	}
+}
+
+func TestLoadManifests(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		t.Helper()
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("b/views.yml", "kind: View\nidentity: {path: /a}\n")
+	write("a.json", `{"kind": "Tenant", "identity": {"name": "t"}}`)
+	write("README.md", "not a manifest")
+
+	manifests, err := LoadManifests(dir)
+	if err != nil {
+		t.Fatalf("LoadManifests: %v", err)
+	}
+	if len(manifests) != 2 || manifests[0].Kind != "Tenant" || manifests[1].Kind != "View" {
+		t.Fatalf("unexpected manifests %+v", manifests)
+	}
+	if !strings.HasSuffix(manifests[1].Source, filepath.Join("b", "views.yml")+"#1") {
+		t.Errorf("Source = %q", manifests[1].Source)
+	}
+	if _, err := LoadManifests(filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Error("expected error for missing path")
+	}
+}
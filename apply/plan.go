@@ -0,0 +1,547 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vast-data/go-vast-client/core"
+)
+
+// Action is the kind of change a plan performs on an object.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+	ActionNoop   Action = "noop"
+)
+
+// Marker identifies live objects owned by a manifest set. Only objects matching the
+// marker are pruned. An object matches when its Field equals Value, contains Value
+// (list fields) or has Value as a key (map fields), or, if Prefix is set, when the
+// string Field starts with Prefix.
+//
+//	&apply.Marker{Field: "name", Prefix: "gitops-"}
+//	&apply.Marker{Field: "tags", Value: "managed-by=gitops"}
+type Marker struct {
+	Field  string
+	Value  string
+	Prefix string
+}
+
+// Matches reports whether record carries the marker.
+func (m *Marker) Matches(record core.Record) bool {
+	value, ok := record[m.Field]
+	if !ok || value == nil {
+		return false
+	}
+	if m.Prefix != "" {
+		s, ok := value.(string)
+		return ok && strings.HasPrefix(s, m.Prefix)
+	}
+	switch v := value.(type) {
+	case string:
+		return v == m.Value
+	case []any:
+		for _, item := range v {
+			if fmt.Sprintf("%v", item) == m.Value {
+				return true
+			}
+		}
+		return false
+	case map[string]any:
+		_, ok := v[m.Value]
+		return ok
+	}
+	return fmt.Sprintf("%v", value) == m.Value
+}
+
+// Options controls planning and applying.
+type Options struct {
+	// Prune deletes live objects of the managed kinds that carry Marker but have no manifest.
+	Prune bool
+	// Marker identifies objects owned by the manifests. Required when Prune is set.
+	Marker *Marker
+	// PruneKinds are the kinds managed by the manifest set, pruned in addition to the kinds of
+	// the manifests. List every managed kind so that deleting the last manifest of a kind
+	// still prunes its marked objects.
+	PruneKinds []string
+	// DiffOptions is passed to core.Diff when comparing spec with live objects.
+	DiffOptions *core.DiffOptions
+	// WaitTimeout, when > 0, waits for async tasks returned by create/update/delete.
+	// Named creates that return a bare task are always waited for (with the default timeout when unset).
+	WaitTimeout time.Duration
+}
+
+// Change is a single planned operation.
+type Change struct {
+	Action   Action
+	Kind     string
+	Manifest *Manifest // nil for pruned objects
+	// Live is the current object (nil for creates).
+	Live core.Record
+	// Diff lists the spec fields that differ from the live object
+	// (all spec fields for creates, nothing for deletes and noops).
+	Diff core.RecordDiff
+}
+
+// ID returns the id of the live object, or nil for creates.
+func (c *Change) ID() any {
+	if c.Live == nil {
+		return nil
+	}
+	return c.Live["id"]
+}
+
+func (c *Change) String() string {
+	if c.Manifest != nil {
+		return fmt.Sprintf("%s %s", c.Action, c.Manifest.describe())
+	}
+	name := c.Live["name"]
+	if name == nil {
+		name = c.ID()
+	}
+	return fmt.Sprintf("%s %s/%v", c.Action, c.Kind, name)
+}
+
+// Plan is the ordered list of changes computed by BuildPlan.
+// Creates and updates come first in dependency order, followed by deletes in reverse dependency order.
+type Plan struct {
+	Changes []Change
+
+	rest core.VastRest
+	opts *Options
+	// ids maps "Kind/name" references to ids of live objects known at planning time.
+	ids map[string]any
+}
+
+// HasChanges reports whether applying the plan would modify the cluster.
+func (p *Plan) HasChanges() bool {
+	for _, change := range p.Changes {
+		if change.Action != ActionNoop {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of changes with the given action.
+func (p *Plan) Count(action Action) int {
+	n := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			n++
+		}
+	}
+	return n
+}
+
+// String renders the plan with field diffs, suitable for dry-run output.
+func (p *Plan) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "plan: %d to create, %d to update, %d to delete, %d unchanged\n",
+		p.Count(ActionCreate), p.Count(ActionUpdate), p.Count(ActionDelete), p.Count(ActionNoop))
+	for _, change := range p.Changes {
+		if change.Action == ActionNoop {
+			continue
+		}
+		sb.WriteString(change.String())
+		sb.WriteString("\n")
+		if !change.Diff.Empty() {
+			for _, line := range strings.Split(change.Diff.PrettyDiff(false), "\n") {
+				sb.WriteString("    ")
+				sb.WriteString(line)
+				sb.WriteString("\n")
+			}
+		}
+	}
+	return sb.String()
+}
+
+// BuildPlan compares manifests with the live objects and computes the changes needed
+// to reach the desired state. Nothing is modified.
+//
+// Manifests are ordered by dependencies: a kind with a relation to another kind
+// (see core.RegisterRelation, e.g. View -> ViewPolicy -> Tenant) and a manifest with a
+// {"$ref": "Kind/name"} to another manifest are applied after their targets.
+func BuildPlan(ctx context.Context, rest core.VastRest, manifests []Manifest, opts *Options) (*Plan, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	if opts.Prune && opts.Marker == nil {
+		return nil, fmt.Errorf("prune requires a marker to identify managed objects")
+	}
+	resources := rest.GetResourceMap()
+	for i := range manifests {
+		if err := manifests[i].Validate(); err != nil {
+			return nil, err
+		}
+		if _, ok := resources[manifests[i].Kind]; !ok {
+			return nil, fmt.Errorf("manifest %s: unknown kind %q", &manifests[i], manifests[i].Kind)
+		}
+	}
+	for _, kind := range opts.PruneKinds {
+		if _, ok := resources[kind]; !ok {
+			return nil, fmt.Errorf("prune: unknown kind %q", kind)
+		}
+	}
+	ordered, err := orderManifests(manifests)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{rest: rest, opts: opts, ids: map[string]any{}}
+	managed := map[string]map[string]bool{} // kind -> ids of live objects with a manifest
+	for _, manifest := range ordered {
+		resource := resources[manifest.Kind]
+		identity, err := plan.resolveRefs(ctx, manifest.Identity, false)
+		if err != nil {
+			return nil, fmt.Errorf("manifest %s: %w", manifest, err)
+		}
+		var live core.Record
+		// An identity referencing an object that is yet to be created cannot match a live object.
+		if !hasPendingRef(identity) {
+			live, err = resource.GetWithContext(ctx, identity)
+			if err != nil && !core.IsNotFoundErr(err) {
+				return nil, fmt.Errorf("manifest %s: %w", manifest, err)
+			}
+		}
+		desired, err := plan.resolveRefs(ctx, manifest.Spec, false)
+		if err != nil {
+			return nil, fmt.Errorf("manifest %s: %w", manifest, err)
+		}
+		change := Change{Kind: manifest.Kind, Manifest: manifest}
+		if live == nil {
+			change.Action = ActionCreate
+			change.Diff = core.Diff(core.Record{}, core.Record(desired), opts.DiffOptions)
+		} else {
+			change.Live = live
+			change.Diff = core.Diff(project(live, desired), core.Record(desired), opts.DiffOptions)
+			change.Action = ActionUpdate
+			if change.Diff.Empty() {
+				change.Action = ActionNoop
+			}
+			if managed[manifest.Kind] == nil {
+				managed[manifest.Kind] = map[string]bool{}
+			}
+			managed[manifest.Kind][fmt.Sprintf("%v", live["id"])] = true
+			if name := manifest.Name(); name != "" {
+				plan.ids[manifest.Ref()] = live["id"]
+			}
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	if opts.Prune {
+		kinds := orderKinds(append(kindOrder(ordered), opts.PruneKinds...))
+		for i := len(kinds) - 1; i >= 0; i-- {
+			kind := kinds[i]
+			records, err := resources[kind].ListWithContext(ctx, core.Params{})
+			if err != nil {
+				return nil, fmt.Errorf("prune %s: %w", kind, err)
+			}
+			for _, record := range records {
+				if managed[kind][fmt.Sprintf("%v", record["id"])] || !opts.Marker.Matches(record) {
+					continue
+				}
+				plan.Changes = append(plan.Changes, Change{Action: ActionDelete, Kind: kind, Live: record})
+			}
+		}
+	}
+	return plan, nil
+}
+
+// resolveRefs returns a copy of spec with {"$ref": "Kind/name"} values replaced by ids.
+// References to objects that do not exist yet are kept as pendingRef placeholders
+// unless strict is set.
+func (p *Plan) resolveRefs(ctx context.Context, spec core.Params, strict bool) (core.Params, error) {
+	resolved, err := p.resolveValue(ctx, map[string]any(spec), strict)
+	if err != nil {
+		return nil, err
+	}
+	if resolved == nil {
+		return core.Params{}, nil
+	}
+	return core.Params(resolved.(map[string]any)), nil
+}
+
+func (p *Plan) resolveValue(ctx context.Context, value any, strict bool) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		if ref, ok := refOf(v); ok {
+			return p.lookupRef(ctx, ref, strict)
+		}
+		out := make(map[string]any, len(v))
+		for key, item := range v {
+			resolved, err := p.resolveValue(ctx, item, strict)
+			if err != nil {
+				return nil, err
+			}
+			out[key] = resolved
+		}
+		return out, nil
+	case core.Params:
+		return p.resolveValue(ctx, map[string]any(v), strict)
+	case []any:
+		out := make([]any, len(v))
+		for i, item := range v {
+			resolved, err := p.resolveValue(ctx, item, strict)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = resolved
+		}
+		return out, nil
+	}
+	return value, nil
+}
+
+// lookupRef returns the id of the object referenced as "Kind/name".
+func (p *Plan) lookupRef(ctx context.Context, ref string, strict bool) (any, error) {
+	if id, ok := p.ids[ref]; ok {
+		return id, nil
+	}
+	kind, name, ok := strings.Cut(ref, "/")
+	if !ok || kind == "" || name == "" {
+		return nil, fmt.Errorf("invalid reference %q, expected Kind/name", ref)
+	}
+	resource, ok := p.rest.GetResourceMap()[kind]
+	if !ok {
+		return nil, fmt.Errorf("invalid reference %q: unknown kind %q", ref, kind)
+	}
+	record, err := resource.GetWithContext(ctx, core.Params{"name": name})
+	if err == nil {
+		p.ids[ref] = record["id"]
+		return record["id"], nil
+	}
+	if !core.IsNotFoundErr(err) || strict {
+		return nil, fmt.Errorf("resolve reference %q: %w", ref, err)
+	}
+	return pendingRef(fmt.Sprintf("(ref %s)", ref)), nil
+}
+
+// pendingRef is the placeholder for a reference to an object the plan is going to create.
+// It renders as "(ref Kind/name)" in diffs.
+type pendingRef string
+
+// hasPendingRef reports whether value contains a pendingRef placeholder.
+func hasPendingRef(value any) bool {
+	switch v := value.(type) {
+	case pendingRef:
+		return true
+	case core.Params:
+		return hasPendingRef(map[string]any(v))
+	case map[string]any:
+		for _, item := range v {
+			if hasPendingRef(item) {
+				return true
+			}
+		}
+	case []any:
+		for _, item := range v {
+			if hasPendingRef(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// refOf reports whether m is a {"$ref": "Kind/name"} reference.
+func refOf(m map[string]any) (string, bool) {
+	if len(m) != 1 {
+		return "", false
+	}
+	ref, ok := m[refKey].(string)
+	return ref, ok
+}
+
+// refsOf collects all references in value.
+func refsOf(value any, out *[]string) {
+	switch v := value.(type) {
+	case map[string]any:
+		if ref, ok := refOf(v); ok {
+			*out = append(*out, ref)
+			return
+		}
+		for _, item := range v {
+			refsOf(item, out)
+		}
+	case core.Params:
+		refsOf(map[string]any(v), out)
+	case []any:
+		for _, item := range v {
+			refsOf(item, out)
+		}
+	}
+}
+
+// project restricts live to the keys present in desired (recursively for nested objects),
+// so that server-populated fields not managed by the manifest are not reported as removed.
+func project(live core.Record, desired core.Params) core.Record {
+	out := make(core.Record, len(desired))
+	for key, want := range desired {
+		value, ok := live[key]
+		if !ok {
+			continue
+		}
+		if wantMap, isMap := asParams(want); isMap {
+			if liveMap, isMap := value.(map[string]any); isMap {
+				value = map[string]any(project(liveMap, wantMap))
+			}
+		}
+		out[key] = value
+	}
+	return out
+}
+
+// asParams returns v as Params if it is a nested object. YAML decodes nested objects of a
+// Params value as Params, JSON as map[string]any.
+func asParams(v any) (core.Params, bool) {
+	switch m := v.(type) {
+	case core.Params:
+		return m, true
+	case map[string]any:
+		return m, true
+	}
+	return nil, false
+}
+
+// orderManifests sorts manifests topologically. A manifest depends on manifests it
+// references and on manifests of kinds its kind has a relation to.
+// Among independent manifests the input order is kept.
+func orderManifests(manifests []Manifest) ([]*Manifest, error) {
+	byRef := make(map[string]int, len(manifests))
+	byKind := make(map[string][]int)
+	for i := range manifests {
+		if name := manifests[i].Name(); name != "" {
+			byRef[manifests[i].Ref()] = i
+		}
+		byKind[manifests[i].Kind] = append(byKind[manifests[i].Kind], i)
+	}
+
+	deps := make([]map[int]bool, len(manifests))
+	for i := range manifests {
+		deps[i] = map[int]bool{}
+		var refs []string
+		refsOf(map[string]any(manifests[i].Identity), &refs)
+		refsOf(map[string]any(manifests[i].Spec), &refs)
+		for _, ref := range refs {
+			if j, ok := byRef[ref]; ok && j != i {
+				deps[i][j] = true
+			}
+		}
+		for _, relation := range core.GetAllRelationsForResource(manifests[i].Kind) {
+			if relation.ResourceType == manifests[i].Kind {
+				continue
+			}
+			for _, j := range byKind[relation.ResourceType] {
+				deps[i][j] = true
+			}
+		}
+	}
+
+	ordered := make([]*Manifest, 0, len(manifests))
+	done := make([]bool, len(manifests))
+	for len(ordered) < len(manifests) {
+		progressed := false
+		for i := range manifests {
+			if done[i] {
+				continue
+			}
+			ready := true
+			for j := range deps[i] {
+				if !done[j] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				done[i] = true
+				ordered = append(ordered, &manifests[i])
+				progressed = true
+				break // restart so earlier manifests unblocked by i keep their input order
+			}
+		}
+		if !progressed {
+			var cycle []string
+			for i := range manifests {
+				if !done[i] {
+					cycle = append(cycle, manifests[i].String())
+				}
+			}
+			sort.Strings(cycle)
+			return nil, fmt.Errorf("dependency cycle between manifests: %s", strings.Join(cycle, ", "))
+		}
+	}
+	return ordered, nil
+}
+
+// orderKinds dedupes kinds and orders them so that kinds come after the kinds they relate to
+// (see core.RegisterRelation). Otherwise the input order is kept; kinds in a relation cycle
+// keep their input order too.
+func orderKinds(kinds []string) []string {
+	var unique []string
+	seen := map[string]bool{}
+	for _, kind := range kinds {
+		if !seen[kind] {
+			seen[kind] = true
+			unique = append(unique, kind)
+		}
+	}
+	deps := make(map[string][]string, len(unique))
+	for _, kind := range unique {
+		for _, relation := range core.GetAllRelationsForResource(kind) {
+			if relation.ResourceType != kind && seen[relation.ResourceType] {
+				deps[kind] = append(deps[kind], relation.ResourceType)
+			}
+		}
+	}
+	ordered := make([]string, 0, len(unique))
+	done := map[string]bool{}
+	for len(ordered) < len(unique) {
+		next := ""
+		for _, kind := range unique {
+			if done[kind] {
+				continue
+			}
+			ready := true
+			for _, dep := range deps[kind] {
+				ready = ready && done[dep]
+			}
+			if ready {
+				next = kind
+				break
+			}
+		}
+		if next == "" {
+			// Relation cycle: take the first remaining kind.
+			for _, kind := range unique {
+				if !done[kind] {
+					next = kind
+					break
+				}
+			}
+		}
+		done[next] = true
+		ordered = append(ordered, next)
+	}
+	return ordered
+}
+
+// kindOrder returns the distinct kinds of ordered manifests in first-seen order.
+func kindOrder(ordered []*Manifest) []string {
+	seen := map[string]bool{}
+	var kinds []string
+	for _, manifest := range ordered {
+		if !seen[manifest.Kind] {
+			seen[manifest.Kind] = true
+			kinds = append(kinds, manifest.Kind)
+		}
+	}
+	return kinds
+}
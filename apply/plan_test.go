@@ -0,0 +1,364 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vast-data/go-vast-client/core"
+)
+
+// testServer is an in-memory VMS supporting list (filtered by exact query params),
+// create, update and delete. Mutating requests are recorded in order.
+// Creates in the asyncCreates collections respond with a vtask instead of the object;
+// the object appears once the task is polled.
+type testServer struct {
+	mu           sync.Mutex
+	data         map[string]map[int]map[string]any // collection -> id -> record
+	nextID       int
+	requests     []string
+	bodies       []map[string]any
+	asyncCreates map[string]bool
+	pending      []func()
+}
+
+func newTestServer() *testServer {
+	return &testServer{data: map[string]map[int]map[string]any{}, nextID: 100}
+}
+
+func (s *testServer) add(collection string, record map[string]any) {
+	if s.data[collection] == nil {
+		s.data[collection] = map[int]map[string]any{}
+	}
+	s.data[collection][record["id"].(int)] = record
+}
+
+func (s *testServer) handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/") // api, latest, collection[, id]
+		collection := parts[2]
+		w.Header().Set("Content-Type", "application/json")
+		var body map[string]any
+		if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+			_ = json.NewDecoder(r.Body).Decode(&body)
+		}
+		if r.Method != http.MethodGet {
+			s.requests = append(s.requests, r.Method+" "+strings.Join(parts[2:], "/"))
+			s.bodies = append(s.bodies, body)
+		}
+
+		if collection == "vtasks" {
+			for _, create := range s.pending {
+				create()
+			}
+			s.pending = nil
+		}
+
+		if len(parts) == 3 {
+			switch r.Method {
+			case http.MethodGet:
+				results := []any{}
+				for id := 0; id < s.nextID+1; id++ {
+					record, ok := s.data[collection][id]
+					if ok && matchesQuery(record, r.URL.Query()) {
+						results = append(results, record)
+					}
+				}
+				_ = json.NewEncoder(w).Encode(results)
+			case http.MethodPost:
+				s.nextID++
+				body["id"] = s.nextID
+				w.WriteHeader(http.StatusCreated)
+				if s.asyncCreates[collection] {
+					s.pending = append(s.pending, func() { s.add(collection, body) })
+					s.nextID++
+					task := map[string]any{"id": s.nextID, "state": "completed", "url": fmt.Sprintf("https://%s/api/latest/vtasks/%d/", r.Host, s.nextID)}
+					s.add("vtasks", task)
+					_ = json.NewEncoder(w).Encode(task)
+					return
+				}
+				s.add(collection, body)
+				_ = json.NewEncoder(w).Encode(body)
+			}
+			return
+		}
+
+		id, _ := strconv.Atoi(parts[3])
+		record, ok := s.data[collection][id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail": "not found"}`))
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(record)
+		case http.MethodPatch:
+			for key, value := range body {
+				record[key] = value
+			}
+			_ = json.NewEncoder(w).Encode(record)
+		case http.MethodDelete:
+			delete(s.data[collection], id)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+}
+
+func matchesQuery(record map[string]any, query map[string][]string) bool {
+	for key, values := range query {
+		if fmt.Sprintf("%v", record[key]) != values[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// testRest is a minimal core.VastRest with a few resource types.
+type testRest struct {
+	ctx         context.Context
+	session     core.RESTSession
+	resourceMap map[string]core.VastResourceAPIWithContext
+}
+
+func (r *testRest) GetSession() core.RESTSession { return r.session }
+func (r *testRest) GetResourceMap() map[string]core.VastResourceAPIWithContext {
+	return r.resourceMap
+}
+func (r *testRest) GetCtx() context.Context    { return r.ctx }
+func (r *testRest) SetCtx(ctx context.Context) { r.ctx = ctx }
+
+func newTestRest(t *testing.T, server *httptest.Server) *testRest {
+	t.Helper()
+	saved := core.RelationRegistry
+	core.RelationRegistry = make(map[string]map[string]core.Relation)
+	t.Cleanup(func() { core.RelationRegistry = saved })
+	core.RegisterRelation("View", "tenant", "tenant_id", "Tenant")
+	core.RegisterRelation("View", "policy", "policy_id", "ViewPolicy")
+	core.RegisterRelation("View", "qos_policy", "qos_policy_id", "QosPolicy")
+	core.RegisterRelation("ViewPolicy", "tenant", "tenant_id", "Tenant")
+
+	addr := server.Listener.Addr().String()
+	colon := strings.LastIndex(addr, ":")
+	port, _ := strconv.ParseUint(addr[colon+1:], 10, 64)
+	timeout := time.Minute
+	session, err := core.NewVMSSession(&core.VMSConfig{
+		Host:           addr[:colon],
+		Port:           port,
+		ApiToken:       "test-token",
+		Timeout:        &timeout,
+		MaxConnections: 5,
+		ApiVersion:     "latest",
+	})
+	if err != nil {
+		t.Fatalf("NewVMSSession: %v", err)
+	}
+	rest := &testRest{
+		ctx:         context.Background(),
+		session:     session,
+		resourceMap: make(map[string]core.VastResourceAPIWithContext),
+	}
+	for resourceType, path := range map[string]string{
+		"Tenant":     "tenants",
+		"ViewPolicy": "viewpolicies",
+		"View":       "views",
+		"QosPolicy":  "qospolicies",
+	} {
+		rest.resourceMap[resourceType] = core.NewVastResource(path, resourceType, rest, core.NewResourceOps(core.C, core.L, core.R, core.U, core.D), nil)
+	}
+	return rest
+}
+
+func labManifests() []Manifest {
+	// Deliberately listed in reverse dependency order.
+	return []Manifest{
+		{Kind: "View", Identity: core.Params{"path": "/lab"}, Spec: core.Params{
+			"path":      "/lab",
+			"protocols": []any{"NFS"},
+			"policy_id": core.Params{refKey: "ViewPolicy/lab-policy"},
+		}},
+		{Kind: "ViewPolicy", Identity: core.Params{"name": "lab-policy"}, Spec: core.Params{
+			"flavor":    "NFS",
+			"tenant_id": core.Params{refKey: "Tenant/lab"},
+		}},
+		{Kind: "Tenant", Identity: core.Params{"name": "lab"}, Spec: core.Params{}},
+	}
+}
+
+func TestBuildPlan_CreateInDependencyOrder(t *testing.T) {
+	srv := newTestServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	plan, err := BuildPlan(context.Background(), rest, labManifests(), nil)
+	if err != nil {
+		t.Fatalf("BuildPlan: %v", err)
+	}
+	var got []string
+	for _, change := range plan.Changes {
+		got = append(got, change.String())
+	}
+	want := []string{"create Tenant/lab", "create ViewPolicy/lab-policy", "create View[path=%2Flab]"}
+	if strings.Join(got, ", ") != strings.Join(want, ", ") {
+		t.Fatalf("changes = %v, want %v", got, want)
+	}
+	if out := plan.String(); !strings.Contains(out, "plan: 3 to create, 0 to update, 0 to delete, 0 unchanged") ||
+		!strings.Contains(out, `"(ref ViewPolicy/lab-policy)"`) {
+		t.Errorf("unexpected dry-run output:\n%s", out)
+	}
+	if len(srv.requests) != 0 {
+		t.Errorf("planning must not modify anything, got %v", srv.requests)
+	}
+}
+
+func TestBuildPlan_UpdateAndNoop(t *testing.T) {
+	srv := newTestServer()
+	srv.add("tenants", map[string]any{"id": 1, "name": "lab"})
+	srv.add("viewpolicies", map[string]any{"id": 2, "name": "lab-policy", "flavor": "NFS", "tenant_id": 1})
+	srv.add("views", map[string]any{"id": 3, "path": "/lab", "protocols": []any{"NFS", "S3"}, "policy_id": 2, "created": "now"})
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	plan, err := BuildPlan(context.Background(), rest, labManifests(), nil)
+	if err != nil {
+		t.Fatalf("BuildPlan: %v", err)
+	}
+	actions := map[string]Action{}
+	for _, change := range plan.Changes {
+		actions[change.Kind] = change.Action
+	}
+	if actions["Tenant"] != ActionNoop || actions["ViewPolicy"] != ActionNoop || actions["View"] != ActionUpdate {
+		t.Fatalf("unexpected actions %v", actions)
+	}
+	view := plan.Changes[2]
+	if fmt.Sprintf("%v", view.ID()) != "3" {
+		t.Errorf("ID() = %v", view.ID())
+	}
+	if len(view.Diff) == 0 || topLevelField(view.Diff[0].Path) != "protocols" {
+		t.Errorf("expected only protocols to differ, got %v", view.Diff)
+	}
+	if !plan.HasChanges() || plan.Count(ActionNoop) != 2 {
+		t.Errorf("HasChanges = %v, noop = %d", plan.HasChanges(), plan.Count(ActionNoop))
+	}
+}
+
+func TestBuildPlan_Prune(t *testing.T) {
+	srv := newTestServer()
+	srv.add("tenants", map[string]any{"id": 1, "name": "lab"})
+	srv.add("views", map[string]any{"id": 3, "path": "/lab", "name": "gitops-lab", "protocols": []any{"NFS"}, "policy_id": 2})
+	srv.add("views", map[string]any{"id": 4, "path": "/old", "name": "gitops-old"})
+	srv.add("views", map[string]any{"id": 5, "path": "/manual", "name": "manual"})
+	srv.add("viewpolicies", map[string]any{"id": 2, "name": "lab-policy", "flavor": "NFS", "tenant_id": 1})
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	if _, err := BuildPlan(context.Background(), rest, labManifests(), &Options{Prune: true}); err == nil {
+		t.Fatal("expected error when pruning without a marker")
+	}
+	plan, err := BuildPlan(context.Background(), rest, labManifests(), &Options{
+		Prune:  true,
+		Marker: &Marker{Field: "name", Prefix: "gitops-"},
+	})
+	if err != nil {
+		t.Fatalf("BuildPlan: %v", err)
+	}
+	if plan.Count(ActionDelete) != 1 {
+		t.Fatalf("expected a single prune, got %s", plan)
+	}
+	last := plan.Changes[len(plan.Changes)-1]
+	if last.Action != ActionDelete || last.String() != "delete View/gitops-old" {
+		t.Errorf("unexpected prune change %s", &last)
+	}
+}
+
+func TestBuildPlan_PruneKindWithoutManifests(t *testing.T) {
+	srv := newTestServer()
+	srv.add("tenants", map[string]any{"id": 1, "name": "lab"})
+	srv.add("tenants", map[string]any{"id": 6, "name": "gitops-gone"})
+	srv.add("views", map[string]any{"id": 4, "path": "/old", "name": "gitops-old"})
+	srv.add("views", map[string]any{"id": 5, "path": "/manual", "name": "manual"})
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	opts := &Options{Prune: true, Marker: &Marker{Field: "name", Prefix: "gitops-"}, PruneKinds: []string{"View", "Tenant"}}
+	plan, err := BuildPlan(context.Background(), rest, nil, opts)
+	if err != nil {
+		t.Fatalf("BuildPlan: %v", err)
+	}
+	var deletes []string
+	for _, change := range plan.Changes {
+		deletes = append(deletes, change.String())
+	}
+	// Views depend on tenants, so they are deleted first.
+	if want := []string{"delete View/gitops-old", "delete Tenant/gitops-gone"}; strings.Join(deletes, ",") != strings.Join(want, ",") {
+		t.Fatalf("got %v, want %v", deletes, want)
+	}
+
+	opts.PruneKinds = []string{"Nope"}
+	if _, err := BuildPlan(context.Background(), rest, nil, opts); err == nil || !strings.Contains(err.Error(), `unknown kind "Nope"`) {
+		t.Fatalf("expected unknown prune kind error, got %v", err)
+	}
+}
+
+func TestBuildPlan_Errors(t *testing.T) {
+	srv := newTestServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTestRest(t, server)
+
+	tests := []struct {
+		name      string
+		manifests []Manifest
+		wantErr   string
+	}{
+		{"unknown kind", []Manifest{{Kind: "Nope", Identity: core.Params{"name": "a"}}}, `unknown kind "Nope"`},
+		{"cycle", []Manifest{
+			{Kind: "Tenant", Identity: core.Params{"name": "a"}, Spec: core.Params{"x": core.Params{refKey: "Tenant/b"}}},
+			{Kind: "Tenant", Identity: core.Params{"name": "b"}, Spec: core.Params{"x": core.Params{refKey: "Tenant/a"}}},
+		}, "dependency cycle"},
+		{"invalid ref", []Manifest{
+			{Kind: "Tenant", Identity: core.Params{"name": "a"}, Spec: core.Params{"x": core.Params{refKey: "Tenant"}}},
+		}, "invalid reference"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildPlan(context.Background(), rest, tt.manifests, nil)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestMarker_Matches(t *testing.T) {
+	record := core.Record{"name": "gitops-a", "tags": []any{"managed-by=gitops"}, "labels": map[string]any{"team": "x"}}
+	tests := []struct {
+		marker Marker
+		want   bool
+	}{
+		{Marker{Field: "name", Prefix: "gitops-"}, true},
+		{Marker{Field: "name", Value: "gitops-a"}, true},
+		{Marker{Field: "tags", Value: "managed-by=gitops"}, true},
+		{Marker{Field: "labels", Value: "team"}, true},
+		{Marker{Field: "labels", Value: "other"}, false},
+		{Marker{Field: "missing", Value: "x"}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.marker.Matches(record); got != tt.want {
+			t.Errorf("%+v.Matches() = %v, want %v", tt.marker, got, tt.want)
+		}
+	}
+}
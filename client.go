@@ -40,6 +40,9 @@ type (
 	// VMSRest is the default untyped client using map[string]any. Recommended for most use cases.
 	VMSRest = rest.UntypedVMSRest
 
+	// ClusterPool manages the clients of many clusters for fan-out queries and health checks.
+	ClusterPool = rest.ClusterPool
+
 	// VastResourceAPI defines standard CRUD operations for VAST resources.
 	VastResourceAPI = core.VastResourceAPI
 
@@ -79,3 +82,8 @@ func NewTypedVMSRest(config *VMSConfig) (*TypedVMSRest, error) {
 func NewVMSRest(config *VMSConfig) (*VMSRest, error) {
 	return rest.NewUntypedVMSRest(config)
 }
+
+// NewClusterPool creates an empty pool of cluster clients.
+func NewClusterPool() *ClusterPool {
+	return rest.NewClusterPool()
+}
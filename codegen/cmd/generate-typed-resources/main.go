@@ -3,6 +3,7 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -948,7 +949,7 @@ type ResourceData struct {
 	NestedTypes         []*NestedType
 	Resource            *vastparser.VastResource
 	HasSearchParams     bool // True if SearchParamsFields is not empty
-	HasExprSearchParams bool // True if any SearchParamsFields use expr.StrField / expr.IntField
+	HasExprSearchParams bool // True if any SearchParamsFields use expr.* field types
 	HasAsyncMethods     bool // True if any extra method is an async task
 	ReturnsTextPlain    bool // True if GET operation returns text/plain instead of JSON
 	HasTextPlainMethods bool // True if any method (main or extra) returns text/plain
@@ -985,6 +986,12 @@ type ResourceData struct {
 	// Array response support for Create/Update operations
 	CreateReturnsArray bool // True if Create operation returns an array
 	UpdateReturnsArray bool // True if Update operation returns an array
+	// Update by ID with a dedicated body, for resources without a regular typed Update
+	HasDetailsUpdate   bool     // True if Update takes UpdateBody and returns DetailsModel
+	UpdateBodyFields   []Field  // From PATCH/PUT /{resource}/{id}/ request body
+	UpdateBodyMethod   string   // HTTP method of the update by ID ("PATCH" or "PUT")
+	UpdateBodyGoMethod string   // Go constant of UpdateBodyMethod (e.g., "MethodPatch")
+	UpdateBodyURL      string   // Update by ID path (e.g., "/quotas/{id}/")
 	GenerationIssues   []string // List of issues encountered during generation
 }
 
@@ -1375,7 +1382,7 @@ func main() {
 		resourceData.SearchParamsFields = searchFields
 		resourceData.HasSearchParams = len(searchFields) > 0
 		for _, f := range resourceData.SearchParamsFields {
-			if f.Type == "expr.StrField" || f.Type == "expr.IntField" {
+			if strings.HasPrefix(f.Type, "expr.") {
 				resourceData.HasExprSearchParams = true
 				break
 			}
@@ -1580,6 +1587,30 @@ func main() {
 			}
 		}
 
+		// Generate UpdateBody from PATCH/PUT /{resource}/{id}/ request body.
+		// It is used when no regular typed Update can be generated (see generateResourceFile).
+		// Eligibility comes from the spec: the U operation may have been dropped above.
+		if resource.HasOperations() {
+			if updateMethod, updateURL := detailsUpdateOperation(&resource); updateMethod != "" {
+				updateRegistry := NewTypeRegistry()
+				updateFields, err := generateUpdateBodyFields(resource.Name, updateURL, updateMethod, updateRegistry)
+				if err != nil {
+					fmt.Printf("  ℹ️  No UpdateBody for %s %s: %v\n", updateMethod, updateURL, err)
+					if errors.Is(err, errMultipartOnlyBody) {
+						// Known exception, see "Generated Methods" in docs/DEVELOPER.md.
+						resourceData.GenerationIssues = append(resourceData.GenerationIssues,
+							fmt.Sprintf("UpdateBody skipped: %s %s: %v", updateMethod, updateURL, err))
+					}
+				} else if len(updateFields) > 0 {
+					resourceData.UpdateBodyFields = updateFields
+					resourceData.UpdateBodyMethod = updateMethod
+					resourceData.UpdateBodyGoMethod = httpMethodToGoConstant(updateMethod)
+					resourceData.UpdateBodyURL = updateURL
+					allNestedTypes = append(allNestedTypes, updateRegistry.GetTypes()...)
+				}
+			}
+		}
+
 		// Generate extra methods from apityped:extraMethod markers
 		for _, extraMethod := range resource.ExtraMethods {
 			extraMethodInfo, extraMethodNested, err := generateExtraMethodInfo(resource.Name, extraMethod)
@@ -1905,6 +1936,30 @@ func generateResourceFile(filename string, data ResourceData) error {
 	// Determine if we should use EditModel (UPDATE without CREATE)
 	data.UseEditModel = hasUpdate && !hasCreate
 
+	// Without a regular Update (e.g. the PATCH response has no schema), generate Update
+	// with the dedicated UpdateBody returning the DetailsModel
+	hasDetailsModel := data.DetailsModelIsAlias || len(data.DetailsModelFields) > 0
+	if !hasUpdate && len(data.UpdateBodyFields) > 0 && (hasList || hasRead) && hasDetailsModel {
+		if returnsAsyncTaskInResponse(data.UpdateBodyMethod, data.UpdateBodyURL) {
+			fmt.Printf("  ℹ️  Skipping Update with UpdateBody: %s %s returns AsyncTaskInResponse\n", data.UpdateBodyMethod, data.UpdateBodyURL)
+		} else {
+			data.HasDetailsUpdate = true
+			hasUpdate = true
+			if summary, err := api.GetOperationSummary(data.UpdateBodyMethod, data.UpdateBodyURL); err == nil && data.UpdateSummary == "" {
+				data.UpdateSummary = summary
+			}
+			fmt.Printf("  ✅ Update uses UpdateBody from %s %s and returns DetailsModel\n", data.UpdateBodyMethod, data.UpdateBodyURL)
+			// The UPDATE exclusion is superseded by the generated Update
+			var issues []string
+			for _, issue := range data.GenerationIssues {
+				if !strings.HasPrefix(issue, "UPDATE operation") {
+					issues = append(issues, issue)
+				}
+			}
+			data.GenerationIssues = issues
+		}
+	}
+
 	// Build CRUD operations string for template header comment
 	var ops []string
 	if hasCreate {
@@ -2476,18 +2531,25 @@ func IsEmptySchema(ref *openapi3.SchemaRef) bool {
 }
 
 // getGoTypeFromOpenAPI converts OpenAPI schema type to Go type
-// searchParamGoType maps OpenAPI types to expr.StrField / expr.IntField for SearchParams structs.
-// String and integer fields become typed expression fields that support Django-style query lookups.
-// All other types fall back to the regular Go type mapping.
+// searchParamGoType maps OpenAPI types to expr.* field types for SearchParams structs.
+// Strings, integers, numbers, booleans and date-time strings become typed expression fields
+// that support Django-style query lookups. All other types fall back to the regular Go type mapping.
 func searchParamGoType(schema *openapi3.Schema) string {
 	if schema == nil || schema.Type == nil || len(*schema.Type) == 0 {
 		return "expr.StrField" // default fallback to string expression
 	}
 	switch (*schema.Type)[0] {
 	case "string":
+		if schema.Format == "date-time" || schema.Format == "date" {
+			return "expr.TimeField"
+		}
 		return "expr.StrField"
 	case "integer":
 		return "expr.IntField"
+	case "number":
+		return "expr.FloatField"
+	case "boolean":
+		return "expr.BoolField"
 	default:
 		return getGoTypeFromOpenAPI(schema, false)
 	}
@@ -2569,7 +2631,7 @@ func generateSearchParamsFields(resourcePath, method string, registry *TypeRegis
 }
 
 // generateSearchParamsFromParameters generates search params fields from individual parameters.
-// Set useExprTypes=true for SearchParams structs (uses expr.StrField/expr.IntField),
+// Set useExprTypes=true for SearchParams structs (uses expr.* field types),
 // false for extra-method body/query params (uses plain Go types).
 func generateSearchParamsFromParameters(params []*openapi3.Parameter, resourcePath string, registry *TypeRegistry, useExprTypes ...bool) ([]Field, error) {
 	exprTypes := len(useExprTypes) > 0 && useExprTypes[0]
@@ -2668,6 +2730,62 @@ func generateRequestBodyFields(resourcePath, method string, registry *TypeRegist
 	return generateFieldsFromSchema(schema.Value, typeName, registry, false, "REQUEST BODY")
 }
 
+// detailsUpdateOperation returns the method and path of the update by ID operation of resource:
+// PATCH /{resource}/{id}/, or PUT when PATCH is not declared. The method is empty when neither is.
+func detailsUpdateOperation(resource *vastparser.VastResource) (string, string) {
+	baseURL := resource.GetOperationsURL()
+	if baseURL == "" {
+		return "", ""
+	}
+	updateURL := "/" + strings.Trim(baseURL, "/") + "/{id}/"
+	pathItem, err := api.GetOpenApiResource(updateURL)
+	if err != nil || pathItem == nil {
+		return "", ""
+	}
+	switch {
+	case pathItem.Patch != nil:
+		return http.MethodPatch, updateURL
+	case pathItem.Put != nil:
+		return http.MethodPut, updateURL
+	}
+	return "", ""
+}
+
+// errMultipartOnlyBody reports a request body declared only as multipart/form-data:
+// typed methods send JSON, so no typed Update is generated for it.
+var errMultipartOnlyBody = errors.New("request body is multipart/form-data only, typed Update sends JSON")
+
+// generateUpdateBodyFields generates the fields of the {Resource}UpdateBody struct
+// from the PATCH/PUT /{resource}/{id}/ request body
+func generateUpdateBodyFields(resourceName, resourcePath, method string, registry *TypeRegistry) ([]Field, error) {
+	schema, err := api.GetRequestBodySchema(method, resourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s request body schema for resource %q: %w", method, resourcePath, err)
+	}
+	if IsEmptySchema(schema) {
+		if isMultipartOnlyBody(method, resourcePath) {
+			return nil, errMultipartOnlyBody
+		}
+		return nil, fmt.Errorf("request body schema is empty for resource %q", resourcePath)
+	}
+	return generateFieldsFromSchema(schema.Value, resourceName+"UpdateBody", registry, false, "UPDATE BODY")
+}
+
+// isMultipartOnlyBody reports whether the method of resourcePath declares a
+// multipart/form-data request body and no JSON one.
+func isMultipartOnlyBody(method, resourcePath string) bool {
+	pathItem, err := api.GetOpenApiResource(resourcePath)
+	if err != nil || pathItem == nil {
+		return false
+	}
+	operation := pathItem.GetOperation(method)
+	if operation == nil || operation.RequestBody == nil || operation.RequestBody.Value == nil {
+		return false
+	}
+	content := operation.RequestBody.Value.Content
+	return content["multipart/form-data"] != nil && content["application/json"] == nil && content["*/*"] == nil
+}
+
 // generateModelFields generates model fields using method-based resolution
 func generateModelFields(resourcePath, method string, registry *TypeRegistry) ([]Field, error) {
 	var schema *openapi3.SchemaRef
@@ -85,6 +85,21 @@ func main() {
 		log.Fatalf("Failed to auto-discover extra methods: %v", err)
 	}
 
+	// Discover foreign-key relations from GET response schemas.
+	// Use +apirelation:NAME[:FIELD]=TYPE to add or override a relation
+	// and +apirelation:NAME=- to opt one out.
+	fmt.Println("Discovering relations from OpenAPI schema...")
+	if err := restParser.AutoDiscoverRelations(); err != nil {
+		log.Fatalf("Failed to discover relations: %v", err)
+	}
+	relationsFile := filepath.Join(outputDir, "relations_autogen.go")
+	if err := generateRelationsFile(relationsFile, configs); err != nil {
+		log.Fatalf("Failed to generate %s: %v", relationsFile, err)
+	}
+	if err := formatGeneratedFiles([]string{filepath.Base(relationsFile)}); err != nil {
+		log.Printf("Warning: Failed to format %s: %v", relationsFile, err)
+	}
+
 	// Convert configs to UntypedResource format
 	var allResources []vastparser.UntypedResource
 	for _, config := range configs {
@@ -696,6 +711,58 @@ func init() {
 	return nil
 }
 
+// generateRelationsFile generates a single file with init() registering all resource relations
+func generateRelationsFile(filename string, configs map[string]*vastparser.RestResourceConfig) error {
+	tmpl := `// Code generated by generate-untyped-resources. DO NOT EDIT.
+
+package untyped
+
+import "github.com/vast-data/go-vast-client/core"
+
+// This file registers foreign-key relations between resources (see core.ResolveRelations)
+// This information comes from the OpenAPI schema and +apirelation markers during code generation
+
+func init() {
+{{- range $i, $config := .}}
+{{- if $i}}
+{{end}}
+	// {{.Name}} relations
+	{{- $name := .Name}}
+	{{- range .Relations}}
+	core.RegisterRelation("{{$name}}", "{{.Name}}", "{{.Field}}", "{{.ResourceType}}")
+	{{- end}}
+{{- end}}
+}
+`
+
+	t, err := template.New("relations").Parse(tmpl)
+	if err != nil {
+		return fmt.Errorf("failed to parse relations template: %w", err)
+	}
+
+	var withRelations []*vastparser.RestResourceConfig
+	for _, config := range configs {
+		if len(config.Relations) > 0 {
+			withRelations = append(withRelations, config)
+		}
+	}
+	sort.Slice(withRelations, func(i, j int) bool {
+		return withRelations[i].Name < withRelations[j].Name
+	})
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("failed to create relations file: %w", err)
+	}
+	defer file.Close()
+
+	if err := t.Execute(file, withRelations); err != nil {
+		return fmt.Errorf("failed to execute relations template: %w", err)
+	}
+
+	return nil
+}
+
 // toSnakeCase converts CamelCase to snake_case
 func toSnakeCase(s string) string {
 	var result []rune
@@ -6,7 +6,7 @@ package typed
 import (
 	"context"
 {{if or .HasTextPlainMethods .ReturnsTextPlain .HasPrimitiveMethods .HasArrayMethods}}	"fmt"
-{{end}}{{if or .ExtraMethods .DeleteIsAsync .DeleteByIdIsAsync}}	"net/http"
+{{end}}{{if or .ExtraMethods .DeleteIsAsync .DeleteByIdIsAsync .HasDetailsUpdate}}	"net/http"
 {{end}}{{if .HasAsyncMethods}}	"time"
 {{end}}
 {{if or .HasAsyncMethods .ExtraMethods .HasTextPlainMethods .ReturnsTextPlain .HasArrayMethods}}
@@ -73,6 +73,32 @@ type {{.Name}} struct {
 	{{end}}
 }
 {{end}}{{end}}
+{{if .HasDetailsUpdate}}
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+{{range .NestedTypes}}{{if eq .Section "UPDATE BODY"}}
+// {{.Name}} represents a nested type for update body
+type {{.Name}} struct {
+	{{range .Fields}}{{.Name}} {{.Type}} `json:"{{.JSONTag}},omitempty" yaml:"{{.YAMLTag}},omitempty" required:"{{.RequiredTag}}"{{if .DocTag}} doc:"{{.DocTag}}"{{else}} doc:""{{end}}`
+	{{end}}
+}
+
+{{end}}{{end}}
+// {{.Name}}UpdateBody represents the request body for {{.Name}} Update operations
+// Generated from {{.UpdateBodyMethod}} request body for resource: {{.UpdateBodyURL}}
+type {{.Name}}UpdateBody struct {
+	{{range .UpdateBodyFields}}{{.Name}} {{.Type}} `json:"{{.JSONTag}},omitempty" yaml:"{{.YAMLTag}},omitempty" required:"{{.RequiredTag}}"{{if .DocTag}} doc:"{{.DocTag}}"{{else}} doc:""{{end}}`
+	{{end}}
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+{{end}}
 {{if or .HasList .HasRead .HasCreate .HasUpdate}}
 // -----------------------------------------------------
 // MODELS
@@ -390,6 +416,46 @@ func (r *{{.Name}}) UpdateWithContext(ctx context.Context, id any, req *{{.Name}
 {{end}}
 {{end}}
 {{end}}
+{{if .HasDetailsUpdate}}
+
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing {{.LowerName}} by ID and returns its details{{if .UpdateSummary}}
+// summary: {{.UpdateSummary}}{{end}}
+func (r *{{.Name}}) Update(id any, req *{{.Name}}UpdateBody) (*{{.Name}}DetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing {{.LowerName}} by ID and returns its details using provided context{{if .UpdateSummary}}
+// summary: {{.UpdateSummary}}{{end}}
+func (r *{{.Name}}) UpdateWithContext(ctx context.Context, id any, req *{{.Name}}UpdateBody) (*{{.Name}}DetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.{{.UpdateBodyGoMethod}}, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+{{if .HasRead}}
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+{{end}}
+	var response {{.Name}}DetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+{{end}}
 {{if .HasDelete}}
 
 // -----------------------------------------------------
@@ -19,6 +19,16 @@ type RestResourceConfig struct {
 	Operations     string                   // CRUD string (e.g., "RUD")
 	ExtraMethods   []apibuilder.ExtraMethod // Extra methods (manual +apiall: + auto-discovered)
 	ExcludeMethods []apibuilder.ExtraMethod // Exclusions from +apiexclude:extraMethod: annotations
+	Relations      []RestRelation           // Relations (manual +apirelation: + auto-discovered)
+	// ExcludeRelations are relation names opted out of auto-discovery with +apirelation:NAME=-
+	ExcludeRelations []string
+}
+
+// RestRelation is a foreign-key reference from a resource field to another resource type
+type RestRelation struct {
+	Name         string // Relation name (e.g., "tenant")
+	Field        string // Record field holding the id(s) (e.g., "tenant_id")
+	ResourceType string // Referenced type name (e.g., "Tenant")
 }
 
 // RestParser parses rest/untyped_rest.go to extract resource configurations
@@ -227,6 +237,110 @@ func (p *RestParser) AutoDiscoverExtraMethods() error {
 	return nil
 }
 
+// AutoDiscoverRelations inspects the GET response schema of every resource and registers
+// a relation for each "<name>_id" (scalar) or "<name>_ids" (list) property whose name matches
+// another resource type, e.g. "qos_policy_id" -> QosPolicy, "cnode_ids" -> Cnode.
+//
+// Discovered relations are merged with manual ones (from +apirelation: annotations, which win
+// on name conflicts) and filtered against exclusions (+apirelation:NAME=-).
+//
+// Call this after ParseRestFile.
+func (p *RestParser) AutoDiscoverRelations() error {
+	// Index resource types by normalized singular name: "VipPool" -> "vippool".
+	// Exact names are indexed first so that a singularized plural type name never shadows them.
+	types := make(map[string]string)
+	for name, config := range p.resourceConfigs {
+		if config.ResourcePath != "" {
+			types[normalizeRelationName(name)] = name
+		}
+	}
+	for name, config := range p.resourceConfigs {
+		singular := normalizeRelationName(singularize(name))
+		if _, exists := types[singular]; config.ResourcePath != "" && !exists {
+			types[singular] = name
+		}
+	}
+
+	for _, config := range p.resourceConfigs {
+		if config.ResourcePath == "" {
+			continue
+		}
+		schemaRef, err := api.GetResponseModelSchema("GET", "/"+config.ResourcePath+"/")
+		if err != nil {
+			continue // resource has no GET model
+		}
+		schema := api.ResolveAllRefs(schemaRef)
+		if schema == nil {
+			continue
+		}
+
+		known := make(map[string]bool)
+		for _, rel := range config.Relations {
+			known[rel.Name] = true
+		}
+		for _, name := range config.ExcludeRelations {
+			known[name] = true
+		}
+
+		var discovered []RestRelation
+		for prop, propRef := range schema.Properties {
+			var name, prefix string
+			switch {
+			case strings.HasSuffix(prop, "_ids"):
+				if propRef.Value == nil || !propRef.Value.Type.Is("array") {
+					continue
+				}
+				name = strings.TrimSuffix(prop, "_ids")
+				prefix = singularize(name)
+				if prefix == name {
+					name += "s" // "cnode_ids" -> "cnodes", "s3_policies_ids" -> "s3_policies"
+				}
+			case strings.HasSuffix(prop, "_id"):
+				if propRef.Value == nil || !(propRef.Value.Type.Is("integer") || propRef.Value.Type.Is("string")) {
+					continue
+				}
+				prefix = strings.TrimSuffix(prop, "_id")
+				name = prefix
+			default:
+				continue
+			}
+			target, ok := types[normalizeRelationName(prefix)]
+			if !ok || known[name] {
+				continue
+			}
+			discovered = append(discovered, RestRelation{Name: name, Field: prop, ResourceType: target})
+			known[name] = true
+		}
+
+		config.Relations = append(config.Relations, discovered...)
+		sort.Slice(config.Relations, func(i, j int) bool {
+			return config.Relations[i].Name < config.Relations[j].Name
+		})
+	}
+
+	return nil
+}
+
+// normalizeRelationName lowercases s and drops underscores: "qos_policy" -> "qospolicy".
+func normalizeRelationName(s string) string {
+	return strings.ToLower(strings.ReplaceAll(s, "_", ""))
+}
+
+// singularize returns a naive singular form: "policies" -> "policy", "cboxes" -> "cbox", "cnodes" -> "cnode".
+func singularize(s string) string {
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		return strings.TrimSuffix(s, "ies") + "y"
+	case strings.HasSuffix(s, "xes"):
+		return strings.TrimSuffix(s, "es")
+	case strings.HasSuffix(s, "ss"):
+		return s
+	case strings.HasSuffix(s, "s"):
+		return strings.TrimSuffix(s, "s")
+	}
+	return s
+}
+
 // isStandardCRUDPath returns true if the path is the standard collection list/create
 // path or the standard single-resource path (with {id}).
 func isStandardCRUDPath(path, collectionPath string) bool {
@@ -316,6 +430,34 @@ func (p *RestParser) parseFieldMarkers(file *ast.File) {
 					}
 				}
 
+				// +apirelation:NAME[:FIELD]=TYPE  – manually specified relation (FIELD defaults to NAME_id)
+				// +apirelation:NAME=-             – opt specific relation out of auto-discovery
+				if strings.HasPrefix(text, "+apirelation:") {
+					marker := strings.TrimPrefix(text, "+apirelation:")
+					parts := strings.SplitN(marker, "=", 2)
+					if len(parts) != 2 {
+						continue
+					}
+					name, field, _ := strings.Cut(strings.TrimSpace(parts[0]), ":")
+					target := strings.TrimSpace(parts[1])
+					if name == "" || target == "" {
+						continue
+					}
+					config := p.findOrCreateConfigByTypeName(typeName)
+					if target == "-" {
+						config.ExcludeRelations = append(config.ExcludeRelations, name)
+						continue
+					}
+					if field == "" {
+						field = name + "_id"
+					}
+					config.Relations = append(config.Relations, RestRelation{
+						Name:         name,
+						Field:        field,
+						ResourceType: target,
+					})
+				}
+
 				// +apiexclude:extraMethod:METHOD=/path/  – opt specific method out of auto-discovery
 				if strings.HasPrefix(text, "+apiexclude:extraMethod:") {
 					marker := strings.TrimPrefix(text, "+apiexclude:extraMethod:")
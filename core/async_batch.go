@@ -0,0 +1,276 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ######################################################
+//              BATCH WAITING FOR ASYNC TASKS
+// ######################################################
+
+// WaitOptions configures WaitAllWithOptions and WaitAnyWithOptions.
+type WaitOptions struct {
+	// Poll controls the timeout and the polling backoff (nil uses the WaitAPIConditionConfig defaults).
+	Poll *WaitAPIConditionConfig
+	// OnProgress is called whenever the state of a task changes or it reports new messages.
+	OnProgress func(TaskProgress)
+	// RetryStates are the failure states (e.g. "failed") retried with VTaskRetry_PATCH.
+	RetryStates []string
+	// MaxRetries is the number of retries per task (default 1 when RetryStates is set).
+	MaxRetries int
+}
+
+// TaskProgress reports a change of a polled task.
+type TaskProgress struct {
+	Result        *AsyncResult
+	Name          string
+	State         string
+	PreviousState string   // empty on the first report
+	Messages      []string // messages added since the previous report
+	Retries       int      // retries performed so far
+	Task          Record   // the polled task record
+}
+
+// taskWatch is the polling state of one AsyncResult.
+type taskWatch struct {
+	result   *AsyncResult
+	state    string
+	messages int
+	retries  int
+	done     bool
+}
+
+// WaitAll waits until all tasks complete or fail, polling them together with one
+// vtasks list call (id__in) per interval. Success, Err and Task of each AsyncResult
+// are updated; the returned error joins the errors of the failed tasks.
+//
+//	clones := []*core.AsyncResult{...}
+//	if err := core.WaitAll(ctx, clones...); err != nil {
+//	    log.Printf("some clones failed: %v", err)
+//	}
+func WaitAll(ctx context.Context, results ...*AsyncResult) error {
+	return WaitAllWithOptions(ctx, nil, results...)
+}
+
+// WaitAllWithOptions is WaitAll with progress callbacks, retries and polling options:
+//
+//	err := core.WaitAllWithOptions(ctx, &core.WaitOptions{
+//	    Poll:        &core.WaitAPIConditionConfig{Timeout: 30 * time.Minute},
+//	    RetryStates: []string{"failed"},
+//	    OnProgress: func(p core.TaskProgress) {
+//	        log.Printf("task %d %s -> %s %v", p.Result.TaskId, p.PreviousState, p.State, p.Messages)
+//	    },
+//	}, results...)
+func WaitAllWithOptions(ctx context.Context, opts *WaitOptions, results ...*AsyncResult) error {
+	watches, err := waitTasks(ctx, opts, results, false)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, watch := range watches {
+		if watch.result.Err != nil {
+			errs = append(errs, watch.result.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// WaitAny waits until one of the tasks completes or fails and returns it.
+// The error is the error of that task, or a timeout/cancellation error.
+func WaitAny(ctx context.Context, results ...*AsyncResult) (*AsyncResult, error) {
+	return WaitAnyWithOptions(ctx, nil, results...)
+}
+
+// WaitAnyWithOptions is WaitAny with progress callbacks, retries and polling options.
+func WaitAnyWithOptions(ctx context.Context, opts *WaitOptions, results ...*AsyncResult) (*AsyncResult, error) {
+	watches, err := waitTasks(ctx, opts, results, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, watch := range watches {
+		if watch.done {
+			return watch.result, watch.result.Err
+		}
+	}
+	return nil, errors.New("no task to wait for")
+}
+
+// waitTasks polls the tasks until all of them (or the first one when first is set) are done.
+// It only returns an error when polling itself fails.
+func waitTasks(ctx context.Context, opts *WaitOptions, results []*AsyncResult, first bool) ([]*taskWatch, error) {
+	if opts == nil {
+		opts = &WaitOptions{}
+	}
+	poll := WaitAPIConditionConfig{}
+	if opts.Poll != nil {
+		poll = *opts.Poll
+	}
+	poll.normalize()
+	maxRetries := opts.MaxRetries
+	if maxRetries == 0 && len(opts.RetryStates) > 0 {
+		maxRetries = 1
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	clock := poll.clock()
+	deadline := clock.Now().Add(poll.Timeout)
+	timeoutCtx, cancel := context.WithTimeout(ctx, poll.Timeout)
+	defer cancel()
+
+	watches := make([]*taskWatch, 0, len(results))
+	for _, result := range results {
+		if result != nil {
+			watches = append(watches, &taskWatch{result: result})
+		}
+	}
+	if len(watches) == 0 {
+		return watches, nil
+	}
+
+	for {
+		byRest := map[VastRest][]*taskWatch{}
+		for _, watch := range watches {
+			if !watch.done {
+				byRest[watch.result.Rest] = append(byRest[watch.result.Rest], watch)
+			}
+		}
+		for rest, pending := range byRest {
+			if err := pollTasks(timeoutCtx, rest, pending, opts, maxRetries); err != nil {
+				if timeoutCtx.Err() == nil {
+					return nil, err
+				}
+				break
+			}
+		}
+
+		finished, remaining := 0, 0
+		for _, watch := range watches {
+			if watch.done {
+				finished++
+			} else {
+				remaining++
+			}
+		}
+		if remaining == 0 || (first && finished > 0) {
+			return watches, nil
+		}
+
+		sleepFor := poll.NextInterval()
+		if remaining := deadline.Sub(clock.Now()); sleepFor > remaining {
+			sleepFor = remaining
+		}
+		if sleepContext(timeoutCtx, clock, sleepFor) != nil || !clock.Now().Before(deadline) {
+			var waitErr error
+			if ctx.Err() != nil {
+				waitErr = fmt.Errorf("wait cancelled: %w", ctx.Err())
+			} else {
+				waitErr = fmt.Errorf("wait timeout after %v", poll.Timeout)
+			}
+			if first {
+				return nil, waitErr
+			}
+			for _, watch := range watches {
+				if !watch.done {
+					watch.result.Success = false
+					watch.result.Err = fmt.Errorf("task %d: %w", watch.result.TaskId, waitErr)
+				}
+			}
+			return watches, nil
+		}
+	}
+}
+
+// pollTasks fetches the pending tasks of one client with a single list call and updates them.
+func pollTasks(ctx context.Context, rest VastRest, pending []*taskWatch, opts *WaitOptions, maxRetries int) error {
+	vtasks, ok := rest.GetResourceMap()[VTaskKey]
+	if !ok {
+		return fmt.Errorf("resource %s not found", VTaskKey)
+	}
+	ids := make([]int64, 0, len(pending))
+	for _, watch := range pending {
+		ids = append(ids, watch.result.TaskId)
+	}
+	records, err := vtasks.ListWithContext(ctx, Params{"id__in": ids})
+	if err != nil {
+		return fmt.Errorf("failed to poll tasks %v: %w", ids, err)
+	}
+	byId := make(map[int64]Record, len(records))
+	for _, record := range records {
+		byId[record.RecordID()] = record
+	}
+
+	for _, watch := range pending {
+		record, found := byId[watch.result.TaskId]
+		if !found {
+			watch.finish(false, fmt.Errorf("task %d not found", watch.result.TaskId))
+			continue
+		}
+		watch.result.Task = record
+		state := strings.ToLower(fmt.Sprintf("%v", record["state"]))
+		watch.report(opts, record, state)
+
+		switch state {
+		case "completed":
+			watch.finish(true, nil)
+		case "running":
+		default:
+			if watch.retries < maxRetries && containsFold(opts.RetryStates, state) {
+				watch.retries++
+				retryPath := BuildResourcePathWithID(vtasks.GetResourcePath(), watch.result.TaskId, "retry")
+				if _, err = Request[Record](ctx, vtasks, http.MethodPatch, retryPath, nil, nil); err != nil {
+					watch.finish(false, fmt.Errorf("failed to retry task %d: %w", watch.result.TaskId, err))
+				}
+				continue
+			}
+			watch.finish(false, vtaskFailure(record, state))
+		}
+	}
+	return nil
+}
+
+// report calls OnProgress when the task state changed or it has new messages.
+func (w *taskWatch) report(opts *WaitOptions, record Record, state string) {
+	messages, _ := record["messages"].([]any)
+	if len(messages) < w.messages {
+		// Messages were reset (e.g. the task was retried).
+		w.messages = 0
+	}
+	if state == w.state && len(messages) == w.messages {
+		return
+	}
+	progress := TaskProgress{
+		Result:        w.result,
+		Name:          record.RecordName(),
+		State:         state,
+		PreviousState: w.state,
+		Retries:       w.retries,
+		Task:          record,
+	}
+	for _, message := range messages[w.messages:] {
+		progress.Messages = append(progress.Messages, fmt.Sprintf("%v", message))
+	}
+	w.state, w.messages = state, len(messages)
+	if opts.OnProgress != nil {
+		opts.OnProgress(progress)
+	}
+}
+
+func (w *taskWatch) finish(success bool, err error) {
+	w.done = true
+	w.result.Success = success
+	w.result.Err = err
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,146 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeVTasks serves GET /vtasks/?id__in=... and PATCH /vtasks/{id}/retry/ from per-task state scripts.
+type fakeVTasks struct {
+	mu      sync.Mutex
+	states  map[string][]string // remaining states per task id; the last one sticks
+	lists   int
+	retried []string
+}
+
+func (f *fakeVTasks) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	if r.Method == http.MethodPatch && strings.HasSuffix(r.URL.Path, "/retry/") {
+		id := strings.Split(strings.TrimSuffix(r.URL.Path, "/retry/"), "/")
+		f.retried = append(f.retried, id[len(id)-1])
+		_ = json.NewEncoder(w).Encode(map[string]any{})
+		return
+	}
+	f.lists++
+	var tasks []any
+	for _, id := range strings.Split(r.URL.Query().Get("id__in"), ",") {
+		states, ok := f.states[id]
+		if !ok {
+			continue
+		}
+		state := states[0]
+		if len(states) > 1 {
+			f.states[id] = states[1:]
+		}
+		var messages []any
+		for i := 0; i < f.lists; i++ {
+			messages = append(messages, "step "+id)
+		}
+		tasks = append(tasks, map[string]any{
+			"id": json.Number(id), "name": "task" + id, "state": state, "messages": messages,
+			"url": "https://l101/api/v5/vtasks/" + id + "/",
+		})
+	}
+	_ = json.NewEncoder(w).Encode(tasks)
+}
+
+func newFakeVTasks(t *testing.T, states map[string][]string) (*fakeVTasks, VastRest) {
+	fake := &fakeVTasks{states: states}
+	server := httptest.NewTLSServer(fake)
+	t.Cleanup(server.Close)
+	return fake, newBulkTestResource(t, server, "vtasks", VTaskKey).Rest
+}
+
+func fastPoll() *WaitAPIConditionConfig {
+	return &WaitAPIConditionConfig{Timeout: 2 * time.Second, Interval: time.Millisecond, MaxInterval: 5 * time.Millisecond}
+}
+
+func TestWaitAll(t *testing.T) {
+	fake, rest := newFakeVTasks(t, map[string][]string{
+		"1": {"running", "completed"},
+		"2": {"failed", "running", "completed"},
+		"3": {"running", "failed"},
+	})
+	ctx := context.Background()
+	results := []*AsyncResult{NewAsyncResult(ctx, 1, rest), NewAsyncResult(ctx, 2, rest), NewAsyncResult(ctx, 3, rest)}
+
+	var progress []string
+	err := WaitAllWithOptions(ctx, &WaitOptions{
+		Poll:        fastPoll(),
+		RetryStates: []string{"failed"},
+		OnProgress: func(p TaskProgress) {
+			if p.Result.TaskId == 1 {
+				progress = append(progress, p.PreviousState+">"+p.State+" "+strings.Join(p.Messages, ","))
+			}
+		},
+	}, results...)
+
+	if !results[0].Success || !results[1].Success || results[2].Success {
+		t.Errorf("success = %v %v %v", results[0].Success, results[1].Success, results[2].Success)
+	}
+	if err == nil || !errors.Is(err, results[2].Err) || !strings.Contains(err.Error(), "state=failed") {
+		t.Errorf("expected joined error of task 3, got %v", err)
+	}
+	if strings.Join(fake.retried, ",") != "2,3" {
+		t.Errorf("retried = %v", fake.retried)
+	}
+	if fake.lists != 3 {
+		t.Errorf("tasks must be polled together, got %d list calls", fake.lists)
+	}
+	want := []string{">running step 1", "running>completed step 1"}
+	if strings.Join(progress, "|") != strings.Join(want, "|") {
+		t.Errorf("progress = %q, want %q", progress, want)
+	}
+	if results[0].Task.RecordName() != "task1" {
+		t.Errorf("task record = %v", results[0].Task)
+	}
+}
+
+func TestWaitAll_Timeout(t *testing.T) {
+	_, rest := newFakeVTasks(t, map[string][]string{"1": {"completed"}, "2": {"running"}})
+	ctx := context.Background()
+	results := []*AsyncResult{NewAsyncResult(ctx, 1, rest), NewAsyncResult(ctx, 2, rest), NewAsyncResult(ctx, 9, rest)}
+
+	poll := fastPoll()
+	poll.Timeout = 50 * time.Millisecond
+	err := WaitAllWithOptions(ctx, &WaitOptions{Poll: poll}, results...)
+	if !results[0].Success {
+		t.Errorf("task 1 must succeed: %v", results[0].Err)
+	}
+	if results[1].Err == nil || !strings.Contains(results[1].Err.Error(), "timeout") {
+		t.Errorf("task 2 must time out, got %v", results[1].Err)
+	}
+	if results[2].Err == nil || !strings.Contains(results[2].Err.Error(), "not found") {
+		t.Errorf("task 9 must not be found, got %v", results[2].Err)
+	}
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestWaitAny(t *testing.T) {
+	_, rest := newFakeVTasks(t, map[string][]string{
+		"1": {"running"},
+		"2": {"running", "running", "completed"},
+	})
+	ctx := context.Background()
+	first, err := WaitAnyWithOptions(ctx, &WaitOptions{Poll: fastPoll()}, NewAsyncResult(ctx, 1, rest), NewAsyncResult(ctx, 2, rest))
+	if err != nil || first == nil || first.TaskId != 2 || !first.Success {
+		t.Errorf("WaitAny = %+v, %v", first, err)
+	}
+
+	poll := fastPoll()
+	poll.Timeout = 20 * time.Millisecond
+	if _, err = WaitAnyWithOptions(ctx, &WaitOptions{Poll: poll}, NewAsyncResult(ctx, 1, rest)); err == nil || !strings.Contains(err.Error(), "timeout") {
+		t.Errorf("expected timeout, got %v", err)
+	}
+}
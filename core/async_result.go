@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 )
@@ -18,12 +19,14 @@ const VTaskKey = "VTask"
 //   - Ctx: The context associated with the task operation
 //   - Success: True if task completed successfully, false if failed
 //   - Err: The error that occurred during task execution (nil if successful)
+//   - Task: The last polled task record (set by Wait, WaitAll and WaitAny)
 type AsyncResult struct {
 	TaskId  int64
 	Rest    VastRest
 	Ctx     context.Context
 	Success bool
 	Err     error
+	Task    Record
 }
 
 // IsFailed returns true if the task failed during execution.
@@ -96,17 +99,7 @@ func (ar *AsyncResult) Wait(timeout time.Duration) (Record, error) {
 				return false, nil
 			default:
 				// Task failed or in unexpected state
-				rawMessages := record["messages"]
-				messages, ok := rawMessages.([]any)
-				if !ok || len(messages) == 0 {
-					taskErr := fmt.Errorf("task %s failed with ID %d: state=%s, no messages or unexpected format",
-						record.RecordName(), record.RecordID(), state)
-					return false, taskErr
-				}
-				lastMsg := fmt.Sprintf("%v", messages[len(messages)-1])
-				taskErr := fmt.Errorf("task %s failed with ID %d: state=%s, message: %s",
-					record.RecordName(), record.RecordID(), state, lastMsg)
-				return false, taskErr
+				return false, vtaskFailure(record, state)
 			}
 		},
 	)
@@ -118,11 +111,24 @@ func (ar *AsyncResult) Wait(timeout time.Duration) (Record, error) {
 	} else {
 		ar.Success = true
 		ar.Err = nil
+		ar.Task = record
 	}
 
 	return record, err
 }
 
+// vtaskFailure returns the error of a task that ended in a failure state.
+func vtaskFailure(record Record, state string) error {
+	messages, ok := record["messages"].([]any)
+	if !ok || len(messages) == 0 {
+		return fmt.Errorf("task %s failed with ID %d: state=%s, no messages or unexpected format",
+			record.RecordName(), record.RecordID(), state)
+	}
+	lastMsg := fmt.Sprintf("%v", messages[len(messages)-1])
+	return fmt.Errorf("task %s failed with ID %d: state=%s, message: %s",
+		record.RecordName(), record.RecordID(), state, lastMsg)
+}
+
 // MaybeAsyncResultFromRecord attempts to extract an async task ID from a record and create an AsyncResult.
 //
 // This function handles two common patterns in VAST API responses:
@@ -197,6 +203,45 @@ type WaitAPIConditionConfig struct {
 	Interval      time.Duration // Current/initial polling interval (mutated by NextInterval)
 	MaxInterval   time.Duration // Cap for exponential backoff
 	BackoffFactor float64       // Rate of interval increase (0.25 = 25% per iteration)
+	Clock         Clock         // Time source for deadlines and sleeps (nil uses the system clock)
+	// AcceptNotFound passes a missing object to verifyFn as a nil record instead of failing
+	// with the not-found error (set by WaitFor, required by UntilDeleted).
+	AcceptNotFound bool
+}
+
+// Clock is the time source of polling loops. Tests inject a fake clock so that
+// waits complete instantly.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// SystemClock is the Clock backed by the time package.
+var SystemClock Clock = systemClock{}
+
+func (c *WaitAPIConditionConfig) clock() Clock {
+	if c.Clock == nil {
+		return SystemClock
+	}
+	return c.Clock
+}
+
+// sleepContext sleeps for d on clock, returning early with the context error when ctx is done.
+func sleepContext(ctx context.Context, clock Clock, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-clock.After(d):
+		return nil
+	}
 }
 
 // normalize fills in missing (zero) values with sensible defaults.
@@ -265,6 +310,10 @@ func (c *WaitAPIConditionConfig) NextInterval() time.Duration {
 //   - waitAPIConditionConfig: Configuration for timeout, intervals, and backoff (nil uses defaults)
 //   - verifyFn: Function that checks if the condition is met. Returns (true, nil) when complete,
 //     (false, nil) to continue polling, or (false, error) to abort with error.
+//     When the object is not found the not-found error is returned, unless AcceptNotFound is set:
+//     verifyFn is then called with a nil record and returning true ends the wait successfully
+//     (see UntilDeleted), otherwise the not-found error is returned.
+//     The predicates of wait_predicates.go (FieldEquals, All, ...) can be used as verifyFn.
 //
 // Returns:
 //   - Record: The final record when the condition is met
@@ -275,6 +324,8 @@ func (c *WaitAPIConditionConfig) NextInterval() time.Duration {
 //   - Initial Interval: 500ms
 //   - Max Interval: 30 seconds
 //   - Backoff Factor: 0.25 (25% increase per iteration)
+//
+// Deadlines and sleeps use waitAPIConditionConfig.Clock; sleeps end as soon as ctx is cancelled.
 func WaitAPICondition(
 	ctx context.Context,
 	caller VastResourceAPIWithContext,
@@ -288,48 +339,72 @@ func WaitAPICondition(
 	}
 	waitAPIConditionConfig.normalize()
 
+	clock := waitAPIConditionConfig.clock()
+	deadline := clock.Now().Add(waitAPIConditionConfig.Timeout)
+
 	// Create a timeout context using the configured timeout
 	timeoutCtx, cancel := context.WithTimeout(ctx, waitAPIConditionConfig.Timeout)
 	defer cancel()
+	timeoutErr := func() error {
+		// Check if it's a timeout or cancellation
+		if ctx.Err() != nil {
+			return fmt.Errorf("WaitAPICondition cancelled: %w", ctx.Err())
+		}
+		return fmt.Errorf("WaitAPICondition timeout after %v", waitAPIConditionConfig.Timeout)
+	}
 
 	// Polling loop with exponential backoff
 	for {
-		select {
-		case <-timeoutCtx.Done():
-			// Check if it's a timeout or cancellation
-			if ctx.Err() != nil {
-				return nil, fmt.Errorf("WaitAPICondition cancelled: %w", ctx.Err())
-			}
-			return nil, fmt.Errorf("WaitAPICondition timeout after %v", waitAPIConditionConfig.Timeout)
-
-		default:
-			var (
-				record Record
-				err    error
-			)
-
-			// Use GetById if "id" parameter is present, otherwise use Get with search params
-			if id, ok := searchParams["id"]; ok {
-				record, err = caller.GetByIdWithContext(timeoutCtx, id)
-			} else {
-				record, err = caller.GetWithContext(timeoutCtx, searchParams)
+		if timeoutCtx.Err() != nil || !clock.Now().Before(deadline) {
+			return nil, timeoutErr()
+		}
+		var (
+			record Record
+			err    error
+		)
+
+		// Use GetById if "id" parameter is present, otherwise use Get with search params
+		if id, ok := searchParams["id"]; ok {
+			record, err = caller.GetByIdWithContext(timeoutCtx, id)
+		} else {
+			record, err = caller.GetWithContext(timeoutCtx, searchParams)
+		}
+		if err != nil {
+			if timeoutCtx.Err() != nil {
+				return nil, timeoutErr()
 			}
-			if err != nil {
+			// With AcceptNotFound a missing object is passed to verifyFn as a nil record:
+			// predicates such as UntilDeleted report it as success.
+			notFound := IsNotFoundErr(err) || ExpectStatusCodes(err, http.StatusNotFound)
+			if !notFound || !waitAPIConditionConfig.AcceptNotFound {
 				return nil, fmt.Errorf("WaitAPICondition API call failed: %w", err)
 			}
-
-			// Check if condition is met
-			completed, err := verifyFn(record)
-			if err != nil {
-				return nil, fmt.Errorf("WaitAPICondition verification failed: %w", err)
+			completed, verifyErr := verifyFn(nil)
+			if verifyErr != nil {
+				return nil, fmt.Errorf("WaitAPICondition verification failed: %w", verifyErr)
 			}
-			if completed {
-				return record, nil
+			if !completed {
+				return nil, fmt.Errorf("WaitAPICondition API call failed: %w", err)
 			}
+			return nil, nil
+		}
 
-			// Sleep for current interval, then bump interval for next iteration
-			sleepFor := waitAPIConditionConfig.NextInterval()
-			time.Sleep(sleepFor)
+		// Check if condition is met
+		completed, err := verifyFn(record)
+		if err != nil {
+			return nil, fmt.Errorf("WaitAPICondition verification failed: %w", err)
+		}
+		if completed {
+			return record, nil
+		}
+
+		// Sleep for current interval (bounded by the deadline), then bump interval for next iteration
+		sleepFor := waitAPIConditionConfig.NextInterval()
+		if remaining := deadline.Sub(clock.Now()); sleepFor > remaining {
+			sleepFor = remaining
+		}
+		if err = sleepContext(timeoutCtx, clock, sleepFor); err != nil {
+			return nil, timeoutErr()
 		}
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/url"
@@ -76,7 +77,7 @@ func createAuthenticator(config *VMSConfig) (Authenticator, error) {
 		return authenticator, nil
 	}
 
-	panic("CreateAuthenticator: neither username/password nor apiToken are provided")
+	return nil, errors.New("neither username/password nor apiToken are provided")
 }
 
 type jwtToken struct {
@@ -344,7 +345,8 @@ func (auth *JWTAuthenticator) equal(other Authenticator) bool {
 		auth.Host == otherAuth.Host &&
 		auth.Port == otherAuth.Port &&
 		auth.Tenant == otherAuth.Tenant &&
-		auth.SslVerify == otherAuth.SslVerify
+		auth.SslVerify == otherAuth.SslVerify &&
+		auth.RespectProxy == otherAuth.RespectProxy
 }
 
 func (auth *JWTAuthenticator) setInitialized(state bool) {
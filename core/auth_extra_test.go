@@ -9,6 +9,25 @@ import (
 	"testing"
 )
 
+func TestJWTAuthenticator_EqualSeparatesIdentities(t *testing.T) {
+	newAuth := func(tenant string, respectProxy bool) *JWTAuthenticator {
+		return &JWTAuthenticator{
+			Host: "vms", Port: 443, Username: "admin", Password: "pw",
+			Tenant: tenant, RespectProxy: respectProxy,
+		}
+	}
+	base := newAuth("", false)
+	if !base.equal(newAuth("", false)) {
+		t.Fatal("expected equal authenticators")
+	}
+	if base.equal(newAuth("tenant-a", false)) {
+		t.Fatal("expected tenants to separate identities")
+	}
+	if base.equal(newAuth("", true)) {
+		t.Fatal("expected RespectProxy to separate authenticators")
+	}
+}
+
 func TestApiRTokenAuthenticator_SetAuthHeaderAndEqual(t *testing.T) {
 	auth := &ApiRTokenAuthenticator{
 		Host:      "vms.example.com",
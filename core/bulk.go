@@ -0,0 +1,598 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBulkConcurrency is the number of parallel requests used by bulk helpers
+// when BulkOptions.Concurrency is not set.
+const DefaultBulkConcurrency = 8
+
+// ErrBulkItemSkipped marks items that were never sent because a previous item failed
+// and BulkOptions.FailFast was enabled (or the context was canceled).
+var ErrBulkItemSkipped = errors.New("bulk item skipped")
+
+// nativeBulkVerbs lists resources whose VMS collection-level "bulk" endpoint accepts
+// a plain list of ids and can therefore replace per-item requests transparently.
+// Endpoint paths are resolved through ExtraMethodRegistry (<Type>Bulk_<VERB>).
+//
+// TenantBulk_POST and ComputeClusterBulk_POST are intentionally absent: they target
+// nested sub-collections (metric label values, tenant associations) and do not create
+// or modify the resource itself. BlockHostMappingBulk_PATCH takes host/volume pairs
+// instead of ids.
+var nativeBulkVerbs = map[string][]string{
+	"Volume":    {http.MethodDelete},
+	"BlockHost": {http.MethodDelete},
+	"Switch":    {http.MethodPatch},
+}
+
+// BulkOptions controls execution of BulkCreate, BulkUpdate and BulkDelete.
+//
+// Example:
+//
+//	result, err := rest.Quotas.BulkCreate(bodies, &core.BulkOptions{
+//	    Concurrency: 16,
+//	    LockKey: func(_ int, _ any, body core.Params) []any {
+//	        return []any{body["path"]}
+//	    },
+//	})
+type BulkOptions struct {
+	// Concurrency is the maximum number of in-flight requests (default: DefaultBulkConcurrency).
+	Concurrency int
+	// FailFast stops scheduling new items after the first failure.
+	// Remaining items are reported with ErrBulkItemSkipped.
+	// By default all items are attempted (continue-on-error).
+	FailFast bool
+	// LockKey optionally returns keys used to lock each item with the resource KeyLocker
+	// for the duration of its request. Returning nil disables locking for that item.
+	LockKey func(index int, id any, body Params) []any
+	// WaitTimeout, when > 0, waits for async tasks returned by the API (see MaybeAsyncResultFromRecord).
+	// The record of each item is replaced with the final task record.
+	WaitTimeout time.Duration
+	// DisableNative forces per-item requests even if VMS provides a native bulk endpoint.
+	DisableNative bool
+}
+
+func (o *BulkOptions) normalize() *BulkOptions {
+	out := BulkOptions{}
+	if o != nil {
+		out = *o
+	}
+	if out.Concurrency <= 0 {
+		out.Concurrency = DefaultBulkConcurrency
+	}
+	return &out
+}
+
+// BulkUpdateItem is a single update (PATCH) request for BulkUpdate.
+type BulkUpdateItem struct {
+	ID   any
+	Body Params
+}
+
+// BulkItemResult holds the outcome of a single bulk item.
+// Index always refers to the position of the item in the input slice.
+type BulkItemResult struct {
+	Index  int
+	ID     any
+	Record Record
+	Err    error
+	// Native is true when the item was processed by a native VMS bulk endpoint.
+	Native bool
+}
+
+// Skipped reports whether the item was never sent.
+func (r BulkItemResult) Skipped() bool {
+	return errors.Is(r.Err, ErrBulkItemSkipped)
+}
+
+// BulkResult contains one BulkItemResult per input item, in input order.
+type BulkResult []BulkItemResult
+
+// Records returns records of successful items in input order.
+func (r BulkResult) Records() RecordSet {
+	out := make(RecordSet, 0, len(r))
+	for _, item := range r {
+		if item.Err == nil && item.Record != nil {
+			out = append(out, item.Record)
+		}
+	}
+	return out
+}
+
+// Failed returns items that failed or were skipped.
+func (r BulkResult) Failed() BulkResult {
+	var out BulkResult
+	for _, item := range r {
+		if item.Err != nil {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// Succeeded returns the number of items that completed without error.
+func (r BulkResult) Succeeded() int {
+	n := 0
+	for _, item := range r {
+		if item.Err == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// BulkItemError wraps the error of a single bulk item with its position and id.
+type BulkItemError struct {
+	Index int
+	ID    any
+	Err   error
+}
+
+func (e *BulkItemError) Error() string {
+	if e.ID != nil {
+		return fmt.Sprintf("item %d (id=%v): %v", e.Index, e.ID, e.Err)
+	}
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e *BulkItemError) Unwrap() error {
+	return e.Err
+}
+
+// BulkError aggregates failures of a bulk operation.
+// It supports errors.Is / errors.As against any of the underlying item errors:
+//
+//	var apiErr *core.ApiError
+//	if errors.As(err, &apiErr) { ... }
+type BulkError struct {
+	Op           string
+	ResourceType string
+	Total        int
+	Errors       []*BulkItemError
+}
+
+func (e *BulkError) Error() string {
+	var sb strings.Builder
+	skipped := 0
+	for _, itemErr := range e.Errors {
+		if errors.Is(itemErr.Err, ErrBulkItemSkipped) {
+			skipped++
+		}
+	}
+	fmt.Fprintf(&sb, "bulk %s %s: %d of %d items failed", e.Op, e.ResourceType, len(e.Errors)-skipped, e.Total)
+	if skipped > 0 {
+		fmt.Fprintf(&sb, " (%d skipped)", skipped)
+	}
+	for _, itemErr := range e.Errors {
+		if errors.Is(itemErr.Err, ErrBulkItemSkipped) {
+			continue
+		}
+		sb.WriteString("\n  - ")
+		sb.WriteString(itemErr.Error())
+	}
+	return sb.String()
+}
+
+// Unwrap returns all item errors so that errors.Is / errors.As traverse them.
+func (e *BulkError) Unwrap() []error {
+	out := make([]error, len(e.Errors))
+	for i, itemErr := range e.Errors {
+		out[i] = itemErr
+	}
+	return out
+}
+
+// IsBulkErr checks if the error is a BulkError.
+func IsBulkErr(err error) bool {
+	var bulkErr *BulkError
+	return errors.As(err, &bulkErr)
+}
+
+//  ######################################################
+//              VAST RESOURCE BULK OPS
+//  ######################################################
+
+// BulkCreateWithContext creates resources from bodies with bounded concurrency.
+// Returns per-item results (in input order) and a *BulkError if any item failed.
+func (e *VastResource) BulkCreateWithContext(ctx context.Context, bodies []Params, opts *BulkOptions) (BulkResult, error) {
+	caller := e.caller()
+	items := make([]bulkItem, len(bodies))
+	for i, body := range bodies {
+		items[i] = bulkItem{body: body}
+	}
+	return e.runBulk(ctx, "create", items, opts, func(ctx context.Context, item bulkItem) (Record, error) {
+		return caller.CreateWithContext(ctx, item.body)
+	})
+}
+
+// BulkUpdateWithContext updates resources by id with bounded concurrency.
+// When every item carries the same body and the resource has a native bulk PATCH
+// endpoint (e.g. SwitchBulk_PATCH), a single request with all ids is sent instead.
+func (e *VastResource) BulkUpdateWithContext(ctx context.Context, updates []BulkUpdateItem, opts *BulkOptions) (BulkResult, error) {
+	options := opts.normalize()
+	items := make([]bulkItem, len(updates))
+	for i, update := range updates {
+		items[i] = bulkItem{id: update.ID, body: update.Body}
+	}
+	if path, ok := e.nativeBulkPath(http.MethodPatch, options); ok && len(items) > 0 && sameBodies(items) {
+		body := Params{}
+		for k, v := range items[0].body {
+			body[k] = v
+		}
+		body["ids"] = bulkIds(items)
+		return e.runNativeBulk(ctx, "update", http.MethodPatch, path, nil, body, items, options)
+	}
+	caller := e.caller()
+	return e.runBulk(ctx, "update", items, options, func(ctx context.Context, item bulkItem) (Record, error) {
+		return caller.UpdateWithContext(ctx, item.id, item.body)
+	})
+}
+
+// BulkDeleteWithContext deletes resources by id with bounded concurrency.
+// queryParams and deleteParams are sent with every request.
+// Resources with a native bulk DELETE endpoint (e.g. VolumeBulk_DELETE, BlockHostBulk_DELETE)
+// are deleted in a single request.
+func (e *VastResource) BulkDeleteWithContext(ctx context.Context, ids []any, queryParams, deleteParams Params, opts *BulkOptions) (BulkResult, error) {
+	options := opts.normalize()
+	items := make([]bulkItem, len(ids))
+	for i, id := range ids {
+		items[i] = bulkItem{id: id}
+	}
+	if path, ok := e.nativeBulkPath(http.MethodDelete, options); ok && len(items) > 0 {
+		body := Params{}
+		for k, v := range deleteParams {
+			body[k] = v
+		}
+		body["ids"] = bulkIds(items)
+		return e.runNativeBulk(ctx, "delete", http.MethodDelete, path, queryParams, body, items, options)
+	}
+	caller := e.caller()
+	return e.runBulk(ctx, "delete", items, options, func(ctx context.Context, item bulkItem) (Record, error) {
+		return caller.DeleteByIdWithContext(ctx, item.id, queryParams, deleteParams)
+	})
+}
+
+// BulkCreate creates resources from bodies using the bound REST context.
+func (e *VastResource) BulkCreate(bodies []Params, opts *BulkOptions) (BulkResult, error) {
+	return e.BulkCreateWithContext(e.Rest.GetCtx(), bodies, opts)
+}
+
+// BulkUpdate updates resources by id using the bound REST context.
+func (e *VastResource) BulkUpdate(updates []BulkUpdateItem, opts *BulkOptions) (BulkResult, error) {
+	return e.BulkUpdateWithContext(e.Rest.GetCtx(), updates, opts)
+}
+
+// BulkDelete deletes resources by id using the bound REST context.
+func (e *VastResource) BulkDelete(ids []any, queryParams, deleteParams Params, opts *BulkOptions) (BulkResult, error) {
+	return e.BulkDeleteWithContext(e.Rest.GetCtx(), ids, queryParams, deleteParams, opts)
+}
+
+type bulkItem struct {
+	id   any
+	body Params
+}
+
+// caller returns the registered resource (which may shadow CRUD methods) or the VastResource itself.
+func (e *VastResource) caller() VastResourceAPIWithContext {
+	if e.Rest != nil {
+		if resource, ok := e.Rest.GetResourceMap()[e.resourceType]; ok && resource != nil {
+			return resource
+		}
+	}
+	return e
+}
+
+// nativeBulkPath returns the URL of the native bulk endpoint for the given verb, if any.
+func (e *VastResource) nativeBulkPath(verb string, opts *BulkOptions) (string, bool) {
+	if opts.DisableNative {
+		return "", false
+	}
+	supported := false
+	for _, v := range nativeBulkVerbs[e.resourceType] {
+		if v == verb {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return "", false
+	}
+	methodName := fmt.Sprintf("%sBulk_%s", e.resourceType, verb)
+	metadata, ok := GetExtraMethodMetadata(e.resourceType, methodName)
+	if !ok || strings.Contains(metadata.URLPath, "{") {
+		return "", false
+	}
+	return metadata.URLPath, true
+}
+
+// runBulk executes fn for every item with bounded concurrency and optional locking/waiting.
+func (e *VastResource) runBulk(
+	ctx context.Context,
+	op string,
+	items []bulkItem,
+	opts *BulkOptions,
+	fn func(context.Context, bulkItem) (Record, error),
+) (BulkResult, error) {
+	options := opts.normalize()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(BulkResult, len(items))
+	sem := make(chan struct{}, options.Concurrency)
+	var wg sync.WaitGroup
+
+	for i, item := range items {
+		results[i] = BulkItemResult{Index: i, ID: item.id}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			results[i].Err = fmt.Errorf("%w: %v", ErrBulkItemSkipped, ctx.Err())
+			continue
+		}
+		wg.Add(1)
+		go func(i int, item bulkItem) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			var record Record
+			var err error
+			if options.LockKey != nil {
+				if keys := options.LockKey(i, item.id, item.body); len(keys) > 0 {
+					var unlock func()
+					if unlock, err = e.LockWithContext(ctx, keys...); err == nil {
+						defer unlock()
+					}
+				}
+			}
+			if err == nil {
+				record, err = fn(ctx, item)
+			}
+			if err == nil {
+				record, err = e.maybeWaitBulkItem(ctx, record, options)
+			}
+			results[i].Record = record
+			results[i].Err = err
+			if err == nil && results[i].ID == nil && record != nil {
+				if id, ok := record["id"]; ok {
+					results[i].ID = id
+				}
+			}
+			if err != nil && options.FailFast {
+				cancel()
+			}
+		}(i, item)
+	}
+	wg.Wait()
+	return results, newBulkError(op, e.resourceType, results)
+}
+
+// runNativeBulk sends a single request to a native bulk endpoint and fans the outcome out to all items.
+func (e *VastResource) runNativeBulk(
+	ctx context.Context,
+	op, verb, path string,
+	queryParams, body Params,
+	items []bulkItem,
+	opts *BulkOptions,
+) (BulkResult, error) {
+	var record Record
+	var err error
+	if opts.LockKey != nil {
+		keySets := make([][]any, 0, len(items))
+		for i, item := range items {
+			if keys := opts.LockKey(i, item.id, item.body); len(keys) > 0 {
+				keySets = append(keySets, keys)
+			}
+		}
+		var unlock func()
+		if unlock, err = e.lockKeySets(ctx, keySets); err == nil {
+			defer unlock()
+		}
+	}
+	if err == nil {
+		record, err = Request[Record](ctx, e, verb, path, queryParams, body)
+	}
+	if err == nil {
+		record, err = e.maybeWaitBulkItem(ctx, record, opts)
+	}
+	results := make(BulkResult, len(items))
+	for i, item := range items {
+		results[i] = BulkItemResult{Index: i, ID: item.id, Record: record, Err: err, Native: true}
+	}
+	return results, newBulkError(op, e.resourceType, results)
+}
+
+// lockKeySets acquires the locks of several key sets in a stable order, so that concurrent
+// callers cannot deadlock each other. Key sets resolving to the same lock are acquired once,
+// since locks are not reentrant.
+func (e *VastResource) lockKeySets(ctx context.Context, keySets [][]any) (func(), error) {
+	locker := e.locker()
+	byKey := make(map[string][]any, len(keySets))
+	for _, keys := range keySets {
+		byKey[locker.key(keys)] = keys
+	}
+	unlocks := make([]func(), 0, len(byKey))
+	unlockAll := func() {
+		for i := len(unlocks) - 1; i >= 0; i-- {
+			unlocks[i]()
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(byKey)) {
+		unlock, err := locker.LockWithContext(ctx, byKey[key]...)
+		if err != nil {
+			unlockAll()
+			return nil, err
+		}
+		unlocks = append(unlocks, unlock)
+	}
+	return unlockAll, nil
+}
+
+func (e *VastResource) maybeWaitBulkItem(ctx context.Context, record Record, opts *BulkOptions) (Record, error) {
+	if opts.WaitTimeout <= 0 {
+		return record, nil
+	}
+	asyncResult := MaybeAsyncResultFromRecord(ctx, record, e.Rest)
+	if asyncResult == nil {
+		return record, nil
+	}
+	return asyncResult.Wait(opts.WaitTimeout)
+}
+
+func newBulkError(op, resourceType string, results BulkResult) error {
+	var itemErrors []*BulkItemError
+	for _, item := range results {
+		if item.Err != nil {
+			itemErrors = append(itemErrors, &BulkItemError{Index: item.Index, ID: item.ID, Err: item.Err})
+		}
+	}
+	if len(itemErrors) == 0 {
+		return nil
+	}
+	return &BulkError{Op: op, ResourceType: resourceType, Total: len(results), Errors: itemErrors}
+}
+
+func bulkIds(items []bulkItem) []any {
+	ids := make([]any, len(items))
+	for i, item := range items {
+		ids[i] = item.id
+	}
+	return ids
+}
+
+func sameBodies(items []bulkItem) bool {
+	for _, item := range items[1:] {
+		if !reflect.DeepEqual(map[string]any(item.body), map[string]any(items[0].body)) {
+			return false
+		}
+	}
+	return true
+}
+
+//  ######################################################
+//              TYPED VAST RESOURCE BULK OPS
+//  ######################################################
+
+// TypedBulkUpdateItem is a single update request for typed bulk updates.
+// Body may be a typed request struct (or pointer) or Params.
+type TypedBulkUpdateItem struct {
+	ID   any
+	Body any
+}
+
+type bulkCapable interface {
+	BulkCreateWithContext(context.Context, []Params, *BulkOptions) (BulkResult, error)
+	BulkUpdateWithContext(context.Context, []BulkUpdateItem, *BulkOptions) (BulkResult, error)
+	BulkDeleteWithContext(context.Context, []any, Params, Params, *BulkOptions) (BulkResult, error)
+}
+
+func (e *TypedVastResource) bulkResource() (bulkCapable, error) {
+	resource, ok := e.getUntypedVastResource().(bulkCapable)
+	if !ok {
+		return nil, fmt.Errorf("resource %q does not support bulk operations", e.resourceType)
+	}
+	return resource, nil
+}
+
+// BulkCreateWithContext creates resources from typed request bodies (structs, struct pointers or Params).
+// Use FillBulkResult to convert records into typed models.
+func (e *TypedVastResource) BulkCreateWithContext(ctx context.Context, bodies []any, opts *BulkOptions) (BulkResult, error) {
+	resource, err := e.bulkResource()
+	if err != nil {
+		return nil, err
+	}
+	params := make([]Params, len(bodies))
+	for i, body := range bodies {
+		if params[i], err = toBulkParams(body); err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+	return resource.BulkCreateWithContext(ctx, params, opts)
+}
+
+// BulkUpdateWithContext updates resources by id from typed request bodies.
+func (e *TypedVastResource) BulkUpdateWithContext(ctx context.Context, updates []TypedBulkUpdateItem, opts *BulkOptions) (BulkResult, error) {
+	resource, err := e.bulkResource()
+	if err != nil {
+		return nil, err
+	}
+	items := make([]BulkUpdateItem, len(updates))
+	for i, update := range updates {
+		body, err := toBulkParams(update.Body)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		items[i] = BulkUpdateItem{ID: update.ID, Body: body}
+	}
+	return resource.BulkUpdateWithContext(ctx, items, opts)
+}
+
+// BulkDeleteWithContext deletes resources by id.
+func (e *TypedVastResource) BulkDeleteWithContext(ctx context.Context, ids []any, opts *BulkOptions) (BulkResult, error) {
+	resource, err := e.bulkResource()
+	if err != nil {
+		return nil, err
+	}
+	return resource.BulkDeleteWithContext(ctx, ids, nil, nil, opts)
+}
+
+// BulkCreate creates resources from typed request bodies using the bound REST context.
+func (e *TypedVastResource) BulkCreate(bodies []any, opts *BulkOptions) (BulkResult, error) {
+	return e.BulkCreateWithContext(e.Untyped.GetCtx(), bodies, opts)
+}
+
+// BulkUpdate updates resources by id from typed request bodies using the bound REST context.
+func (e *TypedVastResource) BulkUpdate(updates []TypedBulkUpdateItem, opts *BulkOptions) (BulkResult, error) {
+	return e.BulkUpdateWithContext(e.Untyped.GetCtx(), updates, opts)
+}
+
+// BulkDelete deletes resources by id using the bound REST context.
+func (e *TypedVastResource) BulkDelete(ids []any, opts *BulkOptions) (BulkResult, error) {
+	return e.BulkDeleteWithContext(e.Untyped.GetCtx(), ids, opts)
+}
+
+// FillBulkResult converts records of a BulkResult into typed models.
+// The returned slice is aligned with the input items; failed items are nil.
+//
+//	result, err := rest.Quotas.BulkCreate(bodies, nil)
+//	models, fillErr := core.FillBulkResult[typed.QuotaUpsertModel](result)
+func FillBulkResult[T any](result BulkResult) ([]*T, error) {
+	out := make([]*T, len(result))
+	for i, item := range result {
+		if item.Err != nil || item.Record == nil {
+			continue
+		}
+		var model T
+		if err := item.Record.Fill(&model); err != nil {
+			return nil, fmt.Errorf("item %d: %w", item.Index, err)
+		}
+		out[i] = &model
+	}
+	return out, nil
+}
+
+func toBulkParams(body any) (Params, error) {
+	switch v := body.(type) {
+	case nil:
+		return Params{}, nil
+	case Params:
+		return v, nil
+	case map[string]any:
+		return Params(v), nil
+	default:
+		return NewParamsFromStruct(body)
+	}
+}
@@ -0,0 +1,338 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newBulkTestResource(t *testing.T, server *httptest.Server, path, resourceType string) *VastResource {
+	t.Helper()
+	session := newTestSession(t, server)
+	rest := &DummyRest{
+		ctx:         context.Background(),
+		Session:     session,
+		resourceMap: make(map[string]VastResourceAPIWithContext),
+	}
+	vr := NewVastResource(path, resourceType, rest, NewResourceOps(C, L, R, U, D), nil)
+	rest.resourceMap[resourceType] = vr
+	return vr
+}
+
+func TestBulkCreate_ConcurrencyLimitAndOrder(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cur := atomic.AddInt32(&inFlight, 1)
+		for {
+			prev := atomic.LoadInt32(&maxInFlight)
+			if cur <= prev || atomic.CompareAndSwapInt32(&maxInFlight, prev, cur) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": body["n"], "name": body["name"]})
+	}))
+	defer server.Close()
+
+	resource := newBulkTestResource(t, server, "users", "User")
+	bodies := make([]Params, 10)
+	for i := range bodies {
+		bodies[i] = Params{"n": i + 100, "name": "u"}
+	}
+
+	result, err := resource.BulkCreate(bodies, &BulkOptions{Concurrency: 3})
+	if err != nil {
+		t.Fatalf("BulkCreate: %v", err)
+	}
+	if len(result) != 10 || result.Succeeded() != 10 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	for i, item := range result {
+		if id, _ := toInt(item.ID); item.Index != i || id != int64(i+100) {
+			t.Errorf("item %d out of order: %+v", i, item)
+		}
+	}
+	if got := atomic.LoadInt32(&maxInFlight); got > 3 {
+		t.Fatalf("expected at most 3 concurrent requests, got %d", got)
+	}
+	if len(result.Records()) != 10 {
+		t.Fatalf("expected 10 records")
+	}
+}
+
+func TestBulkDelete_ContinueOnErrorAggregatesErrors(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/users/2/") || strings.Contains(r.URL.Path, "/users/4/") {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail":"not found"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	resource := newBulkTestResource(t, server, "users", "User")
+	result, err := resource.BulkDelete([]any{1, 2, 3, 4, 5}, nil, nil, nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !IsBulkErr(err) {
+		t.Fatalf("expected BulkError, got %T", err)
+	}
+	var bulkErr *BulkError
+	errors.As(err, &bulkErr)
+	if len(bulkErr.Errors) != 2 || bulkErr.Total != 5 {
+		t.Fatalf("unexpected bulk error: %+v", bulkErr)
+	}
+	if !ExpectStatusCodes(bulkErr.Errors[0].Err, http.StatusNotFound) {
+		t.Fatalf("expected 404 item error, got %v", bulkErr.Errors[0].Err)
+	}
+	var apiErr *ApiError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("errors.As should reach ApiError through BulkError, got %v", apiErr)
+	}
+	if result.Succeeded() != 3 || len(result.Failed()) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if !strings.Contains(err.Error(), "2 of 5 items failed") {
+		t.Fatalf("unexpected message: %s", err.Error())
+	}
+}
+
+func TestBulkUpdate_FailFastSkipsRemaining(t *testing.T) {
+	var calls int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"detail":"bad"}`))
+	}))
+	defer server.Close()
+
+	resource := newBulkTestResource(t, server, "users", "User")
+	updates := make([]BulkUpdateItem, 5)
+	for i := range updates {
+		updates[i] = BulkUpdateItem{ID: i + 1, Body: Params{"name": i}}
+	}
+
+	result, err := resource.BulkUpdate(updates, &BulkOptions{Concurrency: 1, FailFast: true})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("expected exactly 1 request with fail-fast, got %d", got)
+	}
+	skipped := 0
+	for _, item := range result {
+		if item.Skipped() {
+			skipped++
+		}
+	}
+	if skipped != 4 {
+		t.Fatalf("expected 4 skipped items, got %d", skipped)
+	}
+	if !errors.Is(err, ErrBulkItemSkipped) {
+		t.Fatal("expected errors.Is(err, ErrBulkItemSkipped)")
+	}
+	if !strings.Contains(err.Error(), "(4 skipped)") {
+		t.Fatalf("unexpected message: %s", err.Error())
+	}
+}
+
+func TestBulk_LockKeySerializesItems(t *testing.T) {
+	var mu sync.Mutex
+	active := map[string]int{}
+	violated := false
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		key := body["path"].(string)
+		mu.Lock()
+		active[key]++
+		if active[key] > 1 {
+			violated = true
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		active[key]--
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	resource := newBulkTestResource(t, server, "quotas", "Quota")
+	bodies := []Params{{"path": "/a"}, {"path": "/a"}, {"path": "/b"}, {"path": "/a"}}
+	_, err := resource.BulkCreate(bodies, &BulkOptions{
+		Concurrency: 4,
+		LockKey: func(_ int, _ any, body Params) []any {
+			return []any{body["path"]}
+		},
+	})
+	if err != nil {
+		t.Fatalf("BulkCreate: %v", err)
+	}
+	if violated {
+		t.Fatal("items sharing a lock key ran concurrently")
+	}
+}
+
+func TestBulkDelete_NativeEndpoint(t *testing.T) {
+	RegisterExtraMethod("Volume", "VolumeBulk_DELETE", "DELETE", "/volumes/bulk/", "Delete a Bulk Of Block Storage Volumes")
+	defer delete(ExtraMethodRegistry, "Volume")
+
+	var mu sync.Mutex
+	var requests []string
+	var gotBody map[string]any
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	resource := newBulkTestResource(t, server, "volumes", "Volume")
+	result, err := resource.BulkDelete([]any{1, 2, 3}, Params{"force": true}, nil, nil)
+	if err != nil {
+		t.Fatalf("BulkDelete: %v", err)
+	}
+	if len(requests) != 1 || !strings.HasSuffix(strings.Split(requests[0], "?")[0], "/volumes/bulk/") {
+		t.Fatalf("expected a single native bulk request, got %v", requests)
+	}
+	if !strings.Contains(requests[0], "force=true") {
+		t.Fatalf("expected query params to be forwarded: %v", requests)
+	}
+	if ids, _ := gotBody["ids"].([]any); len(ids) != 3 {
+		t.Fatalf("expected ids in body, got %v", gotBody)
+	}
+	for _, item := range result {
+		if !item.Native || item.Err != nil {
+			t.Fatalf("unexpected item: %+v", item)
+		}
+	}
+
+	// DisableNative falls back to per-item requests.
+	requests = nil
+	if _, err = resource.BulkDelete([]any{1, 2}, nil, nil, &BulkOptions{DisableNative: true}); err != nil {
+		t.Fatalf("BulkDelete: %v", err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected per-item requests, got %v", requests)
+	}
+}
+
+func TestBulkDelete_NativeSharedLockKeys(t *testing.T) {
+	RegisterExtraMethod("Volume", "VolumeBulk_DELETE", "DELETE", "/volumes/bulk/", "Delete a Bulk Of Block Storage Volumes")
+	defer delete(ExtraMethodRegistry, "Volume")
+
+	var resource *VastResource
+	var heldDuringRequest bool
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := resource.TryLock("tenant", 1)
+		heldDuringRequest = errors.Is(err, ErrLockHeld)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	resource = newBulkTestResource(t, server, "volumes", "Volume")
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	keys := func(i int, _ any, _ Params) []any {
+		if i == 2 {
+			return []any{"tenant", 0}
+		}
+		return []any{"tenant", 1}
+	}
+	if _, err := resource.BulkDeleteWithContext(ctx, []any{1, 2, 3}, nil, nil, &BulkOptions{LockKey: keys}); err != nil {
+		t.Fatalf("BulkDelete with shared lock keys: %v", err)
+	}
+	if !heldDuringRequest {
+		t.Fatal("expected lock keys to be held during the native request")
+	}
+	if holder, _ := resource.LockHolder("tenant", 1); holder != nil {
+		t.Fatalf("expected locks to be released, held by %s", holder)
+	}
+}
+
+func TestBulkUpdate_NativeRequiresIdenticalBodies(t *testing.T) {
+	RegisterExtraMethod("Switch", "SwitchBulk_PATCH", "PATCH", "/switches/bulk/", "Bulk Update Switch Credentials")
+	defer delete(ExtraMethodRegistry, "Switch")
+
+	var mu sync.Mutex
+	var paths []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	resource := newBulkTestResource(t, server, "switches", "Switch")
+	creds := Params{"username": "admin", "password": "secret"}
+	if _, err := resource.BulkUpdate([]BulkUpdateItem{{ID: 1, Body: creds}, {ID: 2, Body: creds}}, nil); err != nil {
+		t.Fatalf("BulkUpdate: %v", err)
+	}
+	if len(paths) != 1 || !strings.HasSuffix(paths[0], "/switches/bulk/") {
+		t.Fatalf("expected native request, got %v", paths)
+	}
+
+	paths = nil
+	if _, err := resource.BulkUpdate([]BulkUpdateItem{
+		{ID: 1, Body: Params{"username": "a"}},
+		{ID: 2, Body: Params{"username": "b"}},
+	}, nil); err != nil {
+		t.Fatalf("BulkUpdate: %v", err)
+	}
+	if len(paths) != 2 {
+		t.Fatalf("expected per-item requests for differing bodies, got %v", paths)
+	}
+}
+
+func TestTypedBulkCreate_FillBulkResult(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": 7, "name": body["name"]})
+	}))
+	defer server.Close()
+
+	untyped := newBulkTestResource(t, server, "users", "User")
+	typed := NewTypedVastResource("User", untyped.Rest)
+
+	type userBody struct {
+		Name string `json:"name"`
+	}
+	type userModel struct {
+		Id   int64  `json:"id"`
+		Name string `json:"name"`
+	}
+
+	result, err := typed.BulkCreate([]any{&userBody{Name: "alice"}, Params{"name": "bob"}}, nil)
+	if err != nil {
+		t.Fatalf("BulkCreate: %v", err)
+	}
+	models, err := FillBulkResult[userModel](result)
+	if err != nil {
+		t.Fatalf("FillBulkResult: %v", err)
+	}
+	if len(models) != 2 || models[0].Name != "alice" || models[1].Name != "bob" || models[0].Id != 7 {
+		t.Fatalf("unexpected models: %+v %+v", models[0], models[1])
+	}
+}
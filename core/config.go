@@ -26,6 +26,19 @@ type VMSConfig struct {
 	UserAgent      string         // Optional custom User-Agent header to use in HTTP requests. If empty, a default may be applied.
 	ApiVersion     string         // Optional API version
 	PageSize       int            // Default page size for iterators
+	// ValidateRequests enables client-side validation of query params and request bodies
+	// against the embedded OpenAPI schema before they are sent (see openapi_schema.ValidateRequest).
+	// Invalid requests fail with *openapi_schema.ValidationError; operations missing from the
+	// schema are sent unvalidated.
+	ValidateRequests bool
+	// SchemaDrift, when set, checks every response against the OpenAPI response model of its
+	// operation and aggregates unknown fields, missing required fields and type mismatches
+	// per resource. Intended for debugging and CI runs against staging clusters.
+	SchemaDrift *DriftReport
+	// LockBackend is the backend of the resource locks (VastResource.Lock, TryLock, LockWithContext).
+	// If nil, locks only exclude goroutines of the current process; use FileLockBackend
+	// to exclude other processes on the same host.
+	LockBackend LockBackend
 	// Context is an optional external context for controlling HTTP request lifecycle.
 	// When provided, it will be used as the parent context for all HTTP requests made by the client.
 	Context context.Context
@@ -56,6 +69,10 @@ type VMSConfig struct {
 	//   - An error, if processing the response fails.
 	AfterRequestFn func(ctx context.Context, response Renderable) (Renderable, error)
 
+	// Middlewares wrap the round trip of every request, in order (see Middleware).
+	// rest.Use appends to this list.
+	Middlewares []Middleware
+
 	// FillFn optionally overrides the default function used to populate structs
 	// from generic Record maps. If provided, this function is invoked instead of
 	// the default JSON-based marshal/unmarshal logic.
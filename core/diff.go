@@ -0,0 +1,631 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bndr/gotabulate"
+)
+
+//  ######################################################
+//              RECORD DIFF
+//  ######################################################
+
+// DiffOp describes the kind of change reported for a single path.
+type DiffOp string
+
+const (
+	DiffAdded   DiffOp = "added"
+	DiffRemoved DiffOp = "removed"
+	DiffChanged DiffOp = "changed"
+)
+
+// ANSI color codes used by RecordDiff.PrettyDiff.
+const (
+	ansiReset  = "\033[0m"
+	ansiRed    = "\033[31m"
+	ansiGreen  = "\033[32m"
+	ansiYellow = "\033[33m"
+)
+
+// DiffEntry is a single difference between two Records.
+//
+// Path uses dotted/bracket notation: nested map keys are joined with "." and
+// list indexes are written as "[i]" (e.g. "default_user_quota.hard_limit", "ip_ranges[0][1]").
+// Old is nil for DiffAdded entries and New is nil for DiffRemoved entries.
+// Segments holds the same path unparsed: object keys as-is (dots included) and list
+// indexes as "[i]". Use it instead of splitting Path when keys may contain "." or "[".
+type DiffEntry struct {
+	Path     string   `json:"path"`
+	Op       DiffOp   `json:"op"`
+	Old      any      `json:"old,omitempty"`
+	New      any      `json:"new,omitempty"`
+	Segments []string `json:"-"`
+}
+
+func newDiffEntry(path []string, op DiffOp, oldVal, newVal any) DiffEntry {
+	return DiffEntry{Path: joinPath(path), Op: op, Old: oldVal, New: newVal, Segments: path}
+}
+
+// DiffOptions controls how Diff compares two Records.
+//
+// Both IgnorePaths and UnorderedLists accept paths in the same dotted/bracket notation
+// used by DiffEntry.Path. A "*" segment matches any single key or list index, so
+// "*.created" ignores the "created" key of every nested object and "ip_ranges[*]"
+// matches every element of ip_ranges. An ignored path also ignores everything below it.
+//
+// Example:
+//
+//	opts := &DiffOptions{
+//	    IgnorePaths:    []string{"id", "guid", "created", "*.sync_time"},
+//	    UnorderedLists: []string{"ip_ranges", "hosts"},
+//	}
+type DiffOptions struct {
+	IgnorePaths    []string // Paths (or path patterns) excluded from comparison
+	UnorderedLists []string // Paths of lists compared as multisets (element order is ignored)
+}
+
+// RecordDiff is the ordered list of differences returned by Diff.
+type RecordDiff []DiffEntry
+
+// Diff compares two Records and returns the structured list of differences needed to turn a into b.
+//
+// Nested maps are compared key by key and lists element by element (or as multisets for
+// paths listed in opts.UnorderedLists). Numbers are normalized before comparison, so
+// float64(5) decoded from JSON equals int64(5) built in Go code.
+// Entries are ordered by path. A nil opts compares everything.
+func Diff(a, b Record, opts *DiffOptions) RecordDiff {
+	if opts == nil {
+		opts = &DiffOptions{}
+	}
+	var result RecordDiff
+	diffMaps(nil, map[string]any(a), map[string]any(b), opts, &result)
+	return result
+}
+
+// Equal reports whether two Records are equal under the given options.
+func Equal(a, b Record, opts *DiffOptions) bool {
+	return Diff(a, b, opts).Empty()
+}
+
+func diffValues(path []string, a, b any, opts *DiffOptions, result *RecordDiff) {
+	if opts.ignored(path) {
+		return
+	}
+	aMap, aIsMap := asMap(a)
+	bMap, bIsMap := asMap(b)
+	if aIsMap && bIsMap {
+		diffMaps(path, aMap, bMap, opts, result)
+		return
+	}
+	aList, aIsList := asList(a)
+	bList, bIsList := asList(b)
+	if aIsList && bIsList {
+		if opts.unordered(path) {
+			diffUnorderedLists(path, aList, bList, opts, result)
+		} else {
+			diffOrderedLists(path, aList, bList, opts, result)
+		}
+		return
+	}
+	if !valuesEqual(a, b) {
+		*result = append(*result, newDiffEntry(path, DiffChanged, a, b))
+	}
+}
+
+func diffMaps(path []string, a, b map[string]any, opts *DiffOptions, result *RecordDiff) {
+	keys := make(map[string]struct{}, len(a)+len(b))
+	for k := range a {
+		keys[k] = empty
+	}
+	for k := range b {
+		keys[k] = empty
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	for _, key := range sorted {
+		childPath := appendPath(path, key)
+		if opts.ignored(childPath) {
+			continue
+		}
+		aVal, inA := a[key]
+		bVal, inB := b[key]
+		switch {
+		case inA && !inB:
+			*result = append(*result, newDiffEntry(childPath, DiffRemoved, aVal, nil))
+		case !inA && inB:
+			*result = append(*result, newDiffEntry(childPath, DiffAdded, nil, bVal))
+		default:
+			diffValues(childPath, aVal, bVal, opts, result)
+		}
+	}
+}
+
+func diffOrderedLists(path []string, a, b []any, opts *DiffOptions, result *RecordDiff) {
+	common := min(len(a), len(b))
+	for i := 0; i < common; i++ {
+		diffValues(appendPath(path, indexSegment(i)), a[i], b[i], opts, result)
+	}
+	for i := common; i < len(b); i++ {
+		childPath := appendPath(path, indexSegment(i))
+		if !opts.ignored(childPath) {
+			*result = append(*result, newDiffEntry(childPath, DiffAdded, nil, b[i]))
+		}
+	}
+	for i := common; i < len(a); i++ {
+		childPath := appendPath(path, indexSegment(i))
+		if !opts.ignored(childPath) {
+			*result = append(*result, newDiffEntry(childPath, DiffRemoved, a[i], nil))
+		}
+	}
+}
+
+// diffUnorderedLists matches list elements regardless of position.
+// Elements of a without an equal counterpart in b are reported as removed (at their index in a),
+// elements of b without a counterpart in a are reported as added (at their index in b).
+// Elements are compared under opts, so ignored paths inside elements do not prevent a match.
+func diffUnorderedLists(path []string, a, b []any, opts *DiffOptions, result *RecordDiff) {
+	matched := make([]bool, len(b))
+	var removed []int
+	for i, aItem := range a {
+		found := false
+		for j, bItem := range b {
+			if matched[j] {
+				continue
+			}
+			var changes RecordDiff
+			diffValues(appendPath(path, indexSegment(j)), aItem, bItem, opts, &changes)
+			if changes.Empty() {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed = append(removed, i)
+		}
+	}
+	for j, ok := range matched {
+		if !ok {
+			childPath := appendPath(path, indexSegment(j))
+			if !opts.ignored(childPath) {
+				*result = append(*result, newDiffEntry(childPath, DiffAdded, nil, b[j]))
+			}
+		}
+	}
+	for _, i := range removed {
+		childPath := appendPath(path, indexSegment(i))
+		if !opts.ignored(childPath) {
+			*result = append(*result, newDiffEntry(childPath, DiffRemoved, a[i], nil))
+		}
+	}
+}
+
+// ignored reports whether the path (or one of its ancestors) matches an ignore pattern.
+func (opts *DiffOptions) ignored(path []string) bool {
+	for _, pattern := range opts.IgnorePaths {
+		patternSegments := splitPath(pattern)
+		if len(patternSegments) <= len(path) && segmentsMatch(patternSegments, path[:len(patternSegments)]) {
+			return true
+		}
+	}
+	return false
+}
+
+// unordered reports whether the list at path should be compared as a multiset.
+func (opts *DiffOptions) unordered(path []string) bool {
+	for _, pattern := range opts.UnorderedLists {
+		patternSegments := splitPath(pattern)
+		if len(patternSegments) == len(path) && segmentsMatch(patternSegments, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func segmentsMatch(pattern, path []string) bool {
+	for i := range pattern {
+		if pattern[i] == "*" || pattern[i] == "[*]" {
+			continue
+		}
+		if pattern[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// splitPath splits a dotted/bracket path into segments: "a.b[0]" -> ["a", "b", "[0]"].
+func splitPath(path string) []string {
+	var segments []string
+	for _, part := range strings.Split(path, ".") {
+		for part != "" {
+			idx := strings.IndexByte(part, '[')
+			switch {
+			case idx < 0:
+				segments = append(segments, part)
+				part = ""
+			case idx > 0:
+				segments = append(segments, part[:idx])
+				part = part[idx:]
+			default:
+				end := strings.IndexByte(part, ']')
+				if end < 0 {
+					segments = append(segments, part)
+					part = ""
+				} else {
+					segments = append(segments, part[:end+1])
+					part = part[end+1:]
+				}
+			}
+		}
+	}
+	return segments
+}
+
+// joinPath is the inverse of splitPath.
+func joinPath(segments []string) string {
+	var sb strings.Builder
+	for i, segment := range segments {
+		if i > 0 && !strings.HasPrefix(segment, "[") {
+			sb.WriteByte('.')
+		}
+		sb.WriteString(segment)
+	}
+	return sb.String()
+}
+
+func appendPath(path []string, segment string) []string {
+	out := make([]string, len(path), len(path)+1)
+	copy(out, path)
+	return append(out, segment)
+}
+
+func indexSegment(i int) string {
+	return "[" + strconv.Itoa(i) + "]"
+}
+
+func asMap(v any) (map[string]any, bool) {
+	switch m := v.(type) {
+	case map[string]any:
+		return m, true
+	case Record:
+		return m, true
+	case Params:
+		return m, true
+	}
+	return nil, false
+}
+
+func asList(v any) ([]any, bool) {
+	switch l := v.(type) {
+	case []any:
+		return l, true
+	case nil:
+		return nil, false
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil, false
+	}
+	if rv.Type().Elem().Kind() == reflect.Uint8 {
+		// []byte is treated as a scalar
+		return nil, false
+	}
+	out := make([]any, rv.Len())
+	for i := range out {
+		out[i] = rv.Index(i).Interface()
+	}
+	return out, true
+}
+
+// valuesEqual compares two scalar values, normalizing numeric types.
+func valuesEqual(a, b any) bool {
+	if aNum, ok := normalizeNumber(a); ok {
+		if bNum, ok := normalizeNumber(b); ok {
+			return aNum == bNum
+		}
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// normalizeNumber converts any Go numeric value (or json.Number) to float64.
+func normalizeNumber(v any) (float64, bool) {
+	if v == nil {
+		return 0, false
+	}
+	if n, ok := v.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	if isNumericKind(reflect.TypeOf(v).Kind()) {
+		return toFloat64(v), true
+	}
+	return 0, false
+}
+
+// Empty reports whether there are no differences.
+func (d RecordDiff) Empty() bool {
+	return len(d) == 0
+}
+
+// Paths returns the paths of all entries in order.
+func (d RecordDiff) Paths() []string {
+	paths := make([]string, len(d))
+	for i, entry := range d {
+		paths[i] = entry.Path
+	}
+	return paths
+}
+
+// PrettyTable renders the diff as a table with op/path/old/new columns.
+func (d RecordDiff) PrettyTable() string {
+	if d.Empty() {
+		return "<no changes>"
+	}
+	rows := make([][]any, 0, len(d))
+	for _, entry := range d {
+		rows = append(rows, []any{string(entry.Op), entry.Path, diffValueString(entry.Old), diffValueString(entry.New)})
+	}
+	t := gotabulate.Create(rows)
+	t.SetHeaders([]string{"op", "path", "old", "new"})
+	t.SetAlign("left")
+	t.SetWrapStrings(true)
+	t.SetMaxCellSize(60)
+	return t.Render("grid")
+}
+
+// PrettyJson renders the diff entries as JSON, optionally indented.
+func (d RecordDiff) PrettyJson(indent ...string) string {
+	var b []byte
+	var err error
+	if len(indent) > 0 {
+		b, err = json.MarshalIndent(d, "", indent[0])
+	} else {
+		b, err = json.Marshal(d)
+	}
+	if err != nil {
+		return fmt.Sprintf("failed to marshal JSON: %v", err)
+	}
+	return string(b)
+}
+
+// PrettyDiff renders the diff in a unified, line-per-change format where added,
+// removed and changed paths are prefixed with "+", "-" and "~" respectively:
+//
+//	diff := core.Diff(current, desired, nil)
+//	fmt.Println(diff.PrettyDiff(true))
+//
+// When color is true, lines are colored with ANSI escape codes (green/red/yellow).
+func (d RecordDiff) PrettyDiff(color bool) string {
+	if d.Empty() {
+		return "<no changes>"
+	}
+	var sb strings.Builder
+	for i, entry := range d {
+		var line, code string
+		switch entry.Op {
+		case DiffAdded:
+			line = fmt.Sprintf("+ %s: %s", entry.Path, diffValueString(entry.New))
+			code = ansiGreen
+		case DiffRemoved:
+			line = fmt.Sprintf("- %s: %s", entry.Path, diffValueString(entry.Old))
+			code = ansiRed
+		default:
+			line = fmt.Sprintf("~ %s: %s -> %s", entry.Path, diffValueString(entry.Old), diffValueString(entry.New))
+			code = ansiYellow
+		}
+		if color {
+			line = code + line + ansiReset
+		}
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		sb.WriteString(line)
+	}
+	return sb.String()
+}
+
+func (d RecordDiff) String() string {
+	return d.PrettyDiff(false)
+}
+
+func diffValueString(v any) string {
+	if v == nil {
+		return "null"
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+//  ######################################################
+//              PATCH GENERATORS
+//  ######################################################
+
+// MergePatch builds an RFC 7396 JSON merge patch that turns original into desired.
+//
+// Changed and added keys carry the desired value, removed keys are set to nil (JSON null),
+// nested objects are patched recursively and lists are replaced as a whole.
+// The result can be passed directly as the body of an Update (PATCH) call:
+//
+//	patch := core.MergePatch(current, desired, &core.DiffOptions{IgnorePaths: []string{"id"}})
+//	if len(patch) > 0 {
+//	    _, err = rest.Views.Update(current.RecordID(), patch)
+//	}
+func MergePatch(original, desired Record, opts *DiffOptions) Params {
+	if opts == nil {
+		opts = &DiffOptions{}
+	}
+	patch := mergePatchMaps(nil, original, desired, opts)
+	if patch == nil {
+		return Params{}
+	}
+	return Params(patch)
+}
+
+func mergePatchMaps(path []string, original, desired map[string]any, opts *DiffOptions) map[string]any {
+	patch := map[string]any{}
+	for key, desiredVal := range desired {
+		childPath := appendPath(path, key)
+		if opts.ignored(childPath) {
+			continue
+		}
+		originalVal, exists := original[key]
+		if !exists {
+			patch[key] = desiredVal
+			continue
+		}
+		originalMap, origIsMap := asMap(originalVal)
+		desiredMap, desIsMap := asMap(desiredVal)
+		if origIsMap && desIsMap {
+			if nested := mergePatchMaps(childPath, originalMap, desiredMap, opts); len(nested) > 0 {
+				patch[key] = nested
+			}
+			continue
+		}
+		var changes RecordDiff
+		diffValues(childPath, originalVal, desiredVal, opts, &changes)
+		if !changes.Empty() {
+			patch[key] = desiredVal
+		}
+	}
+	for key := range original {
+		if _, exists := desired[key]; exists {
+			continue
+		}
+		if opts.ignored(appendPath(path, key)) {
+			continue
+		}
+		patch[key] = nil
+	}
+	return patch
+}
+
+// JSONPatchOp is a single RFC 6902 JSON Patch operation.
+type JSONPatchOp struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value,omitempty"`
+}
+
+// MarshalJSON always emits "value" for add, replace and test operations,
+// since RFC 6902 requires it even when the value is null.
+func (op JSONPatchOp) MarshalJSON() ([]byte, error) {
+	switch op.Op {
+	case "add", "replace", "test":
+		return json.Marshal(struct {
+			Op    string `json:"op"`
+			Path  string `json:"path"`
+			Value any    `json:"value"`
+		}{op.Op, op.Path, op.Value})
+	}
+	type plain JSONPatchOp
+	return json.Marshal(plain(op))
+}
+
+// JSONPatch is an RFC 6902 JSON Patch document.
+type JSONPatch []JSONPatchOp
+
+// NewJSONPatch builds an RFC 6902 JSON Patch that turns original into desired.
+//
+// Operations are emitted so that they can be applied in order: "replace" and "add"
+// operations for object keys first, then list element removals in descending index order
+// (so earlier removals do not shift later indexes), then list element additions.
+// Additions to lists compared as unordered are appended with the "-" index.
+func NewJSONPatch(original, desired Record, opts *DiffOptions) JSONPatch {
+	if opts == nil {
+		opts = &DiffOptions{}
+	}
+	diff := Diff(original, desired, opts)
+
+	var objectOps, removeOps, addOps JSONPatch
+	for _, entry := range diff {
+		segments := entry.Segments
+		pointer := toJSONPointer(segments)
+		isListElem := len(segments) > 0 && strings.HasPrefix(segments[len(segments)-1], "[")
+		switch entry.Op {
+		case DiffChanged:
+			objectOps = append(objectOps, JSONPatchOp{Op: "replace", Path: pointer, Value: entry.New})
+		case DiffRemoved:
+			if isListElem {
+				removeOps = append(removeOps, JSONPatchOp{Op: "remove", Path: pointer})
+			} else {
+				objectOps = append(objectOps, JSONPatchOp{Op: "remove", Path: pointer})
+			}
+		case DiffAdded:
+			if isListElem {
+				if opts.unordered(segments[:len(segments)-1]) {
+					pointer = toJSONPointer(segments[:len(segments)-1]) + "/-"
+				}
+				addOps = append(addOps, JSONPatchOp{Op: "add", Path: pointer, Value: entry.New})
+			} else {
+				objectOps = append(objectOps, JSONPatchOp{Op: "add", Path: pointer, Value: entry.New})
+			}
+		}
+	}
+	// Remove list elements from the highest index down so indexes stay valid.
+	sort.SliceStable(removeOps, func(i, j int) bool {
+		pi, ii := splitPointerIndex(removeOps[i].Path)
+		pj, ij := splitPointerIndex(removeOps[j].Path)
+		if pi != pj {
+			return pi < pj
+		}
+		return ii > ij
+	})
+
+	result := make(JSONPatch, 0, len(diff))
+	result = append(result, objectOps...)
+	result = append(result, removeOps...)
+	result = append(result, addOps...)
+	return result
+}
+
+// PrettyJson renders the patch document as JSON, optionally indented.
+func (p JSONPatch) PrettyJson(indent ...string) string {
+	var b []byte
+	var err error
+	if len(indent) > 0 {
+		b, err = json.MarshalIndent(p, "", indent[0])
+	} else {
+		b, err = json.Marshal(p)
+	}
+	if err != nil {
+		return fmt.Sprintf("failed to marshal JSON: %v", err)
+	}
+	return string(b)
+}
+
+// toJSONPointer converts path segments into an RFC 6901 JSON pointer.
+func toJSONPointer(segments []string) string {
+	var sb strings.Builder
+	for _, segment := range segments {
+		sb.WriteByte('/')
+		if strings.HasPrefix(segment, "[") && strings.HasSuffix(segment, "]") {
+			sb.WriteString(segment[1 : len(segment)-1])
+			continue
+		}
+		segment = strings.ReplaceAll(segment, "~", "~0")
+		segment = strings.ReplaceAll(segment, "/", "~1")
+		sb.WriteString(segment)
+	}
+	return sb.String()
+}
+
+// splitPointerIndex splits "/a/b/3" into ("/a/b", 3).
+func splitPointerIndex(pointer string) (string, int) {
+	idx := strings.LastIndexByte(pointer, '/')
+	n, _ := strconv.Atoi(pointer[idx+1:])
+	return pointer[:idx], n
+}
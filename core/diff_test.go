@@ -0,0 +1,261 @@
+package core
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiff_NoChanges(t *testing.T) {
+	a := Record{"id": float64(1), "name": "v1", "nested": map[string]any{"x": float64(1)}}
+	b := Record{"id": int64(1), "name": "v1", "nested": map[string]any{"x": 1}}
+	if d := Diff(a, b, nil); !d.Empty() {
+		t.Fatalf("expected no diff (numeric normalization), got %v", d)
+	}
+	if !Equal(a, b, nil) {
+		t.Fatal("expected Equal to be true")
+	}
+}
+
+func TestDiff_AddedRemovedChanged(t *testing.T) {
+	a := Record{"name": "old", "quota": 10, "nested": map[string]any{"keep": 1, "drop": true}}
+	b := Record{"name": "new", "tags": []any{"a"}, "nested": map[string]any{"keep": 2}}
+
+	d := Diff(a, b, nil)
+	got := map[string]DiffOp{}
+	for _, e := range d {
+		got[e.Path] = e.Op
+	}
+	want := map[string]DiffOp{
+		"name":        DiffChanged,
+		"quota":       DiffRemoved,
+		"tags":        DiffAdded,
+		"nested.keep": DiffChanged,
+		"nested.drop": DiffRemoved,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestDiff_OrderedLists(t *testing.T) {
+	a := Record{"ip_ranges": []any{[]any{"10.0.0.1", "10.0.0.5"}, []any{"10.0.1.1", "10.0.1.5"}}}
+	b := Record{"ip_ranges": []any{[]any{"10.0.0.1", "10.0.0.9"}}}
+
+	d := Diff(a, b, nil)
+	if len(d) != 2 {
+		t.Fatalf("expected 2 entries, got %v", d)
+	}
+	if d[0].Path != "ip_ranges[0][1]" || d[0].Op != DiffChanged {
+		t.Errorf("unexpected first entry: %+v", d[0])
+	}
+	if d[1].Path != "ip_ranges[1]" || d[1].Op != DiffRemoved {
+		t.Errorf("unexpected second entry: %+v", d[1])
+	}
+}
+
+func TestDiff_UnorderedLists(t *testing.T) {
+	a := Record{"hosts": []any{"a", "b", "c"}}
+	b := Record{"hosts": []string{"c", "a", "d"}}
+
+	ordered := Diff(a, b, nil)
+	if len(ordered) != 3 {
+		t.Fatalf("expected 3 ordered changes, got %v", ordered)
+	}
+
+	d := Diff(a, b, &DiffOptions{UnorderedLists: []string{"hosts"}})
+	if len(d) != 2 {
+		t.Fatalf("expected 2 entries, got %v", d)
+	}
+	if d[0].Op != DiffAdded || d[0].New != "d" || d[0].Path != "hosts[2]" {
+		t.Errorf("unexpected added entry: %+v", d[0])
+	}
+	if d[1].Op != DiffRemoved || d[1].Old != "b" || d[1].Path != "hosts[1]" {
+		t.Errorf("unexpected removed entry: %+v", d[1])
+	}
+}
+
+func TestDiff_IgnorePaths(t *testing.T) {
+	a := Record{"id": 1, "name": "x", "created": "t1", "quota": map[string]any{"used": 5, "limit": 10}}
+	b := Record{"id": 2, "name": "x", "created": "t2", "quota": map[string]any{"used": 9, "limit": 10}}
+
+	d := Diff(a, b, &DiffOptions{IgnorePaths: []string{"id", "created", "*.used"}})
+	if !d.Empty() {
+		t.Fatalf("expected all changes ignored, got %v", d)
+	}
+
+	d = Diff(a, b, &DiffOptions{IgnorePaths: []string{"quota"}})
+	if !reflect.DeepEqual(d.Paths(), []string{"created", "id"}) {
+		t.Fatalf("unexpected paths: %v", d.Paths())
+	}
+}
+
+func TestDiff_TypeChange(t *testing.T) {
+	d := Diff(Record{"v": map[string]any{"a": 1}}, Record{"v": []any{1}}, nil)
+	if len(d) != 1 || d[0].Op != DiffChanged || d[0].Path != "v" {
+		t.Fatalf("unexpected diff: %v", d)
+	}
+}
+
+func TestMergePatch(t *testing.T) {
+	original := Record{
+		"id":     1,
+		"name":   "old",
+		"remove": "me",
+		"nested": map[string]any{"a": 1, "b": 2},
+		"list":   []any{1, 2},
+	}
+	desired := Record{
+		"id":     1,
+		"name":   "new",
+		"nested": map[string]any{"a": 1, "b": 3, "c": 4},
+		"list":   []any{1, 2, 3},
+		"added":  true,
+	}
+
+	patch := MergePatch(original, desired, nil)
+	want := Params{
+		"name":   "new",
+		"remove": nil,
+		"nested": map[string]any{"b": 3, "c": 4},
+		"list":   []any{1, 2, 3},
+		"added":  true,
+	}
+	if !reflect.DeepEqual(patch, want) {
+		t.Fatalf("got %#v, want %#v", patch, want)
+	}
+
+	if p := MergePatch(original, original, nil); len(p) != 0 {
+		t.Fatalf("expected empty patch, got %v", p)
+	}
+
+	p := MergePatch(original, desired, &DiffOptions{IgnorePaths: []string{"remove", "nested"}})
+	if _, ok := p["remove"]; ok {
+		t.Fatalf("expected remove to be ignored: %v", p)
+	}
+	if _, ok := p["nested"]; ok {
+		t.Fatalf("expected nested to be ignored: %v", p)
+	}
+}
+
+func TestNewJSONPatch(t *testing.T) {
+	original := Record{
+		"name":  "old",
+		"a/b":   1,
+		"gone":  true,
+		"items": []any{"x", "y", "z"},
+	}
+	desired := Record{
+		"name":  "new",
+		"a/b":   1,
+		"items": []any{"x"},
+		"added": map[string]any{"k": "v"},
+	}
+
+	patch := NewJSONPatch(original, desired, nil)
+	var ops []string
+	for _, op := range patch {
+		ops = append(ops, op.Op+" "+op.Path)
+	}
+	want := []string{
+		"add /added",
+		"remove /gone",
+		"replace /name",
+		"remove /items/2",
+		"remove /items/1",
+	}
+	if !reflect.DeepEqual(ops, want) {
+		t.Fatalf("got %v, want %v", ops, want)
+	}
+
+	if !strings.Contains(patch.PrettyJson(), `"op":"replace"`) {
+		t.Fatalf("unexpected JSON: %s", patch.PrettyJson())
+	}
+}
+
+func TestNewJSONPatch_UnorderedAppend(t *testing.T) {
+	patch := NewJSONPatch(
+		Record{"hosts": []any{"a", "b"}},
+		Record{"hosts": []any{"b", "c"}},
+		&DiffOptions{UnorderedLists: []string{"hosts"}},
+	)
+	if len(patch) != 2 {
+		t.Fatalf("unexpected patch: %v", patch)
+	}
+	if patch[0].Op != "remove" || patch[0].Path != "/hosts/0" {
+		t.Errorf("unexpected first op: %+v", patch[0])
+	}
+	if patch[1].Op != "add" || patch[1].Path != "/hosts/-" || patch[1].Value != "c" {
+		t.Errorf("unexpected second op: %+v", patch[1])
+	}
+}
+
+func TestToJSONPointer_Escaping(t *testing.T) {
+	if got := toJSONPointer(splitPath("a~b.c/d[3]")); got != "/a~0b/c~1d/3" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestRecordDiff_Render(t *testing.T) {
+	d := Diff(
+		Record{"name": "old", "gone": 1},
+		Record{"name": "new", "added": []any{1}},
+		nil,
+	)
+
+	plain := d.PrettyDiff(false)
+	for _, line := range []string{`+ added: [1]`, `- gone: 1`, `~ name: "old" -> "new"`} {
+		if !strings.Contains(plain, line) {
+			t.Errorf("missing %q in:\n%s", line, plain)
+		}
+	}
+	if strings.Contains(plain, "\033[") {
+		t.Errorf("plain output must not contain ANSI codes: %q", plain)
+	}
+
+	colored := d.PrettyDiff(true)
+	if !strings.Contains(colored, ansiGreen) || !strings.Contains(colored, ansiRed) || !strings.Contains(colored, ansiYellow) {
+		t.Errorf("expected ANSI colors in: %q", colored)
+	}
+
+	if !strings.Contains(d.PrettyTable(), "changed") {
+		t.Errorf("unexpected table:\n%s", d.PrettyTable())
+	}
+
+	var decoded []DiffEntry
+	if err := json.Unmarshal([]byte(d.PrettyJson("  ")), &decoded); err != nil || len(decoded) != 3 {
+		t.Errorf("unexpected JSON: %v %v", decoded, err)
+	}
+
+	if RecordDiff(nil).PrettyDiff(true) != "<no changes>" {
+		t.Error("expected placeholder for empty diff")
+	}
+}
+
+func TestDiff_UnorderedListsHonorOptions(t *testing.T) {
+	a := Record{"hosts": []any{map[string]any{"name": "a", "sync_time": 1}, map[string]any{"name": "b", "sync_time": 1}}}
+	b := Record{"hosts": []any{map[string]any{"name": "b", "sync_time": 2}, map[string]any{"name": "a", "sync_time": 2}}}
+	opts := &DiffOptions{UnorderedLists: []string{"hosts"}, IgnorePaths: []string{"hosts[*].sync_time"}}
+	if d := Diff(a, b, opts); !d.Empty() {
+		t.Fatalf("expected ignored paths to apply inside unordered elements, got %v", d)
+	}
+}
+
+func TestNewJSONPatch_DottedKeysAndNullValues(t *testing.T) {
+	patch := NewJSONPatch(
+		Record{"labels": map[string]any{"a.b": "x", "c/d": "y"}, "quota": 5},
+		Record{"labels": map[string]any{"a.b": "z", "c/d": "y"}, "quota": nil},
+		nil,
+	)
+	got := patch.PrettyJson()
+	want := `[{"op":"replace","path":"/labels/a.b","value":"z"},{"op":"replace","path":"/quota","value":null}]`
+	if got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+
+	remove, err := json.Marshal(JSONPatchOp{Op: "remove", Path: "/x"})
+	if err != nil || string(remove) != `{"op":"remove","path":"/x"}` {
+		t.Fatalf("unexpected remove op: %s %v", remove, err)
+	}
+}
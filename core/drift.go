@@ -0,0 +1,259 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/vast-data/go-vast-client/openapi_schema"
+)
+
+// ######################################################
+//              RESPONSE SCHEMA DRIFT DETECTION
+// ######################################################
+
+// DriftReport aggregates differences between API responses and the embedded OpenAPI
+// response models: unknown fields, missing required fields and type mismatches.
+// Assign it to VMSConfig.SchemaDrift to check every response received by the client,
+// exercise the resources (e.g. list each of them against a staging cluster) and inspect
+// the report before upgrading:
+//
+//	report := core.NewDriftReport()
+//	config.SchemaDrift = report
+//	rest, _ := client.NewVMSRest(config)
+//	_, _ = rest.Views.List(nil)
+//	fmt.Print(report)
+//
+// DriftReport is safe for concurrent use.
+type DriftReport struct {
+	mu        sync.Mutex
+	resources map[string]*ResourceDrift
+}
+
+// ResourceDrift is the drift observed for one resource type.
+type ResourceDrift struct {
+	Resource  string       `json:"resource"`
+	Paths     []string     `json:"paths"`     // OpenAPI paths the responses were checked against
+	Responses int          `json:"responses"` // responses received
+	Records   int          `json:"records"`   // records checked
+	Unchecked int          `json:"unchecked"` // responses of operations missing from the schema
+	Issues    []DriftIssue `json:"issues,omitempty"`
+}
+
+// DriftIssue is a drifted field with the number of records it was seen in.
+type DriftIssue struct {
+	Path    string                   `json:"path"`  // OpenAPI path, e.g. "/views/{id}/"
+	Field   string                   `json:"field"` // list indexes are collapsed: "hosts[].name"
+	Kind    openapi_schema.IssueKind `json:"kind"`
+	Message string                   `json:"message"`
+	Count   int                      `json:"count"`
+}
+
+// HasDrift reports whether the resource has any drift issue.
+func (d ResourceDrift) HasDrift() bool {
+	return len(d.Issues) > 0
+}
+
+// NewDriftReport returns an empty DriftReport.
+func NewDriftReport() *DriftReport {
+	return &DriftReport{resources: map[string]*ResourceDrift{}}
+}
+
+var driftIndexPattern = regexp.MustCompile(`\[\d+\]`)
+
+// Observe checks a response received for verb/path and records the drift under resource.
+// path is the request path with or without the "/api/<version>" prefix.
+// It is called by the client for every response when VMSConfig.SchemaDrift is set.
+func (r *DriftReport) Observe(resource, verb, path string, response Renderable) {
+	records := driftRecords(response)
+	var (
+		specPath string
+		issues   []openapi_schema.ValidationIssue
+		checked  int
+		err      error
+	)
+	// Validate outside the lock, only the aggregation is serialized.
+	if len(records) > 0 {
+		if specPath, err = openapi_schema.MatchOperationPath(verb, trimApiPrefix(path)); err == nil {
+			for _, record := range records {
+				var verr *openapi_schema.ValidationError
+				if err = openapi_schema.ValidateResponse(verb, specPath, record); errors.As(err, &verr) {
+					issues = append(issues, verr.Issues...)
+					err = nil
+				} else if err != nil {
+					break
+				}
+				checked++
+			}
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	drift, ok := r.resources[resource]
+	if !ok {
+		drift = &ResourceDrift{Resource: resource}
+		r.resources[resource] = drift
+	}
+	drift.Responses++
+	drift.Records += checked
+	if err != nil {
+		drift.Unchecked++
+	}
+	if specPath != "" {
+		drift.addPath(specPath)
+	}
+	for _, issue := range issues {
+		drift.addIssue(specPath, issue)
+	}
+}
+
+func (d *ResourceDrift) addPath(specPath string) {
+	for _, p := range d.Paths {
+		if p == specPath {
+			return
+		}
+	}
+	d.Paths = append(d.Paths, specPath)
+}
+
+func (d *ResourceDrift) addIssue(specPath string, issue openapi_schema.ValidationIssue) {
+	field := driftIndexPattern.ReplaceAllString(issue.Field, "[]")
+	for i := range d.Issues {
+		existing := &d.Issues[i]
+		if existing.Path == specPath && existing.Field == field && existing.Kind == issue.Kind && existing.Message == issue.Message {
+			existing.Count++
+			return
+		}
+	}
+	d.Issues = append(d.Issues, DriftIssue{Path: specPath, Field: field, Kind: issue.Kind, Message: issue.Message, Count: 1})
+}
+
+// Resources returns a snapshot of the observed resources sorted by name,
+// with issues sorted by path and field.
+func (r *DriftReport) Resources() []ResourceDrift {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	out := make([]ResourceDrift, 0, len(r.resources))
+	for _, drift := range r.resources {
+		snapshot := *drift
+		snapshot.Paths = append([]string(nil), drift.Paths...)
+		snapshot.Issues = append([]DriftIssue(nil), drift.Issues...)
+		sort.Strings(snapshot.Paths)
+		sort.Slice(snapshot.Issues, func(i, j int) bool {
+			a, b := snapshot.Issues[i], snapshot.Issues[j]
+			if a.Path != b.Path {
+				return a.Path < b.Path
+			}
+			return a.Field < b.Field
+		})
+		out = append(out, snapshot)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Resource < out[j].Resource })
+	return out
+}
+
+// HasDrift reports whether any resource has drift issues.
+func (r *DriftReport) HasDrift() bool {
+	for _, drift := range r.Resources() {
+		if drift.HasDrift() {
+			return true
+		}
+	}
+	return false
+}
+
+// Reset clears the report.
+func (r *DriftReport) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.resources = map[string]*ResourceDrift{}
+}
+
+// MarshalJSON encodes the report as the list returned by Resources, for CI artifacts.
+func (r *DriftReport) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.Resources())
+}
+
+// String renders the report as text: a summary line followed by the issues of each drifted resource.
+func (r *DriftReport) String() string {
+	resources := r.Resources()
+	var (
+		sb                          strings.Builder
+		drifted, records, unchecked int
+	)
+	for _, drift := range resources {
+		records += drift.Records
+		unchecked += drift.Unchecked
+		if drift.HasDrift() {
+			drifted++
+		}
+	}
+	fmt.Fprintf(&sb, "schema drift: %d of %d resources drifted (%d records checked, %d responses unchecked)\n",
+		drifted, len(resources), records, unchecked)
+	for _, drift := range resources {
+		if !drift.HasDrift() {
+			continue
+		}
+		fmt.Fprintf(&sb, "%s (%d records):\n", drift.Resource, drift.Records)
+		for _, issue := range drift.Issues {
+			fmt.Fprintf(&sb, "  %-14s %s %s: %s (%dx)\n", issue.Kind, issue.Path, issue.Field, issue.Message, issue.Count)
+		}
+	}
+	return sb.String()
+}
+
+// trimApiPrefix strips the "/api/<version>" prefix of request paths.
+func trimApiPrefix(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	trimmed := strings.TrimPrefix(path, "/")
+	if rest, ok := strings.CutPrefix(trimmed, "api/"); ok {
+		if i := strings.Index(rest, "/"); i >= 0 {
+			return rest[i:]
+		}
+		return "/"
+	}
+	return path
+}
+
+// driftRecords returns the records of a response to check: the record itself, the items
+// of a RecordSet or paginated envelope. Reserved "@" keys are dropped; empty and raw
+// (non-object) responses have no records.
+func driftRecords(response Renderable) []map[string]any {
+	var records []map[string]any
+	add := func(m map[string]any) {
+		clean := make(map[string]any, len(m))
+		for k, v := range m {
+			if !strings.HasPrefix(k, "@") {
+				clean[k] = v
+			}
+		}
+		records = append(records, clean)
+	}
+	switch v := response.(type) {
+	case Record:
+		if _, raw := v[customRawKey]; raw || len(v) == 0 {
+			return nil
+		}
+		if results, ok := v["results"].([]any); ok {
+			for _, item := range results {
+				if m, ok := item.(map[string]any); ok {
+					add(m)
+				}
+			}
+			return records
+		}
+		add(v)
+	case RecordSet:
+		for _, record := range v {
+			add(record)
+		}
+	}
+	return records
+}
@@ -0,0 +1,92 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/vast-data/go-vast-client/openapi_schema"
+)
+
+func TestDriftReport_Observe(t *testing.T) {
+	report := NewDriftReport()
+	page := Record{"count": 2, "results": []any{
+		map[string]any{"id": 1, "name": "v1", "path": "/v1", "policy_id": 1, "new_field": true},
+		map[string]any{"id": 2, "name": "v2", "path": 2, "new_field": true, "@related": map[string]any{}},
+	}}
+	report.Observe("View", http.MethodGet, "/api/v5/views/", page)
+	report.Observe("View", http.MethodGet, "/api/latest/views/3/?fields=name", Record{"id": 3, "name": "v3", "path": "/v3", "policy_id": 1})
+	report.Observe("View", http.MethodDelete, "views/3", Record{})
+	report.Observe("Custom", http.MethodGet, "/api/v5/no/such/path/", RecordSet{{"id": 1}})
+
+	resources := report.Resources()
+	if len(resources) != 2 || resources[0].Resource != "Custom" || resources[1].Resource != "View" {
+		t.Fatalf("resources = %+v", resources)
+	}
+	custom, view := resources[0], resources[1]
+	if custom.Unchecked != 1 || custom.HasDrift() {
+		t.Errorf("unknown operations must be counted as unchecked, got %+v", custom)
+	}
+	if view.Responses != 3 || view.Records != 3 || strings.Join(view.Paths, ",") != "/views/,/views/{id}/" {
+		t.Errorf("unexpected counters %+v", view)
+	}
+
+	issues := map[string]DriftIssue{}
+	for _, issue := range view.Issues {
+		issues[issue.Field] = issue
+	}
+	if len(issues) != 3 {
+		t.Fatalf("issues = %+v", view.Issues)
+	}
+	if issue := issues["new_field"]; issue.Kind != openapi_schema.IssueUnknownField || issue.Count != 2 {
+		t.Errorf("new_field = %+v", issue)
+	}
+	if issue := issues["path"]; issue.Kind != openapi_schema.IssueTypeMismatch || issue.Count != 1 {
+		t.Errorf("path = %+v", issue)
+	}
+	if issue := issues["policy_id"]; issue.Kind != openapi_schema.IssueMissingField || issue.Path != "/views/" {
+		t.Errorf("policy_id = %+v", issue)
+	}
+
+	out := report.String()
+	if !strings.HasPrefix(out, "schema drift: 1 of 2 resources drifted (3 records checked, 1 responses unchecked)") ||
+		!strings.Contains(out, "unknown_field  /views/ new_field: unknown field (2x)") {
+		t.Errorf("unexpected report:\n%s", out)
+	}
+	var decoded []ResourceDrift
+	if b, err := json.Marshal(report); err != nil || json.Unmarshal(b, &decoded) != nil || len(decoded) != 2 {
+		t.Errorf("json report = %s, %v", b, err)
+	}
+
+	report.Reset()
+	if report.HasDrift() || len(report.Resources()) != 0 {
+		t.Error("Reset must clear the report")
+	}
+}
+
+func TestDriftReport_Session(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]any{map[string]any{"id": 1, "name": "q", "path": "/q", "extra": 1}})
+	}))
+	defer server.Close()
+
+	session := newTestSession(t, server)
+	report := NewDriftReport()
+	session.GetConfig().SchemaDrift = report
+	resource := newTestResource(session)
+
+	if _, err := Request[RecordSet](context.Background(), resource, http.MethodGet, "quotas", nil, nil); err != nil {
+		t.Fatalf("Request: %v", err)
+	}
+	if !report.HasDrift() {
+		t.Fatalf("expected drift, got %s", report)
+	}
+	resources := report.Resources()
+	if resources[0].Resource != "Dummy" || resources[0].Issues[0].Field != "extra" {
+		t.Errorf("unexpected report %+v", resources)
+	}
+}
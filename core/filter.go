@@ -0,0 +1,269 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vast-data/go-vast-client/openapi_schema"
+)
+
+// ######################################################
+//              CLIENT-SIDE FILTERING
+// ######################################################
+
+// FilterRecords returns the records matching every field__lookup expression in filter,
+// evaluated locally with the same semantics the server applies (see Lookup).
+// Reserved query parameters (page, page_size, ordering, fields) are ignored.
+//
+//	active, err := core.FilterRecords(records, core.Params{
+//	    "name__startswith": "prod",
+//	    "id__in":           "1,2,3",
+//	    "tenant__name":     "default",
+//	})
+func FilterRecords(records RecordSet, filter Params) (RecordSet, error) {
+	compiled, err := compileRecordFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	return compiled.apply(records), nil
+}
+
+// MatchRecord reports whether record satisfies every expression in filter.
+func MatchRecord(record Record, filter Params) (bool, error) {
+	compiled, err := compileRecordFilter(filter)
+	if err != nil {
+		return false, err
+	}
+	return compiled.match(record), nil
+}
+
+// SplitQueryParams partitions params into keys declared as GET query parameters for
+// resourcePath in the OpenAPI schema (server) and the remaining keys (client).
+// Reserved query parameters always go to the server.
+func SplitQueryParams(resourcePath string, params Params) (server Params, client Params, err error) {
+	declared, err := openapi_schema.GetQueryParameters("GET", resourcePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	names := make(map[string]struct{}, len(declared))
+	for _, p := range declared {
+		names[p.Name] = empty
+	}
+	server, client = Params{}, Params{}
+	for k, v := range params {
+		_, reserved := reservedQueryParams[k]
+		_, ok := names[k]
+		if reserved || ok {
+			server[k] = v
+		} else {
+			client[k] = v
+		}
+	}
+	return server, client, nil
+}
+
+// recordFilter is a compiled list of field expressions.
+type recordFilter []fieldFilter
+
+type fieldFilter struct {
+	key    string
+	path   []string
+	lookup Lookup
+	value  any
+	re     *regexp.Regexp
+}
+
+func compileRecordFilter(filter Params) (recordFilter, error) {
+	compiled := make(recordFilter, 0, len(filter))
+	for key, value := range filter {
+		if _, ok := reservedQueryParams[key]; ok {
+			continue
+		}
+		field, lookup := SplitLookupKey(key)
+		f := fieldFilter{key: field, path: strings.Split(field, lookupSep), lookup: lookup, value: value}
+		switch lookup.Base() {
+		case LookupRegex, LookupIRegex:
+			pattern := LookupValue(value)
+			if lookup.Base() == LookupIRegex {
+				pattern = "(?i)" + pattern
+			}
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regex for %q: %w", key, err)
+			}
+			f.re = re
+		case LookupIsNull:
+			if _, err := ToBool(value); err != nil {
+				return nil, fmt.Errorf("invalid value for %q: %w", key, err)
+			}
+		}
+		compiled = append(compiled, f)
+	}
+	return compiled, nil
+}
+
+func (rf recordFilter) apply(records RecordSet) RecordSet {
+	if len(rf) == 0 {
+		return records
+	}
+	out := make(RecordSet, 0, len(records))
+	for _, r := range records {
+		if rf.match(r) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+func (rf recordFilter) match(record Record) bool {
+	for _, f := range rf {
+		if !f.match(record) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f fieldFilter) match(record Record) bool {
+	actual, found := f.lookupField(record)
+	ok := f.evaluate(actual, found)
+	if f.lookup.IsNegated() {
+		return !ok
+	}
+	return ok
+}
+
+// lookupField resolves the field in record. A flat key wins over a nested
+// relation path (e.g. "tenant__name" -> record["tenant"]["name"]).
+func (f fieldFilter) lookupField(record Record) (any, bool) {
+	if v, ok := record[f.key]; ok {
+		return v, true
+	}
+	var current any = map[string]any(record)
+	for _, part := range f.path {
+		m, ok := asMap(current)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+func (f fieldFilter) evaluate(actual any, found bool) bool {
+	if f.lookup.Base() == LookupIsNull {
+		want, _ := ToBool(f.value)
+		return (!found || actual == nil) == want
+	}
+	if !found || actual == nil {
+		return false
+	}
+	switch f.lookup.Base() {
+	case LookupExact:
+		return lookupEqual(actual, f.value)
+	case LookupIExact:
+		return strings.EqualFold(LookupValue(actual), LookupValue(f.value))
+	case LookupContains:
+		return strings.Contains(LookupValue(actual), LookupValue(f.value))
+	case LookupIContains:
+		return strings.Contains(strings.ToLower(LookupValue(actual)), strings.ToLower(LookupValue(f.value)))
+	case LookupStartsWith:
+		return strings.HasPrefix(LookupValue(actual), LookupValue(f.value))
+	case LookupEndsWith:
+		return strings.HasSuffix(LookupValue(actual), LookupValue(f.value))
+	case LookupRegex, LookupIRegex:
+		return f.re.MatchString(LookupValue(actual))
+	case LookupIn:
+		for _, candidate := range lookupList(f.value) {
+			if lookupEqual(actual, candidate) {
+				return true
+			}
+		}
+		return false
+	case LookupGT, LookupGTE, LookupLT, LookupLTE:
+		cmp, ok := compareValues(actual, f.value)
+		if !ok {
+			return false
+		}
+		switch f.lookup.Base() {
+		case LookupGT:
+			return cmp > 0
+		case LookupGTE:
+			return cmp >= 0
+		case LookupLT:
+			return cmp < 0
+		default:
+			return cmp <= 0
+		}
+	}
+	return false
+}
+
+// lookupList returns the candidates of an "in" lookup: a slice or a comma-separated string.
+func lookupList(v any) []any {
+	if s, ok := v.(string); ok {
+		parts := strings.Split(s, ",")
+		out := make([]any, len(parts))
+		for i, p := range parts {
+			out[i] = strings.TrimSpace(p)
+		}
+		return out
+	}
+	if list, ok := asList(v); ok {
+		return list
+	}
+	return []any{v}
+}
+
+// lookupEqual compares a record value with a query value, which is often a string.
+func lookupEqual(actual, want any) bool {
+	if a, ok := toFloat(actual); ok {
+		if b, ok := toFloat(want); ok {
+			return a == b
+		}
+	}
+	if a, ok := actual.(bool); ok {
+		b, err := ToBool(want)
+		return err == nil && a == b
+	}
+	return LookupValue(actual) == LookupValue(want)
+}
+
+// compareValues orders numbers numerically, RFC 3339 timestamps chronologically
+// and everything else lexically.
+func compareValues(actual, want any) (int, bool) {
+	if a, ok := toFloat(actual); ok {
+		b, ok := toFloat(want)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		}
+		return 0, true
+	}
+	as, bs := LookupValue(actual), LookupValue(want)
+	if at, err := time.Parse(time.RFC3339, as); err == nil {
+		if bt, err := time.Parse(time.RFC3339, bs); err == nil {
+			return at.Compare(bt), true
+		}
+	}
+	return strings.Compare(as, bs), true
+}
+
+// toFloat converts numbers and numeric strings to float64.
+func toFloat(v any) (float64, bool) {
+	if s, ok := v.(string); ok {
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		return f, err == nil
+	}
+	return normalizeNumber(v)
+}
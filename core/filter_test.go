@@ -0,0 +1,177 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func filterTestRecords() RecordSet {
+	return RecordSet{
+		{"id": float64(1), "name": "prod-a", "enabled": true, "size": float64(10), "created": "2025-01-01T00:00:00Z", "tenant": map[string]any{"name": "default"}},
+		{"id": float64(2), "name": "Prod-B", "enabled": false, "size": float64(20), "created": "2025-02-01T00:00:00Z", "tenant": map[string]any{"name": "t1"}, "quota": nil},
+		{"id": float64(3), "name": "dev-c", "enabled": true, "size": float64(30), "created": "2025-03-01T00:00:00Z", "tenant": map[string]any{"name": "t1"}, "quota": float64(5)},
+	}
+}
+
+func filteredIDs(t *testing.T, filter Params) []int64 {
+	t.Helper()
+	out, err := FilterRecords(filterTestRecords(), filter)
+	if err != nil {
+		t.Fatalf("FilterRecords(%v): %v", filter, err)
+	}
+	ids := make([]int64, len(out))
+	for i, r := range out {
+		ids[i] = r.RecordID()
+	}
+	return ids
+}
+
+func TestFilterRecords_Lookups(t *testing.T) {
+	cases := []struct {
+		filter Params
+		want   []int64
+	}{
+		{Params{"name": "prod-a"}, []int64{1}},
+		{Params{"id": "2"}, []int64{2}},
+		{Params{"enabled": "false"}, []int64{2}},
+		{Params{"name__iexact": "PROD-B"}, []int64{2}},
+		{Params{"name__contains": "prod"}, []int64{1}},
+		{Params{"name__icontains": "prod"}, []int64{1, 2}},
+		{Params{"name__not_icontains": "prod"}, []int64{3}},
+		{Params{"name__startswith": "dev"}, []int64{3}},
+		{Params{"name__endswith": "-a"}, []int64{1}},
+		{Params{"name__iregex": "^prod-[ab]$"}, []int64{1, 2}},
+		{Params{"id__in": "1,3"}, []int64{1, 3}},
+		{Params{"id__not_in": []int{1, 3}}, []int64{2}},
+		{Params{"size__gt": 10}, []int64{2, 3}},
+		{Params{"size__gte": "20", "size__lte": "20"}, []int64{2}},
+		{Params{"created__lt": "2025-02-15T00:00:00Z"}, []int64{1, 2}},
+		{Params{"tenant__name": "t1"}, []int64{2, 3}},
+		{Params{"quota__isnull": true}, []int64{1, 2}},
+		{Params{"quota__isnull": "false"}, []int64{3}},
+		{Params{"missing": "x"}, []int64{}},
+		{Params{"page_size": 100, "ordering": "-id"}, []int64{1, 2, 3}},
+	}
+	for _, tc := range cases {
+		got := filteredIDs(t, tc.filter)
+		if len(got) != len(tc.want) {
+			t.Errorf("%v: got %v, want %v", tc.filter, got, tc.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("%v: got %v, want %v", tc.filter, got, tc.want)
+				break
+			}
+		}
+	}
+}
+
+func TestFilterRecords_InvalidExpression(t *testing.T) {
+	if _, err := FilterRecords(filterTestRecords(), Params{"name__regex": "("}); err == nil {
+		t.Fatal("expected invalid regex error")
+	}
+	if _, err := MatchRecord(Record{}, Params{"quota__isnull": "maybe"}); err == nil {
+		t.Fatal("expected invalid isnull value error")
+	}
+	if ok, err := MatchRecord(filterTestRecords()[0], Params{"name": "prod-a"}); err != nil || !ok {
+		t.Fatalf("MatchRecord: %v %v", ok, err)
+	}
+}
+
+func TestSplitQueryParams(t *testing.T) {
+	server, client, err := SplitQueryParams("/views/", Params{
+		"name":            "a",
+		"path__icontains": "b",
+		"page_size":       10,
+	})
+	if err != nil {
+		t.Fatalf("SplitQueryParams: %v", err)
+	}
+	if len(server) != 2 || server["name"] != "a" || server["page_size"] != 10 {
+		t.Fatalf("unexpected server params: %v", server)
+	}
+	if len(client) != 1 || client["path__icontains"] != "b" {
+		t.Fatalf("unexpected client params: %v", client)
+	}
+}
+
+func TestResourceIterator_StrictFilter(t *testing.T) {
+	srv := &pagedTestServer{total: 12}
+	var mu sync.Mutex
+	var queries []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries = append(queries, r.URL.RawQuery)
+		mu.Unlock()
+		srv.handler()(w, r)
+	}))
+	defer server.Close()
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+
+	// /users/ declares only page and page_size, so the lookup is applied client-side.
+	it := NewResourceIterator(context.Background(), resource, Params{"name__endswith": "1"}, 5, WithStrictFilter()).(*ResourceIterator)
+	ids := collectIDs(t, it)
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 11 {
+		t.Fatalf("expected ids [1 11], got %v", ids)
+	}
+	for _, q := range queries {
+		if strings.Contains(q, "name__endswith") {
+			t.Fatalf("unsupported lookup must not be sent to the server: %s", q)
+		}
+	}
+
+	cursor := it.Cursor()
+	if !cursor.Strict || cursor.Query["name__endswith"] != "1" {
+		t.Fatalf("cursor must keep the client-side filter: %+v", cursor)
+	}
+
+	// Parallel pages apply the same filter.
+	parallel := NewResourceIterator(context.Background(), resource, Params{"name__endswith": "1"}, 3,
+		WithStrictFilter(), WithParallelPages(3)).(*ResourceIterator)
+	if ids := collectIDs(t, parallel); len(ids) != 2 {
+		t.Fatalf("expected 2 records in parallel mode, got %v", ids)
+	}
+}
+
+func TestResourceIterator_StrictFilterParallelDefaultPageSize(t *testing.T) {
+	const total, serverPageSize = 12, 5
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if (page-1)*serverPageSize >= total {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail": "Invalid page."}`))
+			return
+		}
+		var results []map[string]any
+		for i := (page-1)*serverPageSize + 1; i <= page*serverPageSize && i <= total; i++ {
+			results = append(results, map[string]any{"id": i, "name": fmt.Sprintf("u%d", i)})
+		}
+		var next any
+		if page*serverPageSize < total {
+			next = fmt.Sprintf("https://%s%s?page=%d", r.Host, r.URL.Path, page+1)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"count": total, "next": next, "previous": nil, "results": results})
+	}))
+	defer server.Close()
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+
+	// Without a page size, the filtered first page (u1 only) must not be taken as the server's page size.
+	it := NewResourceIterator(context.Background(), resource, Params{"name__endswith": "1"}, 0,
+		WithStrictFilter(), WithParallelPages(3)).(*ResourceIterator)
+	ids := collectIDs(t, it)
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 11 {
+		t.Fatalf("expected ids [1 11], got %v", ids)
+	}
+}
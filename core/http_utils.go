@@ -3,12 +3,15 @@ package core
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	urlpkg "net/url"
 	"reflect"
 	"strings"
+
+	"github.com/vast-data/go-vast-client/openapi_schema"
 )
 
 // validateResponse checks the response for valid HTTP status codes (specifically for 2xx codes).
@@ -51,6 +54,45 @@ func validateResponse(response *http.Response, host string, port uint64) error {
 	}
 }
 
+// validateRequest checks query params and body against the OpenAPI schema of the operation
+// (see VMSConfig.ValidateRequests). Issues from both are reported in one
+// *openapi_schema.ValidationError. Operations missing from the schema are not validated.
+//
+// Undeclared query keys are sent as-is: filters such as id__gt or name are accepted by VMS
+// on endpoints that only declare pagination.
+func validateRequest(verb, path string, params, body Params) error {
+	var merged *openapi_schema.ValidationError
+	collect := func(err error) error {
+		var verr *openapi_schema.ValidationError
+		switch {
+		case err == nil, errors.Is(err, openapi_schema.ErrOperationNotFound):
+			return nil
+		case errors.As(err, &verr):
+			if merged == nil {
+				merged = verr
+			} else {
+				merged.Issues = append(merged.Issues, verr.Issues...)
+			}
+			return nil
+		}
+		return err
+	}
+	if len(params) > 0 {
+		if err := collect(openapi_schema.ValidateDeclaredQueryParams(verb, path, params)); err != nil {
+			return err
+		}
+	}
+	if body != nil && verb != http.MethodGet {
+		if err := collect(openapi_schema.ValidateRequestBody(verb, path, body)); err != nil {
+			return err
+		}
+	}
+	if merged != nil {
+		return merged
+	}
+	return nil
+}
+
 // pathToUrl returns a full URI string based on the provided input.
 // If the input string is already a full URI (i.e., contains a scheme like "http" or "https"),
 // it is returned unchanged.
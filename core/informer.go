@@ -0,0 +1,662 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ######################################################
+//              INFORMER EVENTS AND HANDLERS
+// ######################################################
+
+// DefaultRelistInterval is used by informers when InformerOptions.RelistInterval is not set.
+const DefaultRelistInterval = 30 * time.Second
+
+// ResourceEventHandler receives change notifications from an Informer.
+// Handlers are invoked sequentially from the informer goroutine and must not block for long.
+type ResourceEventHandler interface {
+	OnAdd(obj Record)
+	OnUpdate(oldObj, newObj Record)
+	OnDelete(obj Record)
+}
+
+// ResourceEventHandlerFuncs adapts plain functions to ResourceEventHandler.
+// Nil functions are ignored.
+type ResourceEventHandlerFuncs struct {
+	AddFunc    func(obj Record)
+	UpdateFunc func(oldObj, newObj Record)
+	DeleteFunc func(obj Record)
+}
+
+func (f ResourceEventHandlerFuncs) OnAdd(obj Record) {
+	if f.AddFunc != nil {
+		f.AddFunc(obj)
+	}
+}
+
+func (f ResourceEventHandlerFuncs) OnUpdate(oldObj, newObj Record) {
+	if f.UpdateFunc != nil {
+		f.UpdateFunc(oldObj, newObj)
+	}
+}
+
+func (f ResourceEventHandlerFuncs) OnDelete(obj Record) {
+	if f.DeleteFunc != nil {
+		f.DeleteFunc(obj)
+	}
+}
+
+// ######################################################
+//              RECORD STORE
+// ######################################################
+
+// IndexFunc computes index values for a record. Returning no values excludes the record from the index.
+type IndexFunc func(Record) []string
+
+// Indexers maps index names to index functions.
+type Indexers map[string]IndexFunc
+
+// Built-in index names.
+const (
+	IndexName     = "name"
+	IndexTenantID = "tenant_id"
+	IndexPath     = "path"
+)
+
+// FieldIndexFunc returns an IndexFunc indexing records by the string value of a top-level field.
+func FieldIndexFunc(field string) IndexFunc {
+	return func(r Record) []string {
+		v, ok := r[field]
+		if !ok || v == nil {
+			return nil
+		}
+		if f, ok := v.(float64); ok && f == float64(int64(f)) {
+			return []string{fmt.Sprintf("%d", int64(f))}
+		}
+		return []string{fmt.Sprintf("%v", v)}
+	}
+}
+
+// DefaultIndexers indexes records by name, tenant_id and path.
+func DefaultIndexers() Indexers {
+	return Indexers{
+		IndexName:     FieldIndexFunc("name"),
+		IndexTenantID: FieldIndexFunc("tenant_id"),
+		IndexPath:     FieldIndexFunc("path"),
+	}
+}
+
+// RecordKeyFunc returns the store key of a record. Records without a key are skipped.
+type RecordKeyFunc func(Record) (string, bool)
+
+// RecordIDKey keys records by their "id" field.
+func RecordIDKey(r Record) (string, bool) {
+	v, ok := r["id"]
+	if !ok || v == nil {
+		return "", false
+	}
+	if f, ok := v.(float64); ok && f == float64(int64(f)) {
+		return fmt.Sprintf("%d", int64(f)), true
+	}
+	return fmt.Sprintf("%v", v), true
+}
+
+// RecordStore is a thread-safe local cache of records keyed by id with secondary indexes.
+type RecordStore struct {
+	mu       sync.RWMutex
+	keyFunc  RecordKeyFunc
+	items    map[string]Record
+	indexers Indexers
+	// indices: index name -> index value -> set of keys
+	indices map[string]map[string]map[string]struct{}
+}
+
+// NewRecordStore creates an empty store. A nil keyFunc defaults to RecordIDKey.
+func NewRecordStore(keyFunc RecordKeyFunc, indexers Indexers) *RecordStore {
+	if keyFunc == nil {
+		keyFunc = RecordIDKey
+	}
+	if indexers == nil {
+		indexers = Indexers{}
+	}
+	s := &RecordStore{
+		keyFunc:  keyFunc,
+		items:    make(map[string]Record),
+		indexers: indexers,
+		indices:  make(map[string]map[string]map[string]struct{}),
+	}
+	for name := range indexers {
+		s.indices[name] = make(map[string]map[string]struct{})
+	}
+	return s
+}
+
+// Get returns the record stored under key.
+func (s *RecordStore) Get(key string) (Record, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	r, ok := s.items[key]
+	return r, ok
+}
+
+// GetByID returns the record with the given id.
+func (s *RecordStore) GetByID(id any) (Record, bool) {
+	key, _ := RecordIDKey(Record{"id": id})
+	return s.Get(key)
+}
+
+// List returns all records sorted by key.
+func (s *RecordStore) List() RecordSet {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]string, 0, len(s.items))
+	for k := range s.items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make(RecordSet, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, s.items[k])
+	}
+	return out
+}
+
+// ListKeys returns all keys in sorted order.
+func (s *RecordStore) ListKeys() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	keys := make([]string, 0, len(s.items))
+	for k := range s.items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Len returns the number of records in the store.
+func (s *RecordStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.items)
+}
+
+// ByIndex returns records whose index value matches.
+func (s *RecordStore) ByIndex(indexName, value string) (RecordSet, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	index, ok := s.indices[indexName]
+	if !ok {
+		return nil, fmt.Errorf("index %q does not exist", indexName)
+	}
+	keys := make([]string, 0, len(index[value]))
+	for k := range index[value] {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make(RecordSet, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, s.items[k])
+	}
+	return out, nil
+}
+
+// IndexValues returns all values present in the given index, sorted.
+func (s *RecordStore) IndexValues(indexName string) []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	values := make([]string, 0, len(s.indices[indexName]))
+	for v := range s.indices[indexName] {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return values
+}
+
+// Add inserts or replaces a record.
+func (s *RecordStore) Add(r Record) {
+	key, ok := s.keyFunc(r)
+	if !ok {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.put(key, r)
+}
+
+// Delete removes a record by key.
+func (s *RecordStore) Delete(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.remove(key)
+}
+
+func (s *RecordStore) put(key string, r Record) {
+	if old, ok := s.items[key]; ok {
+		s.unindex(key, old)
+	}
+	s.items[key] = r
+	for name, fn := range s.indexers {
+		for _, v := range fn(r) {
+			set, ok := s.indices[name][v]
+			if !ok {
+				set = make(map[string]struct{})
+				s.indices[name][v] = set
+			}
+			set[key] = empty
+		}
+	}
+}
+
+func (s *RecordStore) remove(key string) {
+	if old, ok := s.items[key]; ok {
+		s.unindex(key, old)
+		delete(s.items, key)
+	}
+}
+
+func (s *RecordStore) unindex(key string, r Record) {
+	for name, fn := range s.indexers {
+		for _, v := range fn(r) {
+			if set, ok := s.indices[name][v]; ok {
+				delete(set, key)
+				if len(set) == 0 {
+					delete(s.indices[name], v)
+				}
+			}
+		}
+	}
+}
+
+// storeDelta describes a single change produced by RecordStore.replace.
+type storeDelta struct {
+	eventType string
+	old, new  Record
+}
+
+const (
+	eventAdded   = "added"
+	eventUpdated = "updated"
+	eventDeleted = "deleted"
+)
+
+// replace atomically swaps the store content with records and returns the changes.
+func (s *RecordStore) replace(records RecordSet, diffOpts *DiffOptions) []storeDelta {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deltas []storeDelta
+	seen := make(map[string]struct{}, len(records))
+	for _, r := range records {
+		key, ok := s.keyFunc(r)
+		if !ok {
+			continue
+		}
+		seen[key] = empty
+		old, exists := s.items[key]
+		switch {
+		case !exists:
+			deltas = append(deltas, storeDelta{eventType: eventAdded, new: r})
+		case !Equal(old, r, diffOpts):
+			deltas = append(deltas, storeDelta{eventType: eventUpdated, old: old, new: r})
+		default:
+			continue
+		}
+		s.put(key, r)
+	}
+
+	var removed []string
+	for key := range s.items {
+		if _, ok := seen[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+	for _, key := range removed {
+		deltas = append(deltas, storeDelta{eventType: eventDeleted, old: s.items[key]})
+		s.remove(key)
+	}
+	return deltas
+}
+
+// ######################################################
+//              INFORMER
+// ######################################################
+
+// InformerOptions configures an Informer.
+type InformerOptions struct {
+	// Params filter the relist request (e.g. Params{"tenant_id": 1}).
+	Params Params
+	// PageSize used for each relist (0 uses session PageSize).
+	PageSize int
+	// RelistInterval is the polling period (default: DefaultRelistInterval).
+	RelistInterval time.Duration
+	// ResyncInterval, when > 0, periodically re-delivers OnUpdate(obj, obj) for every cached record.
+	ResyncInterval time.Duration
+	// Indexers for the local store (default: DefaultIndexers()).
+	Indexers Indexers
+	// KeyFunc for the local store (default: RecordIDKey).
+	KeyFunc RecordKeyFunc
+	// IgnoreFields are excluded when detecting updates (e.g. frequently changing metrics).
+	// Uses DiffOptions.IgnorePaths syntax.
+	IgnoreFields []string
+	// OnError is called when a relist fails. The informer keeps running and retries on the next tick.
+	OnError func(error)
+}
+
+// Informer keeps a local cache of a resource collection up to date by periodically relisting it
+// and emits Added/Updated/Deleted events to registered handlers.
+//
+// Example:
+//
+//	informer := core.NewInformer(rest.Views, &core.InformerOptions{RelistInterval: 10 * time.Second})
+//	informer.AddEventHandler(core.ResourceEventHandlerFuncs{
+//	    AddFunc: func(obj core.Record) { fmt.Println("added", obj.RecordName()) },
+//	})
+//	go informer.Run(ctx)
+//	informer.WaitForCacheSync(ctx)
+type Informer struct {
+	resource VastResourceAPIWithContext
+	opts     InformerOptions
+	store    *RecordStore
+	diffOpts *DiffOptions
+
+	handlersMu sync.Mutex
+	handlers   []ResourceEventHandler
+
+	mu       sync.RWMutex
+	synced   bool
+	running  bool
+	lastErr  error
+	lastSync time.Time
+	syncedCh chan struct{}
+}
+
+// NewInformer creates an informer for the given resource. Call Run to start it.
+func NewInformer(resource VastResourceAPIWithContext, opts *InformerOptions) *Informer {
+	var o InformerOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.RelistInterval <= 0 {
+		o.RelistInterval = DefaultRelistInterval
+	}
+	if o.Indexers == nil {
+		o.Indexers = DefaultIndexers()
+	}
+	var diffOpts *DiffOptions
+	if len(o.IgnoreFields) > 0 {
+		diffOpts = &DiffOptions{IgnorePaths: o.IgnoreFields}
+	}
+	return &Informer{
+		resource: resource,
+		opts:     o,
+		store:    NewRecordStore(o.KeyFunc, o.Indexers),
+		diffOpts: diffOpts,
+		syncedCh: make(chan struct{}),
+	}
+}
+
+// Store returns the informer's local cache. It must be treated as read-only.
+func (inf *Informer) Store() *RecordStore {
+	return inf.store
+}
+
+// ResourceType returns the type of the watched resource.
+func (inf *Informer) ResourceType() string {
+	return inf.resource.GetResourceType()
+}
+
+// AddEventHandler registers a handler. If the informer has already synced,
+// the handler immediately receives OnAdd for every cached record.
+func (inf *Informer) AddEventHandler(handler ResourceEventHandler) {
+	inf.handlersMu.Lock()
+	defer inf.handlersMu.Unlock()
+	inf.handlers = append(inf.handlers, handler)
+	if inf.HasSynced() {
+		for _, r := range inf.store.List() {
+			handler.OnAdd(r)
+		}
+	}
+}
+
+// HasSynced reports whether the initial list has completed successfully.
+func (inf *Informer) HasSynced() bool {
+	inf.mu.RLock()
+	defer inf.mu.RUnlock()
+	return inf.synced
+}
+
+// WaitForCacheSync blocks until the informer has synced or ctx is done.
+func (inf *Informer) WaitForCacheSync(ctx context.Context) bool {
+	select {
+	case <-inf.syncedCh:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// LastSyncError returns the error of the most recent relist (nil on success).
+func (inf *Informer) LastSyncError() error {
+	inf.mu.RLock()
+	defer inf.mu.RUnlock()
+	return inf.lastErr
+}
+
+// LastSyncTime returns the time of the most recent successful relist.
+func (inf *Informer) LastSyncTime() time.Time {
+	inf.mu.RLock()
+	defer inf.mu.RUnlock()
+	return inf.lastSync
+}
+
+// Run lists the resource and keeps relisting until ctx is canceled. It blocks.
+// Calling Run on an already running informer returns immediately.
+func (inf *Informer) Run(ctx context.Context) {
+	inf.mu.Lock()
+	if inf.running {
+		inf.mu.Unlock()
+		return
+	}
+	inf.running = true
+	inf.mu.Unlock()
+	defer func() {
+		inf.mu.Lock()
+		inf.running = false
+		inf.mu.Unlock()
+	}()
+
+	relist := time.NewTicker(inf.opts.RelistInterval)
+	defer relist.Stop()
+
+	var resync <-chan time.Time
+	if inf.opts.ResyncInterval > 0 {
+		ticker := time.NewTicker(inf.opts.ResyncInterval)
+		defer ticker.Stop()
+		resync = ticker.C
+	}
+
+	_ = inf.Relist(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-relist.C:
+			_ = inf.Relist(ctx)
+		case <-resync:
+			inf.resync()
+		}
+	}
+}
+
+// Relist fetches the full collection once, updates the store and dispatches events.
+// It is called periodically by Run but may also be invoked directly to force a refresh.
+func (inf *Informer) Relist(ctx context.Context) error {
+	params := make(Params, len(inf.opts.Params))
+	for k, v := range inf.opts.Params {
+		params[k] = v
+	}
+	records, err := inf.resource.GetIteratorWithContext(ctx, params, inf.opts.PageSize).All()
+
+	inf.mu.Lock()
+	inf.lastErr = err
+	inf.mu.Unlock()
+	if err != nil {
+		if inf.opts.OnError != nil && ctx.Err() == nil {
+			inf.opts.OnError(err)
+		}
+		return err
+	}
+
+	// Hold handlersMu so that handlers registered concurrently observe either
+	// the full pre-relist state plus events, or the post-relist state only.
+	inf.handlersMu.Lock()
+	deltas := inf.store.replace(records, inf.diffOpts)
+	inf.mu.Lock()
+	inf.lastSync = time.Now()
+	firstSync := !inf.synced
+	inf.synced = true
+	inf.mu.Unlock()
+	inf.dispatchLocked(deltas)
+	inf.handlersMu.Unlock()
+
+	if firstSync {
+		close(inf.syncedCh)
+	}
+	return nil
+}
+
+func (inf *Informer) resync() {
+	if !inf.HasSynced() {
+		return
+	}
+	inf.handlersMu.Lock()
+	defer inf.handlersMu.Unlock()
+	records := inf.store.List()
+	deltas := make([]storeDelta, len(records))
+	for i, r := range records {
+		deltas[i] = storeDelta{eventType: eventUpdated, old: r, new: r}
+	}
+	inf.dispatchLocked(deltas)
+}
+
+// dispatchLocked delivers deltas to all handlers. Caller must hold handlersMu.
+func (inf *Informer) dispatchLocked(deltas []storeDelta) {
+	for _, d := range deltas {
+		for _, h := range inf.handlers {
+			switch d.eventType {
+			case eventAdded:
+				h.OnAdd(d.new)
+			case eventUpdated:
+				h.OnUpdate(d.old, d.new)
+			case eventDeleted:
+				h.OnDelete(d.old)
+			}
+		}
+	}
+}
+
+// ######################################################
+//              SHARED INFORMER FACTORY
+// ######################################################
+
+// SharedInformerFactory hands out one Informer per resource type so that multiple
+// consumers share the same relist loop and cache.
+//
+// Example:
+//
+//	factory := core.NewSharedInformerFactory(rest, 15*time.Second)
+//	views, err := factory.ForResource("View")
+//	if err != nil {
+//	    return err
+//	}
+//	quotas, err := factory.ForResource("Quota")
+//	if err != nil {
+//	    return err
+//	}
+//	factory.Start(ctx)
+//	factory.WaitForCacheSync(ctx)
+type SharedInformerFactory struct {
+	rest           VastRest
+	relistInterval time.Duration
+
+	mu        sync.Mutex
+	informers map[string]*Informer
+	started   map[string]bool
+	ctx       context.Context
+}
+
+// NewSharedInformerFactory creates a factory for informers of resources registered on rest.
+// relistInterval is used for informers created without explicit options.
+func NewSharedInformerFactory(rest VastRest, relistInterval time.Duration) *SharedInformerFactory {
+	return &SharedInformerFactory{
+		rest:           rest,
+		relistInterval: relistInterval,
+		informers:      make(map[string]*Informer),
+		started:        make(map[string]bool),
+	}
+}
+
+// ForResource returns the shared informer for resourceType (e.g. "View", "Quota"), creating it if needed.
+// opts are only applied when the informer is created; later callers receive the existing instance.
+// Informers created after Start are started immediately.
+func (f *SharedInformerFactory) ForResource(resourceType string, opts ...*InformerOptions) (*Informer, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if inf, ok := f.informers[resourceType]; ok {
+		return inf, nil
+	}
+	resource, ok := f.rest.GetResourceMap()[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("resource type %q is not registered", resourceType)
+	}
+	options := &InformerOptions{RelistInterval: f.relistInterval}
+	if len(opts) > 0 && opts[0] != nil {
+		options = opts[0]
+		if options.RelistInterval <= 0 {
+			options.RelistInterval = f.relistInterval
+		}
+	}
+	inf := NewInformer(resource, options)
+	f.informers[resourceType] = inf
+	if f.ctx != nil {
+		f.started[resourceType] = true
+		go inf.Run(f.ctx)
+	}
+	return inf, nil
+}
+
+// Start runs all informers that have not been started yet. They stop when ctx is canceled.
+func (f *SharedInformerFactory) Start(ctx context.Context) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.ctx = ctx
+	for resourceType, inf := range f.informers {
+		if f.started[resourceType] {
+			continue
+		}
+		f.started[resourceType] = true
+		go inf.Run(ctx)
+	}
+}
+
+// WaitForCacheSync waits for all started informers to sync and returns the sync state per resource type.
+func (f *SharedInformerFactory) WaitForCacheSync(ctx context.Context) map[string]bool {
+	f.mu.Lock()
+	informers := make(map[string]*Informer, len(f.informers))
+	for resourceType, inf := range f.informers {
+		if f.started[resourceType] {
+			informers[resourceType] = inf
+		}
+	}
+	f.mu.Unlock()
+
+	result := make(map[string]bool, len(informers))
+	for resourceType, inf := range informers {
+		result[resourceType] = inf.WaitForCacheSync(ctx)
+	}
+	return result
+}
@@ -0,0 +1,280 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// informerTestServer serves a mutable list of records for GET requests.
+type informerTestServer struct {
+	mu      sync.Mutex
+	records []map[string]any
+	fail    bool
+	calls   int
+}
+
+func (s *informerTestServer) set(records ...map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = records
+}
+
+func (s *informerTestServer) handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.calls++
+		if s.fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"detail":"boom"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"count":   len(s.records),
+			"results": s.records,
+		})
+	}
+}
+
+type recordingHandler struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (h *recordingHandler) record(e string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.events = append(h.events, e)
+}
+
+func (h *recordingHandler) OnAdd(obj Record) { h.record("add:" + obj.RecordName()) }
+func (h *recordingHandler) OnUpdate(oldObj, newObj Record) {
+	h.record("update:" + oldObj.RecordName() + "->" + newObj.RecordName())
+}
+func (h *recordingHandler) OnDelete(obj Record) { h.record("delete:" + obj.RecordName()) }
+
+func (h *recordingHandler) take() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	out := h.events
+	h.events = nil
+	return out
+}
+
+func TestInformer_RelistEmitsEvents(t *testing.T) {
+	srv := &informerTestServer{}
+	srv.set(
+		map[string]any{"id": 1, "name": "a", "tenant_id": 1, "path": "/a"},
+		map[string]any{"id": 2, "name": "b", "tenant_id": 2, "path": "/b"},
+	)
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+	informer := NewInformer(resource, &InformerOptions{RelistInterval: time.Hour})
+	handler := &recordingHandler{}
+	informer.AddEventHandler(handler)
+
+	if informer.HasSynced() {
+		t.Fatal("must not be synced before first relist")
+	}
+	if err := informer.Relist(context.Background()); err != nil {
+		t.Fatalf("Relist: %v", err)
+	}
+	if !informer.HasSynced() {
+		t.Fatal("expected synced after relist")
+	}
+	if got := handler.take(); len(got) != 2 || got[0] != "add:a" || got[1] != "add:b" {
+		t.Fatalf("unexpected events: %v", got)
+	}
+
+	srv.set(
+		map[string]any{"id": 1, "name": "a2", "tenant_id": 1, "path": "/a"},
+		map[string]any{"id": 3, "name": "c", "tenant_id": 1, "path": "/c"},
+	)
+	if err := informer.Relist(context.Background()); err != nil {
+		t.Fatalf("Relist: %v", err)
+	}
+	got := handler.take()
+	want := []string{"update:a->a2", "add:c", "delete:b"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	// Unchanged relist emits nothing.
+	_ = informer.Relist(context.Background())
+	if got := handler.take(); len(got) != 0 {
+		t.Fatalf("expected no events, got %v", got)
+	}
+
+	store := informer.Store()
+	if store.Len() != 2 {
+		t.Fatalf("expected 2 records in store, got %d", store.Len())
+	}
+	if r, ok := store.GetByID(3); !ok || r.RecordName() != "c" {
+		t.Fatalf("GetByID(3) = %v, %v", r, ok)
+	}
+	byTenant, err := store.ByIndex(IndexTenantID, "1")
+	if err != nil || len(byTenant) != 2 {
+		t.Fatalf("ByIndex tenant_id=1: %v %v", byTenant, err)
+	}
+	if byName, _ := store.ByIndex(IndexName, "a"); len(byName) != 0 {
+		t.Fatalf("stale index entry for renamed record: %v", byName)
+	}
+	if _, err := store.ByIndex("missing", "x"); err == nil {
+		t.Fatal("expected error for unknown index")
+	}
+}
+
+func TestInformer_IgnoreFieldsAndErrors(t *testing.T) {
+	srv := &informerTestServer{}
+	srv.set(map[string]any{"id": 1, "name": "a", "used": 1})
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+
+	var errs []error
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+	informer := NewInformer(resource, &InformerOptions{
+		IgnoreFields: []string{"used"},
+		OnError:      func(err error) { errs = append(errs, err) },
+	})
+	handler := &recordingHandler{}
+	informer.AddEventHandler(handler)
+	_ = informer.Relist(context.Background())
+	handler.take()
+
+	srv.set(map[string]any{"id": 1, "name": "a", "used": 2})
+	_ = informer.Relist(context.Background())
+	if got := handler.take(); len(got) != 0 {
+		t.Fatalf("ignored field change must not emit events: %v", got)
+	}
+
+	srv.mu.Lock()
+	srv.fail = true
+	srv.mu.Unlock()
+	if err := informer.Relist(context.Background()); err == nil {
+		t.Fatal("expected relist error")
+	}
+	if len(errs) != 1 || informer.LastSyncError() == nil {
+		t.Fatalf("expected OnError to be called once, got %v", errs)
+	}
+	if informer.Store().Len() != 1 {
+		t.Fatal("failed relist must keep the cache")
+	}
+}
+
+func TestInformer_LateHandlerReceivesExisting(t *testing.T) {
+	srv := &informerTestServer{}
+	srv.set(map[string]any{"id": 1, "name": "a"}, map[string]any{"id": 2, "name": "b"})
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+
+	informer := NewInformer(newCRUDTestResource(t, server, NewResourceOps(L)), nil)
+	_ = informer.Relist(context.Background())
+
+	handler := &recordingHandler{}
+	informer.AddEventHandler(handler)
+	if got := handler.take(); len(got) != 2 {
+		t.Fatalf("expected synthetic add events, got %v", got)
+	}
+}
+
+func TestInformer_RunResyncAndShutdown(t *testing.T) {
+	srv := &informerTestServer{}
+	srv.set(map[string]any{"id": 1, "name": "a"})
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+
+	informer := NewInformer(newCRUDTestResource(t, server, NewResourceOps(L)), &InformerOptions{
+		RelistInterval: 20 * time.Millisecond,
+		ResyncInterval: 30 * time.Millisecond,
+	})
+	handler := &recordingHandler{}
+	informer.AddEventHandler(handler)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		informer.Run(ctx)
+		close(done)
+	}()
+
+	syncCtx, syncCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer syncCancel()
+	if !informer.WaitForCacheSync(syncCtx) {
+		t.Fatal("informer did not sync")
+	}
+
+	srv.set(map[string]any{"id": 1, "name": "a"}, map[string]any{"id": 2, "name": "b"})
+	deadline := time.Now().Add(5 * time.Second)
+	var sawAdd, sawResync bool
+	for time.Now().Before(deadline) && !(sawAdd && sawResync) {
+		for _, e := range handler.take() {
+			switch e {
+			case "add:b":
+				sawAdd = true
+			case "update:a->a":
+				sawResync = true
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !sawAdd || !sawResync {
+		t.Fatalf("expected relist add and resync update (add=%v resync=%v)", sawAdd, sawResync)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not stop after context cancellation")
+	}
+}
+
+func TestSharedInformerFactory_SharesInformers(t *testing.T) {
+	srv := &informerTestServer{}
+	srv.set(map[string]any{"id": 1, "name": "a"})
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+	factory := NewSharedInformerFactory(resource.Rest, time.Hour)
+
+	first, err := factory.ForResource("User")
+	if err != nil {
+		t.Fatalf("ForResource: %v", err)
+	}
+	second, _ := factory.ForResource("User")
+	if first != second {
+		t.Fatal("expected the same informer instance for the same resource type")
+	}
+	if _, err := factory.ForResource("Unknown"); err == nil {
+		t.Fatal("expected error for unknown resource type")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	factory.Start(ctx)
+	factory.Start(ctx) // idempotent
+
+	syncCtx, syncCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer syncCancel()
+	synced := factory.WaitForCacheSync(syncCtx)
+	if !synced["User"] {
+		t.Fatalf("expected User informer to sync: %v", synced)
+	}
+	if first.Store().Len() != 1 {
+		t.Fatalf("expected 1 cached record, got %d", first.Store().Len())
+	}
+}
@@ -29,6 +29,13 @@ type VastResourceAPI interface {
 	// Internal methods
 }
 
+// ResourceLocker is implemented by resources supporting non-blocking and cancellable locks.
+type ResourceLocker interface {
+	TryLock(...any) (func(), error)
+	LockWithContext(context.Context, ...any) (func(), error)
+	LockHolder(...any) (*LockHolder, error)
+}
+
 type VastResourceAPIWithContext interface {
 	VastResourceAPI
 	ListWithContext(context.Context, Params) (RecordSet, error)
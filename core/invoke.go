@@ -0,0 +1,149 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vast-data/go-vast-client/openapi_schema"
+)
+
+// ######################################################
+//              DYNAMIC EXTRA-METHOD INVOCATION
+// ######################################################
+
+// InvokeArgs are the arguments of InvokeExtraMethod.
+type InvokeArgs struct {
+	// PathParams fills the "{name}" placeholders of the URL template, e.g. {"id": 5}.
+	PathParams Params
+	// Query is sent as query string parameters.
+	Query Params
+	// Body is sent as the JSON request body. For methods that take no body
+	// (GET, and DELETE without a declared request body) it is merged into the query.
+	Body Params
+	// WaitTimeout waits up to this duration for async task responses to complete.
+	// Zero returns the AsyncResult without waiting.
+	WaitTimeout time.Duration
+}
+
+// InvokeExtraMethod calls a registered extra method by name without reflection.
+// resourceType is the resource name ("View") and methodName the registered method
+// name ("ViewLegalHold_PATCH"); the verb suffix may be omitted when the method is
+// registered for a single verb ("ViewLegalHold" is ambiguous, "ViewCloseSmbHandle" is not).
+//
+// When the response is an async task, the returned *AsyncResult tracks it
+// (waited for when args.WaitTimeout > 0); otherwise it is nil.
+//
+//	response, _, err := core.InvokeExtraMethod(ctx, rest, "View", "ViewLegalHold_PATCH", core.InvokeArgs{
+//	    PathParams: core.Params{"id": 5},
+//	    Body:       core.Params{"legal_hold_path": "/data", "enabled": true},
+//	})
+func InvokeExtraMethod(ctx context.Context, rest VastRest, resourceType, methodName string, args InvokeArgs) (Renderable, *AsyncResult, error) {
+	if ctx == nil {
+		ctx = rest.GetCtx()
+	}
+	metadata, err := findExtraMethod(resourceType, methodName)
+	if err != nil {
+		return nil, nil, err
+	}
+	path, err := interpolatePath(metadata.URLPath, args.PathParams)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", metadata.MethodName, err)
+	}
+
+	verb := strings.ToUpper(metadata.HTTPVerb)
+	query, body := args.Query, args.Body
+	if !extraMethodTakesBody(verb, metadata.URLPath) && len(body) > 0 {
+		query = make(Params, len(args.Query)+len(args.Body))
+		for k, v := range args.Query {
+			query[k] = v
+		}
+		for k, v := range args.Body {
+			if _, conflict := query[k]; conflict {
+				return nil, nil, fmt.Errorf("%s: %q is set in both Query and Body", metadata.MethodName, k)
+			}
+			query[k] = v
+		}
+		body = nil
+	}
+
+	resource, ok := rest.GetResourceMap()[resourceType]
+	if !ok {
+		resource = NewDummy(ctx, rest.GetSession())
+	}
+	response, _, err := requestRenderable(ctx, resource, verb, path, query, body, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	asyncResult, err := maybeWaitAsyncResult(ctx, response, rest, args.WaitTimeout)
+	return response, asyncResult, err
+}
+
+// findExtraMethod resolves methodName (with or without the "_VERB" suffix) for resourceType.
+func findExtraMethod(resourceType, methodName string) (ExtraMethodMetadata, error) {
+	methods, ok := ExtraMethodRegistry[resourceType]
+	if !ok {
+		return ExtraMethodMetadata{}, fmt.Errorf("no extra methods registered for resource %q", resourceType)
+	}
+	if metadata, ok := methods[methodName]; ok {
+		return metadata, nil
+	}
+	var candidates []string
+	for name := range methods {
+		if base, _, ok := strings.Cut(name, "_"); ok && base == methodName {
+			candidates = append(candidates, name)
+		}
+	}
+	sort.Strings(candidates)
+	switch len(candidates) {
+	case 1:
+		return methods[candidates[0]], nil
+	case 0:
+		return ExtraMethodMetadata{}, fmt.Errorf("extra method %q not registered for resource %q", methodName, resourceType)
+	}
+	return ExtraMethodMetadata{}, fmt.Errorf("extra method %q of resource %q is ambiguous: %s",
+		methodName, resourceType, strings.Join(candidates, ", "))
+}
+
+// interpolatePath replaces the "{name}" placeholders of template with escaped values from params.
+func interpolatePath(template string, params Params) (string, error) {
+	used := map[string]struct{}{}
+	var missing []string
+	path := pathPlaceholderPattern.ReplaceAllStringFunc(template, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		value, ok := params[name]
+		if !ok || value == nil {
+			missing = append(missing, name)
+			return placeholder
+		}
+		used[name] = empty
+		return url.PathEscape(formatPathParamValue(value))
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("missing path params %v for %s", missing, template)
+	}
+	for name := range params {
+		if _, ok := used[name]; !ok {
+			return "", fmt.Errorf("unknown path param %q for %s", name, template)
+		}
+	}
+	return path, nil
+}
+
+// extraMethodTakesBody reports whether the operation accepts a request body:
+// POST, PUT and PATCH always do, DELETE only when the OpenAPI schema declares body fields.
+func extraMethodTakesBody(verb, urlPath string) bool {
+	switch verb {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		return true
+	case http.MethodDelete:
+		schema, err := openapi_schema.GetRequestBodySchema(verb, urlPath)
+		return err == nil && schema != nil && schema.Value != nil && len(schema.Value.Properties) > 0
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestInvokeExtraMethod(t *testing.T) {
+	RegisterExtraMethod("View", "ViewLegalHold_GET", "GET", "/views/{id}/legal_hold/", "Get legal hold")
+	RegisterExtraMethod("View", "ViewLegalHold_PATCH", "PATCH", "/views/{id}/legal_hold/", "Set legal hold")
+	RegisterExtraMethod("View", "ViewListSeamlessPeers_GET", "GET", "/views/list_seamless_peers/", "List peers")
+	RegisterExtraMethod("View", "ViewCloseSmbHandle_DELETE", "DELETE", "/views/close_smb_handle/", "Close handles")
+	defer delete(ExtraMethodRegistry, "View")
+
+	var requests []string
+	var bodies []map[string]any
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, "list_seamless_peers") {
+			_ = json.NewEncoder(w).Encode([]any{map[string]any{"id": 1}, map[string]any{"id": 2}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": 5, "enabled": true})
+	}))
+	defer server.Close()
+
+	resource := newBulkTestResource(t, server, "views", "View")
+	rest := resource.Rest
+	ctx := context.Background()
+
+	response, task, err := InvokeExtraMethod(ctx, rest, "View", "ViewLegalHold_PATCH", InvokeArgs{
+		PathParams: Params{"id": 5},
+		Query:      Params{"dry": true},
+		Body:       Params{"legal_hold_path": "/data"},
+	})
+	if err != nil || task != nil {
+		t.Fatalf("Invoke: %v, %v", err, task)
+	}
+	if response.(Record)["enabled"] != true {
+		t.Errorf("response = %v", response)
+	}
+	if !strings.HasSuffix(requests[0], "/views/5/legal_hold/?dry=true") || bodies[0]["legal_hold_path"] != "/data" {
+		t.Errorf("PATCH request = %s %v", requests[0], bodies[0])
+	}
+
+	// Body of a method without request body is sent as query params.
+	if _, _, err = InvokeExtraMethod(ctx, rest, "View", "ViewCloseSmbHandle", InvokeArgs{Body: Params{"session_id": "s1"}}); err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+	if !strings.HasSuffix(requests[1], "/views/close_smb_handle/?session_id=s1") || bodies[1] != nil {
+		t.Errorf("DELETE request = %s %v", requests[1], bodies[1])
+	}
+
+	response, _, err = InvokeExtraMethod(ctx, rest, "View", "ViewListSeamlessPeers", InvokeArgs{})
+	if err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+	if set, ok := response.(RecordSet); !ok || len(set) != 2 {
+		t.Errorf("list responses must be returned as RecordSet, got %#v", response)
+	}
+
+	errorCases := []struct {
+		method string
+		args   InvokeArgs
+		want   string
+	}{
+		{"ViewLegalHold", InvokeArgs{PathParams: Params{"id": 5}}, "ambiguous"},
+		{"ViewMissing_GET", InvokeArgs{}, "not registered"},
+		{"ViewLegalHold_GET", InvokeArgs{}, "missing path params [id]"},
+		{"ViewLegalHold_GET", InvokeArgs{PathParams: Params{"id": 5, "name": "x"}}, `unknown path param "name"`},
+		{"ViewLegalHold_GET", InvokeArgs{PathParams: Params{"id": 5}, Query: Params{"a": 1}, Body: Params{"a": 2}}, "both Query and Body"},
+	}
+	for _, tc := range errorCases {
+		if _, _, err := InvokeExtraMethod(ctx, rest, "View", tc.method, tc.args); err == nil || !strings.Contains(err.Error(), tc.want) {
+			t.Errorf("%s: expected error containing %q, got %v", tc.method, tc.want, err)
+		}
+	}
+	if _, _, err := InvokeExtraMethod(ctx, rest, "Nope", "X", InvokeArgs{}); err == nil {
+		t.Error("expected error for unknown resource")
+	}
+	if len(requests) != 3 {
+		t.Errorf("failed invocations must not send requests, got %v", requests)
+	}
+}
+
+func TestInvokeExtraMethod_AsyncTask(t *testing.T) {
+	RegisterExtraMethod("Volume", "VolumeRefresh_PATCH", "PATCH", "/volumes/{id}/refresh/", "Refresh")
+	defer delete(ExtraMethodRegistry, "Volume")
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"async_task": map[string]any{"id": 77}})
+	}))
+	defer server.Close()
+
+	resource := newBulkTestResource(t, server, "volumes", "Volume")
+	_, task, err := InvokeExtraMethod(context.Background(), resource.Rest, "Volume", "VolumeRefresh", InvokeArgs{PathParams: Params{"id": "a/b"}})
+	if err != nil {
+		t.Fatalf("Invoke: %v", err)
+	}
+	if task == nil || task.TaskId != 77 {
+		t.Errorf("expected async result for task 77, got %+v", task)
+	}
+}
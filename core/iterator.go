@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 )
 
@@ -62,11 +63,34 @@ type ResourceIterator struct {
 	currentPage int
 	err         error
 	initialized bool
+
+	prefetch bool
+	parallel int
+
+	// stable ordering mode (see WithStableOrdering)
+	stable     bool
+	stableDone bool
+	lastID     *int64
+	firstCount int
+
+	// resume is set for iterators restored from a Cursor that were not consumed yet.
+	resume bool
+	// checkpoint overrides Cursor() while prefetch/parallel modes run ahead of the consumer.
+	checkpoint *Cursor
+
+	// strict filter mode (see WithStrictFilter)
+	strict       bool
+	clientFilter Params
+	filter       recordFilter
+	filterErr    error
+	// fetched is the last page as returned by the server, before client-side filtering.
+	fetched RecordSet
 }
 
 // NewResourceIterator creates an iterator that makes raw HTTP requests to preserve pagination metadata.
 // If pageSize is 0 or negative, uses the session's configured PageSize (default: 0 means no page_size param sent).
-func NewResourceIterator(ctx context.Context, resource VastResourceAPIWithContext, params Params, pageSize int) Iterator {
+// Optional IteratorOption values enable prefetching or parallel page fetching for Records()/Pages().
+func NewResourceIterator(ctx context.Context, resource VastResourceAPIWithContext, params Params, pageSize int, opts ...IteratorOption) Iterator {
 	if pageSize <= 0 {
 		// Get default page size from session config
 		config := resource.Session().GetConfig()
@@ -81,7 +105,7 @@ func NewResourceIterator(ctx context.Context, resource VastResourceAPIWithContex
 		params["page_size"] = pageSize
 	}
 
-	return &ResourceIterator{
+	it := &ResourceIterator{
 		resource:     resource,
 		ctx:          ctx,
 		initialQuery: params,
@@ -90,10 +114,23 @@ func NewResourceIterator(ctx context.Context, resource VastResourceAPIWithContex
 		currentPage:  0,
 		initialized:  false,
 	}
+	return it.WithOptions(opts...)
 }
 
 // fetchPage makes a raw HTTP request and processes the pagination envelope.
 func (it *ResourceIterator) fetchPage(url string, params Params) error {
+	if it.filterErr != nil {
+		return it.filterErr
+	}
+	if err := it.fetchRawPage(url, params); err != nil {
+		return err
+	}
+	it.fetched = it.current
+	it.current = it.filter.apply(it.current)
+	return nil
+}
+
+func (it *ResourceIterator) fetchRawPage(url string, params Params) error {
 	session := it.resource.Session()
 
 	// Make raw HTTP request
@@ -106,6 +143,11 @@ func (it *ResourceIterator) fetchPage(url string, params Params) error {
 	} else {
 		// Use resource path with params for first request
 		resourcePath := it.resource.GetResourcePath()
+		if session.GetConfig().ValidateRequests {
+			if err = validateRequest(http.MethodGet, resourcePath, params, nil); err != nil {
+				return err
+			}
+		}
 		query := params.ToQuery()
 		fullURL, buildErr := buildUrl(session, resourcePath, query, session.GetConfig().ApiVersion)
 		if buildErr != nil {
@@ -213,6 +255,15 @@ func (it *ResourceIterator) processPaginationEnvelope(envelope Record) error {
 
 // Next advances to the next page and returns the records and any error.
 func (it *ResourceIterator) Next() (RecordSet, error) {
+	it.checkpoint = nil
+	return it.next()
+}
+
+func (it *ResourceIterator) next() (RecordSet, error) {
+	it.resume = false
+	if it.stable {
+		return it.nextStable()
+	}
 	if !it.initialized {
 		it.err = it.fetchPage("", it.initialQuery)
 		it.initialized = true
@@ -237,6 +288,10 @@ func (it *ResourceIterator) Next() (RecordSet, error) {
 
 // Previous moves to the previous page and returns the records and any error.
 func (it *ResourceIterator) Previous() (RecordSet, error) {
+	if it.stable {
+		it.err = fmt.Errorf("Previous() is not supported with stable ordering")
+		return RecordSet{}, it.err
+	}
 	if !it.initialized {
 		it.err = fmt.Errorf("iterator not initialized, call Next() first")
 		return RecordSet{}, it.err
@@ -260,6 +315,9 @@ func (it *ResourceIterator) HasNext() bool {
 	if !it.initialized {
 		return true
 	}
+	if it.stable {
+		return !it.stableDone
+	}
 	return it.nextURL != nil && *it.nextURL != ""
 }
 
@@ -323,6 +381,11 @@ func (it *ResourceIterator) String() string {
 
 // Reset resets the iterator to the first page and returns the first page records.
 func (it *ResourceIterator) Reset() (RecordSet, error) {
+	it.checkpoint = nil
+	return it.reset()
+}
+
+func (it *ResourceIterator) reset() (RecordSet, error) {
 	it.initialized = false
 	it.current = nil
 	it.nextURL = nil
@@ -330,8 +393,11 @@ func (it *ResourceIterator) Reset() (RecordSet, error) {
 	it.currentPage = 0
 	it.err = nil
 	it.totalCount = -1
+	it.stableDone = false
+	it.lastID = nil
+	it.resume = false
 
-	return it.Next()
+	return it.next()
 }
 
 // All fetches all pages and returns all records.
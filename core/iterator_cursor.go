@@ -0,0 +1,219 @@
+package core
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// ######################################################
+//              ITERATOR CURSOR
+// ######################################################
+
+// Cursor is a serializable snapshot of a ResourceIterator position.
+// A job can persist it after processing each page and resume with NewResourceIteratorFromCursor.
+// Resuming is page-granular: the page that was being processed when the cursor was
+// taken is considered consumed, so checkpoint after a page is fully processed.
+//
+//	for page, err := range it.Pages() {
+//	    ...process page...
+//	    data, _ := json.Marshal(it.Cursor())
+//	    saveCheckpoint(data)
+//	}
+type Cursor struct {
+	ResourceType string `json:"resource_type"`
+	Query        Params `json:"query,omitempty"`
+	PageSize     int    `json:"page_size,omitempty"`
+	// Page is the 1-based number of the last consumed page (0 when nothing was fetched yet).
+	Page int `json:"page"`
+	// NextURL is the URL of the next page to fetch (empty when exhausted or in stable mode).
+	NextURL string `json:"next_url,omitempty"`
+	// Count is the total count reported by the first page (-1 if unknown).
+	Count int `json:"count"`
+	// Done is true when all pages were consumed.
+	Done bool `json:"done,omitempty"`
+	// Stable is true for iterators using stable ordering (see WithStableOrdering).
+	Stable bool `json:"stable,omitempty"`
+	// LastID is the id watermark of the last consumed record in stable mode.
+	LastID *int64 `json:"last_id,omitempty"`
+	// Strict is true for iterators filtering unsupported lookups client-side (see WithStrictFilter).
+	Strict bool `json:"strict,omitempty"`
+}
+
+// Encode returns the cursor as an opaque URL-safe string.
+func (c Cursor) Encode() (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeCursor parses a cursor produced by Cursor.Encode.
+func DecodeCursor(s string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor encoding: %w", err)
+	}
+	var c Cursor
+	if err = json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	return &c, nil
+}
+
+// WithStableOrdering makes the iterator order results by id and page through them
+// with an id__gt watermark instead of following next links.
+// Use it for resources whose page links shift when records are created or deleted
+// during iteration. Previous() is not supported in this mode.
+func WithStableOrdering() IteratorOption {
+	return func(it *ResourceIterator) {
+		it.stable = true
+	}
+}
+
+// Cursor returns a snapshot of the iterator position after the last consumed page.
+func (it *ResourceIterator) Cursor() Cursor {
+	if it.checkpoint != nil {
+		return *it.checkpoint
+	}
+	return it.snapshotCursor()
+}
+
+func (it *ResourceIterator) snapshotCursor() Cursor {
+	query := make(Params, len(it.initialQuery)+len(it.clientFilter))
+	for k, v := range it.initialQuery {
+		query[k] = v
+	}
+	for k, v := range it.clientFilter {
+		query[k] = v
+	}
+	c := Cursor{
+		ResourceType: it.resource.GetResourceType(),
+		Query:        query,
+		PageSize:     it.pageSize,
+		Count:        it.totalCount,
+		Stable:       it.stable,
+		Strict:       it.strict,
+	}
+	if !it.initialized {
+		return c
+	}
+	c.Page = it.currentPage + 1
+	if it.stable {
+		if it.lastID != nil {
+			id := *it.lastID
+			c.LastID = &id
+		}
+		c.Done = it.stableDone
+		return c
+	}
+	if it.nextURL != nil && *it.nextURL != "" {
+		c.NextURL = *it.nextURL
+	} else {
+		c.Done = true
+	}
+	return c
+}
+
+// NewResourceIteratorFromCursor restores an iterator from a cursor.
+// The first call to Next() (or the first page yielded by Records()/Pages()) returns
+// the page following the one recorded in the cursor.
+func NewResourceIteratorFromCursor(ctx context.Context, resource VastResourceAPIWithContext, cursor *Cursor, opts ...IteratorOption) (*ResourceIterator, error) {
+	if cursor == nil {
+		return nil, fmt.Errorf("cursor is nil")
+	}
+	if cursor.ResourceType != "" && cursor.ResourceType != resource.GetResourceType() {
+		return nil, fmt.Errorf(
+			"cursor was created for resource %q, cannot resume it on %q",
+			cursor.ResourceType, resource.GetResourceType(),
+		)
+	}
+	query := make(Params, len(cursor.Query))
+	for k, v := range cursor.Query {
+		query[k] = v
+	}
+	if cursor.Strict {
+		opts = append([]IteratorOption{WithStrictFilter()}, opts...)
+	}
+	it := NewResourceIterator(ctx, resource, query, cursor.PageSize, opts...).(*ResourceIterator)
+	it.stable = it.stable || cursor.Stable
+	if cursor.Page == 0 {
+		return it, nil
+	}
+
+	it.initialized = true
+	it.resume = true
+	it.currentPage = cursor.Page - 1
+	it.totalCount = cursor.Count
+	if it.stable {
+		if cursor.LastID != nil {
+			id := *cursor.LastID
+			it.lastID = &id
+		}
+		it.stableDone = cursor.Done
+		it.firstCount = cursor.Count
+		return it, nil
+	}
+	if !cursor.Done && cursor.NextURL != "" {
+		next := cursor.NextURL
+		it.nextURL = &next
+	}
+	return it, nil
+}
+
+// nextStable fetches the next page ordered by id after the current watermark.
+func (it *ResourceIterator) nextStable() (RecordSet, error) {
+	if it.initialized && it.stableDone {
+		return RecordSet{}, nil
+	}
+	params := make(Params, len(it.initialQuery)+2)
+	for k, v := range it.initialQuery {
+		params[k] = v
+	}
+	params["ordering"] = "id"
+	if it.lastID != nil {
+		params["id__gt"] = *it.lastID
+	}
+
+	wasInitialized := it.initialized
+	it.err = it.fetchPage("", params)
+	it.initialized = true
+	if it.err != nil {
+		return RecordSet{}, it.err
+	}
+	if wasInitialized {
+		it.currentPage++
+	} else {
+		it.firstCount = it.totalCount
+	}
+	// Keep the count snapshot from the first page; later pages report remaining records only.
+	it.totalCount = it.firstCount
+
+	// Watermark and exhaustion are based on the server page, before client-side filtering.
+	switch {
+	case len(it.fetched) == 0:
+		it.stableDone = true
+	case it.pageSize > 0:
+		it.stableDone = len(it.fetched) < it.pageSize
+	default:
+		it.stableDone = it.nextURL == nil
+	}
+	if n := len(it.fetched); n > 0 {
+		// Not RecordID: it panics on records without id.
+		id, _ := toInt(it.fetched[n-1]["id"])
+		// A watermark that does not advance (id__gt ignored, records without id) would
+		// request the same page forever.
+		if !it.stableDone && (id <= 0 || (it.lastID != nil && id <= *it.lastID)) {
+			it.stableDone = true
+			it.err = fmt.Errorf("stable ordering: id watermark did not advance (last id %d), the endpoint must support id__gt", id)
+			return RecordSet{}, it.err
+		}
+		it.lastID = &id
+	}
+	// Page links are not used in stable mode.
+	it.nextURL = nil
+	it.previousURL = nil
+	return it.current, nil
+}
@@ -0,0 +1,274 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestCursor_ResumeSequential(t *testing.T) {
+	srv := &pagedTestServer{total: 10}
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+
+	it := NewResourceIterator(context.Background(), resource, Params{"name__contains": "u"}, 3).(*ResourceIterator)
+	if c := it.Cursor(); c.Page != 0 || c.Done {
+		t.Fatalf("unexpected initial cursor: %+v", c)
+	}
+
+	var seen []int64
+	var checkpoint string
+	pages := 0
+	for page, err := range it.Pages() {
+		if err != nil {
+			t.Fatalf("Pages: %v", err)
+		}
+		for _, r := range page {
+			seen = append(seen, r.RecordID())
+		}
+		pages++
+		var encErr error
+		if checkpoint, encErr = it.Cursor().Encode(); encErr != nil {
+			t.Fatalf("Encode: %v", encErr)
+		}
+		if pages == 2 {
+			break // simulate a crash after two pages
+		}
+	}
+
+	cursor, err := DecodeCursor(checkpoint)
+	if err != nil {
+		t.Fatalf("DecodeCursor: %v", err)
+	}
+	if cursor.Page != 2 || cursor.Count != 10 || cursor.NextURL == "" || cursor.ResourceType != "User" {
+		t.Fatalf("unexpected cursor: %+v", cursor)
+	}
+
+	restored, err := NewResourceIteratorFromCursor(context.Background(), resource, cursor)
+	if err != nil {
+		t.Fatalf("NewResourceIteratorFromCursor: %v", err)
+	}
+	for record, err := range restored.Records() {
+		if err != nil {
+			t.Fatalf("Records: %v", err)
+		}
+		seen = append(seen, record.RecordID())
+	}
+	assertSequentialIDs(t, seen, 10)
+
+	final := restored.Cursor()
+	if !final.Done {
+		t.Fatalf("expected exhausted cursor, got %+v", final)
+	}
+	done, _ := NewResourceIteratorFromCursor(context.Background(), resource, &final)
+	if page, err := done.Next(); err != nil || len(page) != 0 || done.HasNext() {
+		t.Fatalf("exhausted cursor must not yield records: %v %v", page, err)
+	}
+}
+
+func TestCursor_PrefetchCheckpointMatchesConsumer(t *testing.T) {
+	srv := &pagedTestServer{total: 9}
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+
+	it := NewResourceIterator(context.Background(), resource, nil, 3, WithPrefetch()).(*ResourceIterator)
+	var cursor Cursor
+	for _, err := range it.Pages() {
+		if err != nil {
+			t.Fatalf("Pages: %v", err)
+		}
+		cursor = it.Cursor()
+		break
+	}
+	if cursor.Page != 1 {
+		t.Fatalf("checkpoint must reflect the consumed page, got %+v", cursor)
+	}
+
+	restored, _ := NewResourceIteratorFromCursor(context.Background(), resource, &cursor)
+	ids := collectIDs(t, restored)
+	if len(ids) != 6 || ids[0] != 4 {
+		t.Fatalf("expected to resume at id 4, got %v", ids)
+	}
+}
+
+func TestCursor_ParallelCheckpoint(t *testing.T) {
+	srv := &pagedTestServer{total: 12}
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+
+	it := NewResourceIterator(context.Background(), resource, nil, 3, WithParallelPages(3)).(*ResourceIterator)
+	var cursor Cursor
+	pages := 0
+	for _, err := range it.Pages() {
+		if err != nil {
+			t.Fatalf("Pages: %v", err)
+		}
+		pages++
+		cursor = it.Cursor()
+		if pages == 2 {
+			break
+		}
+	}
+	if cursor.Page != 2 || cursor.Done {
+		t.Fatalf("unexpected cursor: %+v", cursor)
+	}
+	restored, _ := NewResourceIteratorFromCursor(context.Background(), resource, &cursor)
+	ids := collectIDs(t, restored)
+	if len(ids) != 6 || ids[0] != 7 {
+		t.Fatalf("expected to resume at id 7, got %v", ids)
+	}
+}
+
+func TestCursor_ResourceTypeMismatch(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+
+	if _, err := NewResourceIteratorFromCursor(context.Background(), resource, &Cursor{ResourceType: "Quota", Page: 1}); err == nil {
+		t.Fatal("expected error for mismatched resource type")
+	}
+	if _, err := NewResourceIteratorFromCursor(context.Background(), resource, nil); err == nil {
+		t.Fatal("expected error for nil cursor")
+	}
+	if _, err := DecodeCursor("%%%"); err == nil {
+		t.Fatal("expected decode error")
+	}
+}
+
+// stableTestServer serves records honoring ordering=id and id__gt, and inserts a record
+// with a low id after the first request to shift offset-based pages.
+type stableTestServer struct {
+	mu       sync.Mutex
+	ids      []int
+	requests []string
+	inserted bool
+}
+
+func (s *stableTestServer) handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		q := r.URL.Query()
+		s.requests = append(s.requests, r.URL.RawQuery)
+		size, _ := strconv.Atoi(q.Get("page_size"))
+		gt := -1
+		if v := q.Get("id__gt"); v != "" {
+			gt, _ = strconv.Atoi(v)
+		}
+		sort.Ints(s.ids)
+		var results []map[string]any
+		for _, id := range s.ids {
+			if id > gt && len(results) < size {
+				results = append(results, map[string]any{"id": id})
+			}
+		}
+		var count int
+		for _, id := range s.ids {
+			if id > gt {
+				count++
+			}
+		}
+		if !s.inserted {
+			// A concurrent writer adds a record that would shift page-number based pagination.
+			s.ids = append(s.ids, 0)
+			s.inserted = true
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"count": count, "next": nil, "previous": nil, "results": results})
+	}
+}
+
+func TestStableOrdering_WatermarkAndResume(t *testing.T) {
+	srv := &stableTestServer{ids: []int{1, 2, 3, 4, 5, 6, 7}}
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+
+	it := NewResourceIterator(context.Background(), resource, nil, 3, WithStableOrdering()).(*ResourceIterator)
+	page, err := it.Next()
+	if err != nil || len(page) != 3 {
+		t.Fatalf("first page: %v %v", page, err)
+	}
+	if it.Count() != 7 {
+		t.Fatalf("expected count snapshot 7, got %d", it.Count())
+	}
+	cursor := it.Cursor()
+	if !cursor.Stable || cursor.LastID == nil || *cursor.LastID != 3 {
+		t.Fatalf("unexpected stable cursor: %+v", cursor)
+	}
+	if _, err := it.Previous(); err == nil {
+		t.Fatal("Previous must fail in stable mode")
+	}
+
+	restored, err := NewResourceIteratorFromCursor(context.Background(), resource, &cursor)
+	if err != nil {
+		t.Fatalf("NewResourceIteratorFromCursor: %v", err)
+	}
+	var rest []int64
+	for record, err := range restored.Records() {
+		if err != nil {
+			t.Fatalf("Records: %v", err)
+		}
+		rest = append(rest, record.RecordID())
+	}
+	if len(rest) != 4 || rest[0] != 4 || rest[3] != 7 {
+		t.Fatalf("expected ids 4..7 after watermark, got %v", rest)
+	}
+	if restored.Count() != 7 {
+		t.Fatalf("count snapshot must survive resume, got %d", restored.Count())
+	}
+
+	last := srv.requests[len(srv.requests)-1]
+	if q := parseQuery(t, last); q.Get("ordering") != "id" || q.Get("id__gt") != "6" {
+		t.Fatalf("unexpected stable query: %s", last)
+	}
+}
+
+func TestStableOrdering_WatermarkMustAdvance(t *testing.T) {
+	for name, record := range map[string]map[string]any{"id__gt ignored": {"id": 1}, "no id": {"name": "a"}} {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]any{"count": 5, "next": nil, "previous": nil, "results": []any{record, record}})
+			}))
+			defer server.Close()
+			resource := newCRUDTestResource(t, server, NewResourceOps(L))
+
+			it := NewResourceIterator(context.Background(), resource, nil, 2, WithStableOrdering()).(*ResourceIterator)
+			var (
+				records int
+				lastErr error
+			)
+			for _, err := range it.Records() {
+				if err != nil {
+					lastErr = err
+					break
+				}
+				if records++; records > 10 {
+					t.Fatal("iteration must stop when the watermark does not advance")
+				}
+			}
+			if lastErr == nil || !strings.Contains(lastErr.Error(), "did not advance") {
+				t.Fatalf("expected a watermark error, got %v", lastErr)
+			}
+		})
+	}
+}
+
+func parseQuery(t *testing.T, raw string) interface{ Get(string) string } {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, "https://x/?"+raw, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return req.URL.Query()
+}
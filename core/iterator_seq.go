@@ -0,0 +1,408 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sync"
+)
+
+// ######################################################
+//              ITERATOR OPTIONS
+// ######################################################
+
+// pageQueryParam is the page-number query parameter used by VMS pagination.
+const pageQueryParam = "page"
+
+// IteratorOption configures optional ResourceIterator behavior.
+type IteratorOption func(*ResourceIterator)
+
+// WithPrefetch makes Records()/Pages() fetch page N+1 in the background
+// while the caller processes page N.
+func WithPrefetch() IteratorOption {
+	return func(it *ResourceIterator) {
+		it.prefetch = true
+	}
+}
+
+// WithParallelPages makes Records()/Pages() fetch the remaining pages concurrently
+// (at most workers requests in flight) once the total count is known from the first envelope.
+// Pages are requested by number (?page=N) and still yielded in order.
+// Falls back to sequential iteration when the response carries no count or is not paginated.
+func WithParallelPages(workers int) IteratorOption {
+	return func(it *ResourceIterator) {
+		if workers > 1 {
+			it.parallel = workers
+		}
+	}
+}
+
+// WithStrictFilter makes the iterator check every query key against the GET query
+// parameters declared for the resource in the OpenAPI schema. Declared keys are sent
+// to the server; the rest (typically lookups the endpoint would silently ignore) are
+// evaluated client-side on each page with FilterRecords.
+// Count() still reports the server-side count, taken before client-side filtering.
+//
+//	it := core.NewResourceIterator(ctx, rest.Views, core.Params{"path__icontains": "/prod"}, 100,
+//	    core.WithStrictFilter())
+func WithStrictFilter() IteratorOption {
+	return func(it *ResourceIterator) {
+		if it.strict {
+			return
+		}
+		it.strict = true
+		server, client, err := SplitQueryParams(it.resource.GetResourcePath(), it.initialQuery)
+		if err != nil {
+			it.filterErr = fmt.Errorf("strict filter: %w", err)
+			return
+		}
+		if it.filter, err = compileRecordFilter(client); err != nil {
+			it.filterErr = fmt.Errorf("strict filter: %w", err)
+			return
+		}
+		it.initialQuery, it.clientFilter = server, client
+	}
+}
+
+// WithOptions applies options to an existing iterator and returns it.
+//
+//	it := resource.GetIterator(params, 100).(*core.ResourceIterator).WithOptions(core.WithPrefetch())
+func (it *ResourceIterator) WithOptions(opts ...IteratorOption) *ResourceIterator {
+	for _, opt := range opts {
+		opt(it)
+	}
+	return it
+}
+
+// ######################################################
+//              RANGE-OVER-FUNC ACCESSORS
+// ######################################################
+
+// Pages returns a range-over-func sequence over pages, starting from the first page
+// (or from the checkpointed position for iterators restored with NewResourceIteratorFromCursor).
+// Iteration stops after the first error, which is yielded with a nil RecordSet.
+//
+//	for page, err := range it.Pages() {
+//	    if err != nil { return err }
+//	    process(page)
+//	}
+func (it *ResourceIterator) Pages() iter.Seq2[RecordSet, error] {
+	return func(yield func(RecordSet, error) bool) {
+		it.checkpoint = nil
+		switch {
+		case it.parallel > 1 && !it.stable && !it.resume:
+			it.parallelPages(yield)
+		case it.prefetch:
+			it.prefetchPages(yield)
+		default:
+			sequentialPages(it.start, it.HasNext, it.next, yield)
+		}
+	}
+}
+
+// Records returns a range-over-func sequence over individual records, starting from the first page.
+//
+//	for record, err := range it.Records() {
+//	    if err != nil { return err }
+//	    fmt.Println(record.RecordName())
+//	}
+func (it *ResourceIterator) Records() iter.Seq2[Record, error] {
+	return flattenPages(it.Pages())
+}
+
+// Pages returns a range-over-func sequence over pages of any Iterator.
+// *ResourceIterator values use their configured prefetch/parallel mode.
+func Pages(it Iterator) iter.Seq2[RecordSet, error] {
+	if ri, ok := it.(*ResourceIterator); ok {
+		return ri.Pages()
+	}
+	return func(yield func(RecordSet, error) bool) {
+		sequentialPages(it.Reset, it.HasNext, it.Next, yield)
+	}
+}
+
+// Records returns a range-over-func sequence over records of any Iterator.
+func Records(it Iterator) iter.Seq2[Record, error] {
+	return flattenPages(Pages(it))
+}
+
+func flattenPages(pages iter.Seq2[RecordSet, error]) iter.Seq2[Record, error] {
+	return func(yield func(Record, error) bool) {
+		for page, err := range pages {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, record := range page {
+				if !yield(record, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func sequentialPages(
+	start func() (RecordSet, error),
+	hasNext func() bool,
+	next func() (RecordSet, error),
+	yield func(RecordSet, error) bool,
+) {
+	page, err := start()
+	for {
+		if err != nil {
+			yield(nil, err)
+			return
+		}
+		if len(page) > 0 && !yield(page, nil) {
+			return
+		}
+		if !hasNext() {
+			return
+		}
+		page, err = next()
+	}
+}
+
+// start begins iteration from the first page, or continues a restored cursor position.
+func (it *ResourceIterator) start() (RecordSet, error) {
+	if it.resume {
+		return it.next()
+	}
+	return it.reset()
+}
+
+type pageResult struct {
+	records RecordSet
+	err     error
+	cursor  Cursor
+}
+
+// prefetchPages drives the iterator from a background goroutine one page ahead of the consumer.
+func (it *ResourceIterator) prefetchPages(yield func(RecordSet, error) bool) {
+	pages := make(chan pageResult, 1)
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(pages)
+		sequentialPages(it.start, it.HasNext, it.next, func(page RecordSet, err error) bool {
+			result := pageResult{records: page, err: err}
+			if err == nil {
+				result.cursor = it.snapshotCursor()
+			}
+			select {
+			case pages <- result:
+				return err == nil
+			case <-done:
+				return false
+			}
+		})
+	}()
+	// Wait for the producer so that the iterator is not mutated after Pages returns.
+	defer wg.Wait()
+	defer close(done)
+
+	for result := range pages {
+		if result.err == nil {
+			// The live iterator state runs one page ahead; expose the consumer's position.
+			cursor := result.cursor
+			it.checkpoint = &cursor
+		}
+		if !yield(result.records, result.err) || result.err != nil {
+			return
+		}
+	}
+}
+
+// parallelPages fetches the first page, then requests pages 2..N concurrently by page number.
+func (it *ResourceIterator) parallelPages(yield func(RecordSet, error) bool) {
+	first, err := it.reset()
+	if err != nil {
+		yield(nil, err)
+		return
+	}
+	pageSize := it.pageSize
+	if pageSize <= 0 {
+		// The server's default page size: count the page before client-side filtering.
+		pageSize = len(it.fetched)
+	}
+	if !it.HasNext() || it.totalCount < 0 || pageSize <= 0 {
+		// Nothing more to fetch in parallel: fall back to sequential continuation.
+		if len(first) > 0 && !yield(first, nil) {
+			return
+		}
+		for it.HasNext() {
+			page, err := it.next()
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if len(page) > 0 && !yield(page, nil) {
+				return
+			}
+		}
+		return
+	}
+	if len(first) > 0 && !yield(first, nil) {
+		return
+	}
+
+	totalPages := (it.totalCount + pageSize - 1) / pageSize
+	if totalPages < 2 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(it.context())
+	results := make([]chan pageResult, totalPages+1)
+	for n := 2; n <= totalPages; n++ {
+		results[n] = make(chan pageResult, 1)
+	}
+	sem := make(chan struct{}, it.parallel)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for n := 2; n <= totalPages; n++ {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			wg.Add(1)
+			go func(n int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				records, err := it.fetchPageNumber(ctx, n)
+				results[n] <- pageResult{records: records, err: err}
+			}(n)
+		}
+	}()
+
+	for n := 2; n <= totalPages; n++ {
+		var result pageResult
+		select {
+		case result = <-results[n]:
+		case <-ctx.Done():
+			yield(nil, ctx.Err())
+			return
+		}
+		if result.err != nil {
+			yield(nil, fmt.Errorf("page %d: %w", n, result.err))
+			return
+		}
+		it.current = result.records
+		it.currentPage = n - 1
+		it.checkpoint = it.pageNumberCursor(n, totalPages)
+		if len(result.records) > 0 && !yield(result.records, nil) {
+			return
+		}
+	}
+	it.nextURL = nil
+}
+
+// pageNumberCursor builds the cursor for page n while later pages are being fetched concurrently.
+func (it *ResourceIterator) pageNumberCursor(n, totalPages int) *Cursor {
+	cursor := it.snapshotCursor()
+	cursor.Page = n
+	cursor.NextURL = ""
+	cursor.Done = n >= totalPages
+	if !cursor.Done {
+		params := make(Params, len(it.initialQuery)+1)
+		for k, v := range it.initialQuery {
+			params[k] = v
+		}
+		params[pageQueryParam] = n + 1
+		session := it.resource.Session()
+		url, err := buildUrl(session, it.resource.GetResourcePath(), params.ToQuery(), session.GetConfig().ApiVersion)
+		if err == nil {
+			cursor.NextURL = url
+		}
+	}
+	return &cursor
+}
+
+// fetchPageNumber fetches a single page by number without touching the iterator state.
+func (it *ResourceIterator) fetchPageNumber(ctx context.Context, page int) (RecordSet, error) {
+	params := make(Params, len(it.initialQuery)+1)
+	for k, v := range it.initialQuery {
+		params[k] = v
+	}
+	params[pageQueryParam] = page
+	pageIt := &ResourceIterator{resource: it.resource, ctx: ctx, totalCount: -1, filter: it.filter}
+	if err := pageIt.fetchPage("", params); err != nil {
+		return nil, err
+	}
+	return pageIt.current, nil
+}
+
+func (it *ResourceIterator) context() context.Context {
+	if it.ctx == nil {
+		return context.Background()
+	}
+	return it.ctx
+}
+
+// ######################################################
+//              TYPED ITERATOR
+// ######################################################
+
+// TypedIterator wraps an Iterator and decodes records into T.
+//
+//	it := core.NewTypedIterator[typed.QuotaDetailsModel](rest.Quotas.GetIterator(nil, 100))
+//	for quota, err := range it.Records() { ... }
+type TypedIterator[T any] struct {
+	Iterator
+}
+
+// NewTypedIterator wraps it so that records are decoded into *T.
+func NewTypedIterator[T any](it Iterator) *TypedIterator[T] {
+	return &TypedIterator[T]{Iterator: it}
+}
+
+// Pages returns a range-over-func sequence over decoded pages.
+func (t *TypedIterator[T]) Pages() iter.Seq2[[]*T, error] {
+	return func(yield func([]*T, error) bool) {
+		for page, err := range Pages(t.Iterator) {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			models := make([]*T, 0, len(page))
+			for _, record := range page {
+				var model T
+				if err := record.Fill(&model); err != nil {
+					yield(nil, err)
+					return
+				}
+				models = append(models, &model)
+			}
+			if !yield(models, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Records returns a range-over-func sequence over decoded records.
+func (t *TypedIterator[T]) Records() iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		for page, err := range t.Pages() {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, model := range page {
+				if !yield(model, nil) {
+					return
+				}
+			}
+		}
+	}
+}
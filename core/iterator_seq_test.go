@@ -0,0 +1,247 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// pagedTestServer serves `total` users split into pages using ?page=N&page_size=M,
+// mimicking the VMS pagination envelope.
+type pagedTestServer struct {
+	total    int
+	delay    time.Duration
+	failPage int
+
+	inFlight    int32
+	maxInFlight int32
+	mu          sync.Mutex
+	requested   []int
+}
+
+func (s *pagedTestServer) handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cur := atomic.AddInt32(&s.inFlight, 1)
+		defer atomic.AddInt32(&s.inFlight, -1)
+		for {
+			prev := atomic.LoadInt32(&s.maxInFlight)
+			if cur <= prev || atomic.CompareAndSwapInt32(&s.maxInFlight, prev, cur) {
+				break
+			}
+		}
+
+		q := r.URL.Query()
+		page, _ := strconv.Atoi(q.Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		size, _ := strconv.Atoi(q.Get("page_size"))
+		s.mu.Lock()
+		s.requested = append(s.requested, page)
+		s.mu.Unlock()
+		time.Sleep(s.delay)
+
+		if page == s.failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"detail":"boom"}`))
+			return
+		}
+
+		var results []map[string]any
+		for i := (page-1)*size + 1; i <= page*size && i <= s.total; i++ {
+			results = append(results, map[string]any{"id": i, "name": fmt.Sprintf("u%d", i)})
+		}
+		var next any
+		if page*size < s.total {
+			next = fmt.Sprintf("https://%s%s?page=%d&page_size=%d", r.Host, r.URL.Path, page+1, size)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"count":    s.total,
+			"next":     next,
+			"previous": nil,
+			"results":  results,
+		})
+	}
+}
+
+func collectIDs(t *testing.T, it *ResourceIterator) []int64 {
+	t.Helper()
+	var ids []int64
+	for record, err := range it.Records() {
+		if err != nil {
+			t.Fatalf("Records: %v", err)
+		}
+		ids = append(ids, record.RecordID())
+	}
+	return ids
+}
+
+func assertSequentialIDs(t *testing.T, ids []int64, total int) {
+	t.Helper()
+	if len(ids) != total {
+		t.Fatalf("expected %d records, got %d", total, len(ids))
+	}
+	for i, id := range ids {
+		if id != int64(i+1) {
+			t.Fatalf("records out of order at %d: %v", i, ids)
+		}
+	}
+}
+
+func TestResourceIterator_RecordsAndPages(t *testing.T) {
+	srv := &pagedTestServer{total: 7}
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+
+	it := NewResourceIterator(context.Background(), resource, nil, 3).(*ResourceIterator)
+	assertSequentialIDs(t, collectIDs(t, it), 7)
+
+	pages := 0
+	for page, err := range it.Pages() {
+		if err != nil {
+			t.Fatalf("Pages: %v", err)
+		}
+		pages++
+		if pages < 3 && len(page) != 3 {
+			t.Fatalf("unexpected page size %d", len(page))
+		}
+	}
+	if pages != 3 {
+		t.Fatalf("expected 3 pages, got %d", pages)
+	}
+
+	// Early break stops fetching.
+	srv.requested = nil
+	for range it.Records() {
+		break
+	}
+	if len(srv.requested) != 1 {
+		t.Fatalf("expected only the first page to be fetched, got %v", srv.requested)
+	}
+
+	// Package-level helpers work with the Iterator interface.
+	var count int
+	for _, err := range Records(resource.GetIterator(nil, 2)) {
+		if err != nil {
+			t.Fatalf("Records: %v", err)
+		}
+		count++
+	}
+	if count != 7 {
+		t.Fatalf("expected 7 records, got %d", count)
+	}
+}
+
+func TestResourceIterator_Prefetch(t *testing.T) {
+	srv := &pagedTestServer{total: 10, delay: 5 * time.Millisecond}
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+
+	it := NewResourceIterator(context.Background(), resource, nil, 2, WithPrefetch()).(*ResourceIterator)
+	assertSequentialIDs(t, collectIDs(t, it), 10)
+
+	// Early termination must not leak the producer or leave it running.
+	for range it.Pages() {
+		break
+	}
+	requestedAfterBreak := len(srv.requested)
+	time.Sleep(30 * time.Millisecond)
+	if len(srv.requested) != requestedAfterBreak {
+		t.Fatal("prefetch producer kept fetching after the consumer stopped")
+	}
+}
+
+func TestResourceIterator_ParallelPages(t *testing.T) {
+	srv := &pagedTestServer{total: 25, delay: 20 * time.Millisecond}
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+
+	it := NewResourceIterator(context.Background(), resource, nil, 5).(*ResourceIterator).
+		WithOptions(WithParallelPages(4))
+	assertSequentialIDs(t, collectIDs(t, it), 25)
+
+	if got := atomic.LoadInt32(&srv.maxInFlight); got < 2 || got > 4 {
+		t.Fatalf("expected between 2 and 4 concurrent requests, got %d", got)
+	}
+	if it.HasNext() {
+		t.Fatal("iterator should be exhausted after parallel iteration")
+	}
+}
+
+func TestResourceIterator_ParallelPagesError(t *testing.T) {
+	srv := &pagedTestServer{total: 20, failPage: 3}
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+
+	it := NewResourceIterator(context.Background(), resource, nil, 5, WithParallelPages(3)).(*ResourceIterator)
+	var ids []int64
+	var gotErr error
+	for record, err := range it.Records() {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		ids = append(ids, record.RecordID())
+	}
+	if gotErr == nil {
+		t.Fatal("expected error from failing page")
+	}
+	var apiErr *ApiError
+	if !errors.As(gotErr, &apiErr) {
+		t.Fatalf("expected wrapped ApiError, got %v", gotErr)
+	}
+	if len(ids) != 10 {
+		t.Fatalf("expected records of pages 1-2 before the error, got %d", len(ids))
+	}
+}
+
+func TestTypedIterator(t *testing.T) {
+	srv := &pagedTestServer{total: 5}
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+
+	type user struct {
+		Id   int64  `json:"id"`
+		Name string `json:"name"`
+	}
+	typedIt := NewTypedIterator[user](resource.GetIterator(nil, 2))
+
+	var names []string
+	for u, err := range typedIt.Records() {
+		if err != nil {
+			t.Fatalf("Records: %v", err)
+		}
+		names = append(names, u.Name)
+	}
+	if len(names) != 5 || names[0] != "u1" || names[4] != "u5" {
+		t.Fatalf("unexpected names: %v", names)
+	}
+
+	pages := 0
+	for page, err := range typedIt.Pages() {
+		if err != nil {
+			t.Fatalf("Pages: %v", err)
+		}
+		pages++
+		if page[0].Id == 0 {
+			t.Fatal("expected decoded models")
+		}
+	}
+	if pages != 3 {
+		t.Fatalf("expected 3 pages, got %d", pages)
+	}
+}
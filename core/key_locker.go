@@ -1,46 +1,208 @@
 package core
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
-type refLock struct {
-	mu  sync.Mutex
-	ref int32
+// ErrLockHeld is returned by TryLock when the lock is held by someone else.
+var ErrLockHeld = errors.New("lock is held")
+
+// LockBackend acquires named locks for KeyLocker.
+// The default backend locks within the process; FileLockBackend locks across processes on one host.
+type LockBackend interface {
+	// Acquire takes the lock for key and returns the function releasing it. When wait is false
+	// it fails with ErrLockHeld if the lock is taken, otherwise it waits until the lock is
+	// acquired or ctx is done.
+	Acquire(ctx context.Context, key string, holder LockHolder, wait bool) (release func(), err error)
+	// Holder returns the current holder of key, or nil when the lock is free.
+	Holder(key string) (*LockHolder, error)
+}
+
+// LockHolder describes who holds a lock, for debugging lock contention.
+type LockHolder struct {
+	Key        string    `json:"key"`
+	Owner      string    `json:"owner,omitempty"` // free-form owner, e.g. the program name
+	PID        int       `json:"pid"`
+	Host       string    `json:"host"`
+	AcquiredAt time.Time `json:"acquired_at"`
+	// Stale is set when the holder process is no longer running on this host
+	// or the lock is held longer than the backend StaleAfter.
+	Stale bool `json:"-"`
+}
+
+func (h LockHolder) String() string {
+	s := fmt.Sprintf("pid %d on %s since %s", h.PID, h.Host, h.AcquiredAt.Format(time.RFC3339))
+	if h.Owner != "" {
+		s = h.Owner + " " + s
+	}
+	if h.Stale {
+		s += " (stale)"
+	}
+	return s
+}
+
+func newLockHolder(key, owner string) LockHolder {
+	host, _ := os.Hostname()
+	return LockHolder{Key: key, Owner: owner, PID: os.Getpid(), Host: host, AcquiredAt: time.Now()}
 }
 
 type KeyLocker struct {
-	locks sync.Map
-	sep   string
+	backend LockBackend
+	prefix  string
+	sep     string
 }
 
-// NewKeyLocker creates a new KeyLocker.
+// NewKeyLocker creates a new KeyLocker locking within the process.
 func NewKeyLocker() *KeyLocker {
-	return &KeyLocker{sep: ":"}
+	return &KeyLocker{backend: newMemoryLockBackend(), sep: ":"}
 }
 
-// Lock returns a function that will unlock the key when called
-func (kl *KeyLocker) Lock(keys ...any) func() {
+// NewKeyLockerWithBackend creates a KeyLocker using backend. prefix namespaces the keys
+// (typically the resource type) when the backend is shared.
+func NewKeyLockerWithBackend(backend LockBackend, prefix string) *KeyLocker {
+	return &KeyLocker{backend: backend, prefix: prefix, sep: ":"}
+}
+
+func (kl *KeyLocker) key(keys []any) string {
 	var parts []string
+	if kl.prefix != "" {
+		parts = append(parts, kl.prefix)
+	}
 	for _, k := range keys {
 		parts = append(parts, fmt.Sprintf("%v", k))
 	}
-	combinedKey := strings.Join(parts, kl.sep)
+	return strings.Join(parts, kl.sep)
+}
+
+// Lock returns a function that will unlock the key when called.
+// It panics if the backend fails (e.g. the lock directory is not writable);
+// use LockWithContext to handle backend errors.
+func (kl *KeyLocker) Lock(keys ...any) func() {
+	unlock, err := kl.LockWithContext(context.Background(), keys...)
+	if err != nil {
+		panic(fmt.Sprintf("KeyLocker: %v", err))
+	}
+	return unlock
+}
 
-	lockIface, _ := kl.locks.LoadOrStore(combinedKey, &refLock{})
-	lock := lockIface.(*refLock)
+// LockWithContext waits for the lock until it is acquired or ctx is done
+// (use context.WithTimeout to bound the wait).
+func (kl *KeyLocker) LockWithContext(ctx context.Context, keys ...any) (func(), error) {
+	key := kl.key(keys)
+	unlock, err := kl.backend.Acquire(ctx, key, newLockHolder(key, ""), true)
+	if err != nil {
+		return nil, kl.describe(key, err)
+	}
+	return unlock, nil
+}
 
-	atomic.AddInt32(&lock.ref, 1)
-	lock.mu.Lock()
+// TryLock acquires the lock without waiting. It fails with ErrLockHeld,
+// describing the current holder, when the lock is taken.
+func (kl *KeyLocker) TryLock(keys ...any) (func(), error) {
+	key := kl.key(keys)
+	unlock, err := kl.backend.Acquire(context.Background(), key, newLockHolder(key, ""), false)
+	if err != nil {
+		return nil, kl.describe(key, err)
+	}
+	return unlock, nil
+}
 
-	// Return a closure that unlocks and cleans up
-	return func() {
-		lock.mu.Unlock()
-		if atomic.AddInt32(&lock.ref, -1) == 0 {
-			kl.locks.Delete(combinedKey)
+// Holder returns the current holder of the lock, or nil when it is free.
+func (kl *KeyLocker) Holder(keys ...any) (*LockHolder, error) {
+	return kl.backend.Holder(kl.key(keys))
+}
+
+// describe adds the lock holder to contention errors.
+func (kl *KeyLocker) describe(key string, err error) error {
+	if !errors.Is(err, ErrLockHeld) && !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	if holder, _ := kl.backend.Holder(key); holder != nil {
+		return fmt.Errorf("lock %q held by %s: %w", key, holder, err)
+	}
+	return fmt.Errorf("lock %q: %w", key, err)
+}
+
+// ######################################################
+//              IN-PROCESS BACKEND
+// ######################################################
+
+type refLock struct {
+	sem    chan struct{} // buffered(1): holding the lock is having sent to it
+	ref    int32
+	holder atomic.Pointer[LockHolder]
+}
+
+type memoryLockBackend struct {
+	mu    sync.Mutex
+	locks map[string]*refLock
+}
+
+func newMemoryLockBackend() *memoryLockBackend {
+	return &memoryLockBackend{locks: map[string]*refLock{}}
+}
+
+func (b *memoryLockBackend) ref(key string) *refLock {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	lock, ok := b.locks[key]
+	if !ok {
+		lock = &refLock{sem: make(chan struct{}, 1)}
+		b.locks[key] = lock
+	}
+	lock.ref++
+	return lock
+}
+
+func (b *memoryLockBackend) unref(key string, lock *refLock) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if lock.ref--; lock.ref == 0 {
+		delete(b.locks, key)
+	}
+}
+
+func (b *memoryLockBackend) Acquire(ctx context.Context, key string, holder LockHolder, wait bool) (func(), error) {
+	lock := b.ref(key)
+	if !wait {
+		select {
+		case lock.sem <- struct{}{}:
+		default:
+			b.unref(key, lock)
+			return nil, ErrLockHeld
+		}
+	} else {
+		select {
+		case lock.sem <- struct{}{}:
+		case <-ctx.Done():
+			b.unref(key, lock)
+			return nil, ctx.Err()
 		}
 	}
+	lock.holder.Store(&holder)
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			lock.holder.Store(nil)
+			<-lock.sem
+			b.unref(key, lock)
+		})
+	}, nil
+}
+
+func (b *memoryLockBackend) Holder(key string) (*LockHolder, error) {
+	b.mu.Lock()
+	lock, ok := b.locks[key]
+	b.mu.Unlock()
+	if !ok {
+		return nil, nil
+	}
+	return lock.holder.Load(), nil
 }
@@ -0,0 +1,180 @@
+package core
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+var errFlockUnsupported = errors.New("file locks are not supported on this platform")
+
+// FileLockBackend is a LockBackend locking across processes on one host with flock(2)
+// on files of a lock directory. Use it when several processes (cron jobs, daemons)
+// reconcile the same objects:
+//
+//	backend, err := core.NewFileLockBackend("/var/run/vast-locks")
+//	backend.StaleAfter = 10 * time.Minute
+//	config.LockBackend = backend
+//
+// Each lock is a file named after a hash of the lock key (resource type and keys) holding the
+// LockHolder of the current holder. Locks are released by the kernel when the holder exits.
+type FileLockBackend struct {
+	Dir string
+	// Owner is recorded in the holder metadata, e.g. the program name.
+	Owner string
+	// StaleAfter marks locks held longer than this as stale. Zero only marks locks
+	// whose holder process is no longer running on this host as stale.
+	StaleAfter time.Duration
+	// BreakStale removes stale lock files so waiters can take over the lock.
+	BreakStale bool
+	// PollInterval is the interval between acquire attempts while waiting (default 50ms).
+	PollInterval time.Duration
+}
+
+// NewFileLockBackend returns a FileLockBackend using dir, creating it if needed.
+// It fails on platforms without flock(2).
+func NewFileLockBackend(dir string) (*FileLockBackend, error) {
+	if !flockSupported {
+		return nil, errFlockUnsupported
+	}
+	if dir == "" {
+		return nil, errors.New("lock directory cannot be empty")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("cannot create lock directory: %w", err)
+	}
+	return &FileLockBackend{Dir: dir}, nil
+}
+
+// LockFile returns the path of the lock file of key.
+func (b *FileLockBackend) LockFile(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(b.Dir, hex.EncodeToString(sum[:16])+".lock")
+}
+
+func (b *FileLockBackend) Acquire(ctx context.Context, key string, holder LockHolder, wait bool) (func(), error) {
+	path := b.LockFile(key)
+	holder.Owner = b.Owner
+	pollInterval := b.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = 50 * time.Millisecond
+	}
+	for {
+		file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		locked, err := tryFlock(file)
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+		if locked {
+			// The file may have been removed as stale between open and flock: retry on the new file.
+			if !sameFile(file, path) {
+				_ = unflock(file)
+				file.Close()
+				continue
+			}
+			if err = writeLockHolder(file, holder); err != nil {
+				_ = unflock(file)
+				file.Close()
+				return nil, err
+			}
+			var once sync.Once
+			return func() {
+				once.Do(func() {
+					_ = file.Truncate(0)
+					_ = unflock(file)
+					file.Close()
+				})
+			}, nil
+		}
+
+		current := b.readHolder(path, key)
+		file.Close()
+		if b.BreakStale && current.Stale {
+			if err = os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+			continue
+		}
+		if !wait {
+			return nil, ErrLockHeld
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// Holder reads the holder metadata without taking the lock, so it never makes a concurrent
+// acquire fail. Holders truncate the file on release; metadata left behind by a holder that
+// exited without releasing is reported as Stale.
+func (b *FileLockBackend) Holder(key string) (*LockHolder, error) {
+	data, err := os.ReadFile(b.LockFile(key))
+	if os.IsNotExist(err) || (err == nil && len(data) == 0) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	holder := b.parseHolder(data, key)
+	return &holder, nil
+}
+
+// readHolder reads the holder metadata of a held lock file and evaluates staleness.
+func (b *FileLockBackend) readHolder(path, key string) LockHolder {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return LockHolder{Key: key}
+	}
+	return b.parseHolder(data, key)
+}
+
+func (b *FileLockBackend) parseHolder(data []byte, key string) LockHolder {
+	holder := LockHolder{Key: key}
+	if json.Unmarshal(data, &holder) != nil {
+		// Holder is writing its metadata.
+		return LockHolder{Key: key}
+	}
+	holder.Stale = holder.exitedOnThisHost() ||
+		(b.StaleAfter > 0 && !holder.AcquiredAt.IsZero() && time.Since(holder.AcquiredAt) > b.StaleAfter)
+	return holder
+}
+
+// exitedOnThisHost reports whether the holder process ran on this host and is gone.
+func (h LockHolder) exitedOnThisHost() bool {
+	host, _ := os.Hostname()
+	return h.Host == host && h.PID > 0 && !processAlive(h.PID)
+}
+
+func writeLockHolder(file *os.File, holder LockHolder) error {
+	data, err := json.Marshal(holder)
+	if err != nil {
+		return err
+	}
+	if err = file.Truncate(0); err != nil {
+		return err
+	}
+	_, err = file.WriteAt(data, 0)
+	return err
+}
+
+// sameFile reports whether file is still the file linked at path.
+func sameFile(file *os.File, path string) bool {
+	opened, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	linked, err := os.Stat(path)
+	return err == nil && os.SameFile(opened, linked)
+}
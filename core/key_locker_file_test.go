@@ -0,0 +1,229 @@
+//go:build unix
+
+package core
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+)
+
+func TestFileLockBackend_TryLockAndHolder(t *testing.T) {
+	backend, err := NewFileLockBackend(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend.Owner = "reconciler"
+	kl := NewKeyLockerWithBackend(backend, "Quota")
+
+	unlock, err := kl.TryLock("path", "/shared")
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	holder, err := kl.Holder("path", "/shared")
+	if err != nil || holder == nil {
+		t.Fatalf("Holder = %v, %v", holder, err)
+	}
+	if holder.PID != os.Getpid() || holder.Owner != "reconciler" || holder.Key != "Quota:path:/shared" || holder.Stale {
+		t.Errorf("holder = %+v", holder)
+	}
+
+	// flock locks are per open file: a second locker in the same process is excluded too.
+	if _, err = NewKeyLockerWithBackend(backend, "Quota").TryLock("path", "/shared"); !errors.Is(err, ErrLockHeld) {
+		t.Errorf("expected ErrLockHeld, got %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 80*time.Millisecond)
+	defer cancel()
+	if _, err = kl.LockWithContext(ctx, "path", "/shared"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+
+	unlock()
+	if holder, _ = kl.Holder("path", "/shared"); holder != nil {
+		t.Errorf("lock must be free, held by %v", holder)
+	}
+	relock, err := kl.TryLock("path", "/shared")
+	if err != nil {
+		t.Fatalf("TryLock after unlock: %v", err)
+	}
+	relock()
+}
+
+func TestFileLockBackend_Stale(t *testing.T) {
+	backend, err := NewFileLockBackend(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	kl := NewKeyLockerWithBackend(backend, "View")
+	unlock, err := kl.TryLock(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+
+	// Pretend the holder is a process that no longer exists.
+	holder, _ := kl.Holder(1)
+	holder.PID = 1 << 30
+	data, _ := json.Marshal(holder)
+	if err = os.WriteFile(backend.LockFile("View:1"), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if holder, _ = kl.Holder(1); holder == nil || !holder.Stale {
+		t.Fatalf("holder must be stale: %+v", holder)
+	}
+	if _, err = kl.TryLock(1); !errors.Is(err, ErrLockHeld) {
+		t.Errorf("stale locks are kept unless BreakStale is set, got %v", err)
+	}
+
+	backend.BreakStale = true
+	broken, err := kl.TryLock(1)
+	if err != nil {
+		t.Fatalf("stale lock must be broken: %v", err)
+	}
+	broken()
+}
+
+func TestFileLockBackend_HolderDoesNotTakeLock(t *testing.T) {
+	backend, err := NewFileLockBackend(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	kl := NewKeyLockerWithBackend(backend, "Quota")
+	unlock, err := kl.TryLock(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlock()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 2000; i++ {
+			_, _ = kl.Holder(1)
+		}
+	}()
+	for i := 0; i < 2000; i++ {
+		unlock, err := kl.TryLock(1)
+		if err != nil {
+			t.Fatalf("TryLock failed while probing the holder: %v", err)
+		}
+		unlock()
+	}
+	<-done
+}
+
+func TestVastResource_LockBackendFailure(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+	resource := newBulkTestResource(t, server, "quotas", "Quota")
+	dir := t.TempDir()
+	backend, _ := NewFileLockBackend(dir)
+	resource.Session().GetConfig().LockBackend = backend
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	// Lock must not silently drop cross-process exclusion.
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("expected Lock to panic with the backend error")
+			}
+		}()
+		resource.Lock("path", "/shared")()
+	}()
+	if _, err := resource.LockWithContext(context.Background(), "path", "/shared"); err == nil {
+		t.Fatal("expected LockWithContext to report the backend error")
+	}
+
+	result, err := resource.BulkCreate([]Params{{"name": "a"}}, &BulkOptions{
+		LockKey: func(int, any, Params) []any { return []any{"path", "/shared"} },
+	})
+	if err == nil || result[0].Err == nil || result[0].Record != nil {
+		t.Fatalf("expected the lock error on the bulk item, got %v %+v", err, result)
+	}
+}
+
+func TestFileLockBackend_CrossProcess(t *testing.T) {
+	dir := t.TempDir()
+	cmd := exec.Command(os.Args[0], "-test.run=^TestFileLockBackend_HelperProcess$")
+	cmd.Env = append(os.Environ(), "VAST_LOCK_HELPER_DIR="+dir)
+	stdin, _ := cmd.StdinPipe()
+	stdout, _ := cmd.StdoutPipe()
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer cmd.Wait()
+	if line, err := bufio.NewReader(stdout).ReadString('\n'); err != nil || line != "locked\n" {
+		stdin.Close()
+		t.Fatalf("helper process: %q, %v", line, err)
+	}
+
+	backend, _ := NewFileLockBackend(dir)
+	kl := NewKeyLockerWithBackend(backend, "Quota")
+	_, err := kl.TryLock(7)
+	if !errors.Is(err, ErrLockHeld) {
+		t.Fatalf("expected ErrLockHeld, got %v", err)
+	}
+	if holder, _ := kl.Holder(7); holder == nil || holder.PID != cmd.Process.Pid {
+		t.Errorf("holder must be the helper process %d: %+v", cmd.Process.Pid, holder)
+	}
+
+	// The lock is released when the helper exits.
+	stdin.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	unlock, err := kl.LockWithContext(ctx, 7)
+	if err != nil {
+		t.Fatalf("LockWithContext: %v", err)
+	}
+	unlock()
+}
+
+func TestVastResource_LockBackend(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+	resource := newBulkTestResource(t, server, "quotas", "Quota")
+	backend, _ := NewFileLockBackend(t.TempDir())
+	resource.Session().GetConfig().LockBackend = backend
+
+	unlock, err := resource.TryLock("path", "/shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+	if _, err = os.Stat(backend.LockFile("Quota:path:/shared")); err != nil {
+		t.Errorf("lock must be keyed by resource type and keys: %v", err)
+	}
+	if _, err = resource.TryLock("path", "/shared"); !errors.Is(err, ErrLockHeld) {
+		t.Errorf("expected ErrLockHeld, got %v", err)
+	}
+}
+
+// TestFileLockBackend_HelperProcess holds the "Quota:7" lock until stdin is closed.
+func TestFileLockBackend_HelperProcess(t *testing.T) {
+	dir := os.Getenv("VAST_LOCK_HELPER_DIR")
+	if dir == "" {
+		t.Skip("helper process for TestFileLockBackend_CrossProcess")
+	}
+	backend, _ := NewFileLockBackend(dir)
+	unlock, err := NewKeyLockerWithBackend(backend, "Quota").TryLock(7)
+	if err != nil {
+		os.Stdout.WriteString(err.Error() + "\n")
+		os.Exit(1)
+	}
+	_ = unlock // exit without unlocking: the kernel releases the lock
+	os.Stdout.WriteString("locked\n")
+	_, _ = bufio.NewReader(os.Stdin).ReadString('\n')
+	os.Exit(0)
+}
@@ -0,0 +1,21 @@
+//go:build !unix
+
+package core
+
+import (
+	"os"
+)
+
+const flockSupported = false
+
+func tryFlock(*os.File) (bool, error) {
+	return false, errFlockUnsupported
+}
+
+func unflock(*os.File) error {
+	return errFlockUnsupported
+}
+
+func processAlive(int) bool {
+	return true
+}
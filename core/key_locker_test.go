@@ -1,6 +1,10 @@
 package core
 
 import (
+	"context"
+	"errors"
+	"os"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -340,3 +344,83 @@ func TestKeyLocker_Lock_HighConcurrency(t *testing.T) {
 		t.Errorf("Total operations = %v, want %v", total, numGoroutines)
 	}
 }
+
+func TestKeyLocker_TryLock(t *testing.T) {
+	kl := NewKeyLocker()
+	unlock, err := kl.TryLock("quota", 1)
+	if err != nil {
+		t.Fatalf("TryLock: %v", err)
+	}
+	holder, _ := kl.Holder("quota", 1)
+	if holder == nil || holder.PID != os.Getpid() || holder.Key != "quota:1" {
+		t.Errorf("holder = %+v", holder)
+	}
+
+	if _, err = kl.TryLock("quota", 1); !errors.Is(err, ErrLockHeld) || !strings.Contains(err.Error(), "held by") {
+		t.Errorf("expected ErrLockHeld with holder, got %v", err)
+	}
+	other, err := kl.TryLock("quota", 2)
+	if err != nil {
+		t.Fatalf("other key must not be locked: %v", err)
+	}
+	other()
+
+	unlock()
+	unlock() // releasing twice is a no-op
+	if holder, _ = kl.Holder("quota", 1); holder != nil {
+		t.Errorf("lock must be free, held by %v", holder)
+	}
+	relock, err := kl.TryLock("quota", 1)
+	if err != nil {
+		t.Fatalf("TryLock after unlock: %v", err)
+	}
+	relock()
+}
+
+func TestKeyLocker_LockWithContext(t *testing.T) {
+	kl := NewKeyLocker()
+	unlock := kl.Lock("key")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := kl.LockWithContext(ctx, "key"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+
+	acquired := make(chan func())
+	go func() {
+		next, err := kl.LockWithContext(context.Background(), "key")
+		if err != nil {
+			t.Error(err)
+		}
+		acquired <- next
+	}()
+	unlock()
+	select {
+	case next := <-acquired:
+		next()
+	case <-time.After(time.Second):
+		t.Fatal("waiter must acquire the released lock")
+	}
+}
+
+func TestNewKeyLockerWithBackend_Prefix(t *testing.T) {
+	backend := newMemoryLockBackend()
+	views := NewKeyLockerWithBackend(backend, "View")
+	quotas := NewKeyLockerWithBackend(backend, "Quota")
+
+	unlock, err := views.TryLock(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+	if _, err = NewKeyLockerWithBackend(backend, "View").TryLock(1); !errors.Is(err, ErrLockHeld) {
+		t.Errorf("lockers sharing a backend must exclude each other, got %v", err)
+	}
+	other, err := quotas.TryLock(1)
+	if err != nil {
+		t.Errorf("prefixes must namespace keys: %v", err)
+	} else {
+		other()
+	}
+}
@@ -0,0 +1,131 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ######################################################
+//              QUERY LOOKUP VOCABULARY
+// ######################################################
+
+// Lookup is a Django-style filter suffix appended to a query field name
+// (e.g. "name__icontains=foo"). The same vocabulary is used by the typed
+// expressions in resources/typed/expr and by the untyped Q() builder.
+type Lookup string
+
+const (
+	LookupExact      Lookup = "exact"
+	LookupIExact     Lookup = "iexact"
+	LookupContains   Lookup = "contains"
+	LookupIContains  Lookup = "icontains"
+	LookupStartsWith Lookup = "startswith"
+	LookupEndsWith   Lookup = "endswith"
+	LookupRegex      Lookup = "regex"
+	LookupIRegex     Lookup = "iregex"
+	LookupIn         Lookup = "in"
+	LookupGT         Lookup = "gt"
+	LookupGTE        Lookup = "gte"
+	LookupLT         Lookup = "lt"
+	LookupLTE        Lookup = "lte"
+	LookupIsNull     Lookup = "isnull"
+)
+
+const (
+	lookupSep    = "__"
+	lookupNegate = "not_"
+)
+
+// knownLookups lists all positive lookups. Negated forms are "not_" + lookup.
+var knownLookups = map[Lookup]struct{}{
+	LookupExact:      empty,
+	LookupIExact:     empty,
+	LookupContains:   empty,
+	LookupIContains:  empty,
+	LookupStartsWith: empty,
+	LookupEndsWith:   empty,
+	LookupRegex:      empty,
+	LookupIRegex:     empty,
+	LookupIn:         empty,
+	LookupGT:         empty,
+	LookupGTE:        empty,
+	LookupLT:         empty,
+	LookupLTE:        empty,
+	LookupIsNull:     empty,
+}
+
+// Not returns the negated lookup (e.g. "icontains" -> "not_icontains").
+// Negating an already negated lookup returns the positive form.
+func (l Lookup) Not() Lookup {
+	if after, ok := strings.CutPrefix(string(l), lookupNegate); ok {
+		return Lookup(after)
+	}
+	return lookupNegate + l
+}
+
+// IsNegated reports whether the lookup has the "not_" prefix.
+func (l Lookup) IsNegated() bool {
+	return strings.HasPrefix(string(l), lookupNegate)
+}
+
+// Base returns the lookup without the "not_" prefix.
+func (l Lookup) Base() Lookup {
+	return Lookup(strings.TrimPrefix(string(l), lookupNegate))
+}
+
+// IsKnown reports whether the lookup (or its negation) belongs to the supported vocabulary.
+func (l Lookup) IsKnown() bool {
+	_, ok := knownLookups[l.Base()]
+	return ok
+}
+
+// LookupKey builds the query parameter key for a field and lookup.
+// Exact match (or an empty lookup) yields the bare field name:
+//
+//	LookupKey("name", LookupExact)           // "name"
+//	LookupKey("name", LookupIContains)       // "name__icontains"
+//	LookupKey("id", LookupIn.Not())          // "id__not_in"
+func LookupKey(field string, lookup Lookup) string {
+	if lookup == "" || lookup == LookupExact {
+		return field
+	}
+	return field + lookupSep + string(lookup)
+}
+
+// SplitLookupKey splits a query key into field name and lookup.
+// Keys without a known lookup suffix are returned with LookupExact.
+//
+//	SplitLookupKey("name__not_icontains") // ("name", "not_icontains")
+//	SplitLookupKey("tenant__name")        // ("tenant__name", "exact")
+func SplitLookupKey(key string) (string, Lookup) {
+	idx := strings.LastIndex(key, lookupSep)
+	if idx <= 0 {
+		return key, LookupExact
+	}
+	lookup := Lookup(key[idx+len(lookupSep):])
+	if !lookup.IsKnown() {
+		return key, LookupExact
+	}
+	return key[:idx], lookup
+}
+
+// LookupValue serializes a lookup value the way VMS expects it in a query string.
+// Slices and arrays are joined with commas (used by "in" lookups).
+func LookupValue(v any) string {
+	if v == nil {
+		return ""
+	}
+	rv := reflect.ValueOf(v)
+	if (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) && rv.Type().Elem().Kind() != reflect.Uint8 {
+		parts := make([]string, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			parts[i] = LookupValue(rv.Index(i).Interface())
+		}
+		return strings.Join(parts, ",")
+	}
+	if f, ok := v.(float64); ok && f == float64(int64(f)) {
+		return fmt.Sprintf("%d", int64(f))
+	}
+	return fmt.Sprintf("%v", v)
+}
@@ -0,0 +1,56 @@
+package core
+
+import "testing"
+
+func TestLookupKeyRoundTrip(t *testing.T) {
+	cases := []struct {
+		field  string
+		lookup Lookup
+		key    string
+	}{
+		{"name", LookupExact, "name"},
+		{"name", LookupIContains, "name__icontains"},
+		{"id", LookupIn.Not(), "id__not_in"},
+		{"policy__name", LookupStartsWith, "policy__name__startswith"},
+	}
+	for _, tc := range cases {
+		if got := LookupKey(tc.field, tc.lookup); got != tc.key {
+			t.Errorf("LookupKey(%q, %q) = %q, want %q", tc.field, tc.lookup, got, tc.key)
+		}
+		field, lookup := SplitLookupKey(tc.key)
+		if field != tc.field || lookup != tc.lookup {
+			t.Errorf("SplitLookupKey(%q) = (%q, %q)", tc.key, field, lookup)
+		}
+	}
+	if field, lookup := SplitLookupKey("tenant__name"); field != "tenant__name" || lookup != LookupExact {
+		t.Errorf("unexpected split of relation path: %q %q", field, lookup)
+	}
+}
+
+func TestLookupNegation(t *testing.T) {
+	if LookupGT.Not() != "not_gt" || LookupGT.Not().Not() != LookupGT {
+		t.Fatal("unexpected negation")
+	}
+	if !LookupGT.Not().IsNegated() || LookupGT.Not().Base() != LookupGT || !LookupGT.Not().IsKnown() {
+		t.Fatal("unexpected negated lookup properties")
+	}
+	if Lookup("fuzzy").IsKnown() {
+		t.Fatal("unknown lookup reported as known")
+	}
+}
+
+func TestLookupValue(t *testing.T) {
+	cases := map[string]any{
+		"1,2,3": []int{1, 2, 3},
+		"a,b":   []string{"a", "b"},
+		"5":     float64(5),
+		"1.5":   1.5,
+		"x":     "x",
+		"":      nil,
+	}
+	for want, v := range cases {
+		if got := LookupValue(v); got != want {
+			t.Errorf("LookupValue(%v) = %q, want %q", v, got, want)
+		}
+	}
+}
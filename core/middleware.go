@@ -0,0 +1,128 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// ######################################################
+//              MIDDLEWARE CHAIN
+// ######################################################
+
+// MiddlewareRequest is the request passed through the middleware chain.
+type MiddlewareRequest struct {
+	// Request carries the method, URL and headers (authentication included).
+	// Middlewares may change the URL and headers; the body is encoded from Body on each send.
+	Request *http.Request
+	// Body is the request body, nil for requests without body.
+	Body Params
+	// ResourceType is the resource the request is made for ("Dummy" for raw session calls).
+	ResourceType string
+}
+
+// Verb returns the HTTP method of the request.
+func (r *MiddlewareRequest) Verb() string {
+	return r.Request.Method
+}
+
+// URL returns the full request URL.
+func (r *MiddlewareRequest) URL() string {
+	return r.Request.URL.String()
+}
+
+// Handler sends a request through the rest of the chain and returns the decoded response.
+type Handler func(ctx context.Context, req *MiddlewareRequest) (Renderable, error)
+
+// Middleware wraps the round trip of API requests. It may change the request before calling next,
+// transform the response or the error, call next several times (retries) or not at all
+// (short-circuit with a synthetic response):
+//
+//	rest.Use(func(ctx context.Context, req *core.MiddlewareRequest, next core.Handler) (core.Renderable, error) {
+//	    start := time.Now()
+//	    response, err := next(ctx, req)
+//	    log.Printf("%s %s took %s", req.Verb(), req.URL(), time.Since(start))
+//	    return response, err
+//	})
+//
+// Middlewares run in registration order: client middlewares (VMSConfig.Middlewares, rest.Use),
+// then middlewares of the resource type (e.g. rest.Views.Use), then the built-in hooks
+// (logging, resource interceptors, BeforeRequestFn and AfterRequestFn) around the HTTP call.
+// Authentication retries happen outside the chain, so the chain runs again for a retried request.
+type Middleware func(ctx context.Context, req *MiddlewareRequest, next Handler) (Renderable, error)
+
+// Use registers middlewares for requests of this resource type.
+// Register middlewares before sending requests; Use is not safe for concurrent use with requests.
+func (e *VastResource) Use(middlewares ...Middleware) {
+	e.middlewares = append(e.middlewares, middlewares...)
+}
+
+func (e *VastResource) resourceMiddlewares() []Middleware {
+	return e.middlewares
+}
+
+// Middlewares returns a copy of the middlewares registered for this resource type.
+func (e *VastResource) Middlewares() []Middleware {
+	return slices.Clone(e.middlewares)
+}
+
+// Use registers middlewares for requests of this resource type (see VastResource.Use).
+func (e *TypedVastResource) Use(middlewares ...Middleware) {
+	e.getUntypedVastResource().(interface{ Use(...Middleware) }).Use(middlewares...)
+}
+
+// chain composes middlewares around handler; the first middleware is the outermost.
+func chain(handler Handler, middlewares ...Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		middleware, next := middlewares[i], handler
+		handler = func(ctx context.Context, req *MiddlewareRequest) (Renderable, error) {
+			return middleware(ctx, req, next)
+		}
+	}
+	return handler
+}
+
+// hooksMiddleware runs the built-in hooks: request logging, the resource interceptors
+// (BeforeRequest/AfterRequest) and the VMSConfig BeforeRequestFn/AfterRequestFn callbacks.
+func hooksMiddleware(resource InterceptableVastResourceAPI) Middleware {
+	return func(ctx context.Context, req *MiddlewareRequest, next Handler) (Renderable, error) {
+		var body io.Reader
+		if req.Body != nil {
+			data, _, err := encodeBody(req.Body, req.Request.Header.Get(HeaderContentType))
+			if err != nil {
+				return nil, err
+			}
+			body = bytes.NewReader(data)
+		}
+		if err := resource.doBeforeRequest(ctx, req.Request, req.Verb(), req.URL(), body); err != nil {
+			return nil, err
+		}
+		response, err := next(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return resource.doAfterRequest(ctx, response)
+	}
+}
+
+// encodeBody encodes body as JSON, or as multipart/form-data when contentType is multipart.
+// It returns the content type to send (multipart content types carry the boundary).
+func encodeBody(body Params, contentType string) ([]byte, string, error) {
+	if strings.Contains(strings.ToLower(contentType), ContentTypeMultipartForm) {
+		multipartData, err := body.ToMultipartFormData()
+		if err != nil {
+			return nil, "", err
+		}
+		data, err := io.ReadAll(multipartData.Body)
+		return data, multipartData.ContentType, err
+	}
+	reader, err := body.ToBody()
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := io.ReadAll(reader)
+	return data, contentType, err
+}
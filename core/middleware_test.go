@@ -0,0 +1,138 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMiddleware_Order(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": 1, "trace": r.Header.Get("X-Trace")})
+	}))
+	defer server.Close()
+
+	resource := newBulkTestResource(t, server, "views", "View")
+	config := resource.Session().GetConfig()
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(ctx context.Context, req *MiddlewareRequest, next Handler) (Renderable, error) {
+			calls = append(calls, name+">")
+			req.Request.Header.Add("X-Trace", name)
+			response, err := next(ctx, req)
+			calls = append(calls, "<"+name)
+			return response, err
+		}
+	}
+	config.Middlewares = []Middleware{trace("client1"), trace("client2")}
+	resource.Use(trace("view"))
+	config.BeforeRequestFn = func(context.Context, *http.Request, string, string, io.Reader) error {
+		calls = append(calls, "before-hook")
+		return nil
+	}
+	config.AfterRequestFn = func(_ context.Context, response Renderable) (Renderable, error) {
+		calls = append(calls, "after-hook")
+		return response, nil
+	}
+
+	record, err := Request[Record](context.Background(), resource, http.MethodGet, "/views/1/", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record["trace"] != "client1" {
+		t.Errorf("headers set by middlewares must be sent, got %v", record["trace"])
+	}
+	want := "client1> client2> view> before-hook after-hook <view <client2 <client1"
+	if got := strings.Join(calls, " "); got != want {
+		t.Errorf("calls:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Resource middlewares only apply to their resource type.
+	calls = nil
+	if _, err = Request[Record](context.Background(), newTestResource(resource.Session().(*VMSSession)), http.MethodGet, "/views/1/", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(calls, " "); strings.Contains(got, "view") {
+		t.Errorf("view middleware must not run for other resources: %s", got)
+	}
+}
+
+func TestMiddleware_ShortCircuit(t *testing.T) {
+	var hits int32
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+	}))
+	defer server.Close()
+
+	resource := newBulkTestResource(t, server, "views", "View")
+	resource.Use(func(ctx context.Context, req *MiddlewareRequest, next Handler) (Renderable, error) {
+		if req.Verb() == http.MethodGet && req.ResourceType == "View" {
+			return Record{"id": int64(1), "name": "cached"}, nil
+		}
+		return next(ctx, req)
+	})
+	record, err := Request[Record](context.Background(), resource, http.MethodGet, "/views/1/", nil, nil)
+	if err != nil || record["name"] != "cached" {
+		t.Errorf("synthetic response = %v, %v", record, err)
+	}
+	if hits != 0 {
+		t.Errorf("short-circuited requests must not be sent, got %d", hits)
+	}
+}
+
+func TestMiddleware_RetryAndTransformError(t *testing.T) {
+	var hits int32
+	var bodies []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if atomic.AddInt32(&hits, 1) == 1 || strings.Contains(r.URL.Path, "broken") {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": 2})
+	}))
+	defer server.Close()
+
+	resource := newBulkTestResource(t, server, "views", "View")
+	errMaintenance := errors.New("cluster in maintenance")
+	resource.Session().GetConfig().Middlewares = []Middleware{
+		func(ctx context.Context, req *MiddlewareRequest, next Handler) (Renderable, error) {
+			response, err := next(ctx, req)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %v", errMaintenance, err)
+			}
+			return response, nil
+		},
+		func(ctx context.Context, req *MiddlewareRequest, next Handler) (Renderable, error) {
+			response, err := next(ctx, req)
+			var apiErr *ApiError
+			if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusServiceUnavailable && !strings.Contains(req.URL(), "broken") {
+				return next(ctx, req)
+			}
+			return response, err
+		},
+	}
+
+	record, err := Request[Record](context.Background(), resource, http.MethodPost, "/views/", nil, Params{"name": "v"})
+	if err != nil || fmt.Sprint(record["id"]) != "2" {
+		t.Fatalf("retried request = %v, %v", record, err)
+	}
+	if len(bodies) != 2 || bodies[0] != `{"name":"v"}` || bodies[1] != bodies[0] {
+		t.Errorf("the body must be sent on each attempt, got %q", bodies)
+	}
+
+	_, err = Request[Record](context.Background(), resource, http.MethodGet, "/broken/", nil, nil)
+	if !errors.Is(err, errMaintenance) || !strings.Contains(err.Error(), "503") {
+		t.Errorf("expected transformed error, got %v", err)
+	}
+}
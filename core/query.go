@@ -0,0 +1,260 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vast-data/go-vast-client/openapi_schema"
+)
+
+// ######################################################
+//              QUERY BUILDER
+// ######################################################
+
+// Reserved query parameters that are not resource fields.
+const (
+	QueryParamOrdering = "ordering"
+	QueryParamPageSize = "page_size"
+	QueryParamFields   = "fields"
+)
+
+var reservedQueryParams = map[string]struct{}{
+	QueryParamOrdering: empty,
+	QueryParamPageSize: empty,
+	QueryParamFields:   empty,
+	pageQueryParam:     empty,
+}
+
+// Query is a fluent builder for search Params of untyped resources.
+// It uses the same lookup vocabulary and serialization as resources/typed/expr.
+//
+//	params := core.Q().
+//	    Field("name").IContains("prod").
+//	    Field("id").In(1, 2, 3).
+//	    OrderBy("-created").
+//	    PageSize(100).
+//	    Only("id", "name").
+//	    Params()
+//	// ?name__icontains=prod&id__in=1,2,3&ordering=-created&page_size=100&fields=id,name
+//	records, err := rest.Views.List(params)
+type Query struct {
+	params   Params
+	ordering []string
+	fields   []string
+	pageSize int
+}
+
+// Q creates an empty query builder.
+func Q() *Query {
+	return &Query{params: Params{}}
+}
+
+// Field starts a condition on the given field name.
+func (q *Query) Field(name string) *FieldQuery {
+	return &FieldQuery{query: q, name: name}
+}
+
+// Where merges raw params into the query (raw keys win on conflict).
+func (q *Query) Where(params Params) *Query {
+	for k, v := range params {
+		q.params[k] = v
+	}
+	return q
+}
+
+// OrderBy sets result ordering. Prefix a field with "-" for descending order.
+func (q *Query) OrderBy(fields ...string) *Query {
+	q.ordering = append(q.ordering, fields...)
+	return q
+}
+
+// PageSize sets the page_size query parameter.
+func (q *Query) PageSize(n int) *Query {
+	q.pageSize = n
+	return q
+}
+
+// Only restricts the returned fields (the "fields" query parameter).
+func (q *Query) Only(fields ...string) *Query {
+	q.fields = append(q.fields, fields...)
+	return q
+}
+
+// Params returns the query as Params ready to be passed to List/Get/GetIterator.
+func (q *Query) Params() Params {
+	out := make(Params, len(q.params)+3)
+	for k, v := range q.params {
+		out[k] = v
+	}
+	if len(q.ordering) > 0 {
+		out[QueryParamOrdering] = strings.Join(q.ordering, ",")
+	}
+	if q.pageSize > 0 {
+		out[QueryParamPageSize] = q.pageSize
+	}
+	if len(q.fields) > 0 {
+		out[QueryParamFields] = strings.Join(q.fields, ",")
+	}
+	return out
+}
+
+// String returns the encoded query string.
+func (q *Query) String() string {
+	params := q.Params()
+	return params.ToQuery()
+}
+
+// Validate checks field names against the searchable query parameters declared
+// in the OpenAPI schema for the GET operation of resourcePath (e.g. "/views/"),
+// and lookups against the supported vocabulary.
+// Returns a *QueryValidationError listing all offending keys.
+func (q *Query) Validate(resourcePath string) error {
+	searchable, err := openapi_schema.SearchableQueryParams(resourcePath)
+	if err != nil {
+		return err
+	}
+	return validateQueryKeys(resourcePath, q.Params(), searchable)
+}
+
+// ValidatedParams validates the query against resourcePath and returns the params.
+func (q *Query) ValidatedParams(resourcePath string) (Params, error) {
+	if err := q.Validate(resourcePath); err != nil {
+		return nil, err
+	}
+	return q.Params(), nil
+}
+
+func (q *Query) set(field string, lookup Lookup, value any) *Query {
+	q.params[LookupKey(field, lookup)] = value
+	return q
+}
+
+// QueryValidationError describes query keys that are not supported by a resource.
+type QueryValidationError struct {
+	ResourcePath string
+	// UnknownFields are field names not declared as searchable for the resource.
+	UnknownFields []string
+	// UnknownLookups are keys with an unsupported lookup suffix.
+	UnknownLookups []string
+	// Allowed lists searchable fields declared in the schema.
+	Allowed []string
+}
+
+func (e *QueryValidationError) Error() string {
+	var parts []string
+	if len(e.UnknownFields) > 0 {
+		parts = append(parts, fmt.Sprintf("unknown fields [%s]", strings.Join(e.UnknownFields, ", ")))
+	}
+	if len(e.UnknownLookups) > 0 {
+		parts = append(parts, fmt.Sprintf("unsupported lookups [%s]", strings.Join(e.UnknownLookups, ", ")))
+	}
+	return fmt.Sprintf(
+		"invalid query for %s: %s (searchable fields: %s)",
+		e.ResourcePath, strings.Join(parts, "; "), strings.Join(e.Allowed, ", "),
+	)
+}
+
+func validateQueryKeys(resourcePath string, params Params, searchable []string) error {
+	allowed := make(map[string]struct{}, len(searchable))
+	for _, name := range searchable {
+		allowed[name] = empty
+	}
+	verr := &QueryValidationError{ResourcePath: resourcePath}
+	for key := range params {
+		if _, ok := reservedQueryParams[key]; ok {
+			continue
+		}
+		if _, ok := allowed[key]; ok {
+			continue
+		}
+		field, lookup := SplitLookupKey(key)
+		if lookup == LookupExact && field == key {
+			// No known lookup suffix: either an unknown field or an unknown lookup on a known field.
+			if idx := strings.LastIndex(key, lookupSep); idx > 0 {
+				if _, ok := allowed[key[:idx]]; ok {
+					verr.UnknownLookups = append(verr.UnknownLookups, key)
+					continue
+				}
+			}
+			verr.UnknownFields = append(verr.UnknownFields, key)
+			continue
+		}
+		if _, ok := allowed[field]; !ok {
+			verr.UnknownFields = append(verr.UnknownFields, key)
+		}
+	}
+	if len(verr.UnknownFields) == 0 && len(verr.UnknownLookups) == 0 {
+		return nil
+	}
+	sort.Strings(verr.UnknownFields)
+	sort.Strings(verr.UnknownLookups)
+	verr.Allowed = append([]string(nil), searchable...)
+	sort.Strings(verr.Allowed)
+	return verr
+}
+
+// FieldQuery is a pending condition on a single field. Each lookup method
+// records the condition and returns the parent Query for chaining.
+type FieldQuery struct {
+	query *Query
+	name  string
+}
+
+// Lookup applies an arbitrary lookup (see Lookup constants).
+func (f *FieldQuery) Lookup(lookup Lookup, value any) *Query {
+	if lookup.Base() == LookupIn {
+		value = LookupValue(value)
+	}
+	return f.query.set(f.name, lookup, value)
+}
+
+// Range matches values between lo and hi inclusive (field__gte=lo&field__lte=hi).
+func (f *FieldQuery) Range(lo, hi any) *Query {
+	f.query.set(f.name, LookupGTE, lo)
+	return f.query.set(f.name, LookupLTE, hi)
+}
+
+// IsNull matches records where the field is null (field__isnull=true).
+func (f *FieldQuery) IsNull() *Query { return f.query.set(f.name, LookupIsNull, true) }
+
+// NotNull matches records where the field is set (field__isnull=false).
+func (f *FieldQuery) NotNull() *Query { return f.query.set(f.name, LookupIsNull, false) }
+
+func (f *FieldQuery) Eq(v any) *Query            { return f.query.set(f.name, LookupExact, v) }
+func (f *FieldQuery) Exact(v any) *Query         { return f.query.set(f.name, LookupExact, v) }
+func (f *FieldQuery) IExact(v string) *Query     { return f.query.set(f.name, LookupIExact, v) }
+func (f *FieldQuery) Contains(v string) *Query   { return f.query.set(f.name, LookupContains, v) }
+func (f *FieldQuery) IContains(v string) *Query  { return f.query.set(f.name, LookupIContains, v) }
+func (f *FieldQuery) StartsWith(v string) *Query { return f.query.set(f.name, LookupStartsWith, v) }
+func (f *FieldQuery) EndsWith(v string) *Query   { return f.query.set(f.name, LookupEndsWith, v) }
+func (f *FieldQuery) Regex(v string) *Query      { return f.query.set(f.name, LookupRegex, v) }
+func (f *FieldQuery) IRegex(v string) *Query     { return f.query.set(f.name, LookupIRegex, v) }
+func (f *FieldQuery) In(vs ...any) *Query        { return f.query.set(f.name, LookupIn, LookupValue(vs)) }
+func (f *FieldQuery) GT(v any) *Query            { return f.query.set(f.name, LookupGT, v) }
+func (f *FieldQuery) GTE(v any) *Query           { return f.query.set(f.name, LookupGTE, v) }
+func (f *FieldQuery) LT(v any) *Query            { return f.query.set(f.name, LookupLT, v) }
+func (f *FieldQuery) LTE(v any) *Query           { return f.query.set(f.name, LookupLTE, v) }
+func (f *FieldQuery) NotExact(v any) *Query      { return f.query.set(f.name, LookupExact.Not(), v) }
+func (f *FieldQuery) NotIExact(v string) *Query  { return f.query.set(f.name, LookupIExact.Not(), v) }
+func (f *FieldQuery) NotContains(v string) *Query {
+	return f.query.set(f.name, LookupContains.Not(), v)
+}
+func (f *FieldQuery) NotIContains(v string) *Query {
+	return f.query.set(f.name, LookupIContains.Not(), v)
+}
+func (f *FieldQuery) NotStartsWith(v string) *Query {
+	return f.query.set(f.name, LookupStartsWith.Not(), v)
+}
+func (f *FieldQuery) NotEndsWith(v string) *Query {
+	return f.query.set(f.name, LookupEndsWith.Not(), v)
+}
+func (f *FieldQuery) NotRegex(v string) *Query  { return f.query.set(f.name, LookupRegex.Not(), v) }
+func (f *FieldQuery) NotIRegex(v string) *Query { return f.query.set(f.name, LookupIRegex.Not(), v) }
+func (f *FieldQuery) NotIn(vs ...any) *Query {
+	return f.query.set(f.name, LookupIn.Not(), LookupValue(vs))
+}
+func (f *FieldQuery) NotGT(v any) *Query  { return f.query.set(f.name, LookupGT.Not(), v) }
+func (f *FieldQuery) NotGTE(v any) *Query { return f.query.set(f.name, LookupGTE.Not(), v) }
+func (f *FieldQuery) NotLT(v any) *Query  { return f.query.set(f.name, LookupLT.Not(), v) }
+func (f *FieldQuery) NotLTE(v any) *Query { return f.query.set(f.name, LookupLTE.Not(), v) }
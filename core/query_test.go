@@ -0,0 +1,79 @@
+package core
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestQuery_Params(t *testing.T) {
+	params := Q().
+		Field("name").IContains("prod").
+		Field("id").In(1, 2, 3).
+		Field("path").NotStartsWith("/tmp").
+		Field("tenant_id").Eq(5).
+		Field("capacity").Lookup(LookupIn.Not(), []int{7, 8}).
+		OrderBy("-created", "name").
+		PageSize(50).
+		Only("id", "name").
+		Where(Params{"raw": "x"}).
+		Params()
+
+	expected := Params{
+		"name__icontains":      "prod",
+		"id__in":               "1,2,3",
+		"path__not_startswith": "/tmp",
+		"tenant_id":            5,
+		"capacity__not_in":     "7,8",
+		"ordering":             "-created,name",
+		"page_size":            50,
+		"fields":               "id,name",
+		"raw":                  "x",
+	}
+	if len(params) != len(expected) {
+		t.Fatalf("unexpected params: %v", params)
+	}
+	for k, v := range expected {
+		if params[k] != v {
+			t.Errorf("%s: expected %v, got %v", k, v, params[k])
+		}
+	}
+}
+
+func TestQuery_String(t *testing.T) {
+	got := Q().Field("id").GTE(10).Field("id").LT(20).String()
+	for _, part := range []string{"id__gte=10", "id__lt=20"} {
+		if !strings.Contains(got, part) {
+			t.Errorf("expected %q in %q", part, got)
+		}
+	}
+}
+
+func TestQuery_Validate(t *testing.T) {
+	ok := Q().
+		Field("name").IContains("a").
+		Field("policy__name").Eq("p").
+		Field("tenant_name__icontains").Eq("t").
+		OrderBy("name").PageSize(10)
+	if err := ok.Validate("/views/"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err := Q().
+		Field("nope").Eq(1).
+		Field("name").Lookup("fuzzy", "x").
+		ValidatedParams("/views/")
+	var verr *QueryValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected QueryValidationError, got %v", err)
+	}
+	if len(verr.UnknownFields) != 1 || verr.UnknownFields[0] != "nope" {
+		t.Errorf("unexpected unknown fields: %v", verr.UnknownFields)
+	}
+	if len(verr.UnknownLookups) != 1 || verr.UnknownLookups[0] != "name__fuzzy" {
+		t.Errorf("unexpected unknown lookups: %v", verr.UnknownLookups)
+	}
+	if !strings.Contains(verr.Error(), "/views/") || len(verr.Allowed) == 0 {
+		t.Errorf("unexpected error message: %v", verr)
+	}
+}
@@ -0,0 +1,128 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+)
+
+// ######################################################
+//              RAW API ACCESS
+// ######################################################
+
+// RawOptions are the optional arguments of RawClient.Do.
+type RawOptions struct {
+	// PathParams fills the "{name}" placeholders of the path, e.g. {"id": 5} for "/views/{id}/legal_hold/".
+	PathParams Params
+	// Headers are added to the request.
+	Headers []http.Header
+	// ResourceType runs the interceptors of this resource (e.g. "View") instead of the generic ones.
+	ResourceType string
+	// WaitTimeout waits up to this duration for async task responses to complete.
+	// Zero returns the AsyncResult without waiting.
+	WaitTimeout time.Duration
+}
+
+// RawClient sends requests to arbitrary VMS endpoints, e.g. endpoints not covered by the
+// generated resources. Requests go through the same pipeline as resource methods:
+// URL building, authentication, retries, interceptors, logging and async task detection.
+type RawClient struct {
+	rest VastRest
+}
+
+// NewRawClient returns a RawClient for rest. Use rest.Raw() instead.
+func NewRawClient(rest VastRest) *RawClient {
+	return &RawClient{rest: rest}
+}
+
+// Do sends method to path ("/views/{id}/legal_hold/" or "views/5/legal_hold") relative to the
+// API version root. The response is a Record or a RecordSet; the *AsyncResult is non-nil
+// when the response is an async task (waited for when opts.WaitTimeout > 0).
+//
+//	response, _, err := rest.Raw().Do(ctx, http.MethodGet, "/monitors/{id}/query/",
+//	    core.Params{"time_frame": "5m"}, nil, &core.RawOptions{PathParams: core.Params{"id": 3}})
+func (c *RawClient) Do(ctx context.Context, method, path string, query, body Params, opts *RawOptions) (Renderable, *AsyncResult, error) {
+	if ctx == nil {
+		ctx = c.rest.GetCtx()
+	}
+	if opts == nil {
+		opts = &RawOptions{}
+	}
+	path, err := interpolatePath(trimApiPrefix(path), opts.PathParams)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resource VastResourceAPIWithContext
+	if opts.ResourceType != "" {
+		var ok bool
+		if resource, ok = c.rest.GetResourceMap()[opts.ResourceType]; !ok {
+			return nil, nil, fmt.Errorf("unknown resource type %q", opts.ResourceType)
+		}
+	} else {
+		resource = NewDummy(ctx, c.rest.GetSession())
+	}
+	response, _, err := requestRenderable(ctx, resource, method, path, query, body, opts.Headers)
+	if err != nil {
+		return nil, nil, err
+	}
+	asyncResult, err := maybeWaitAsyncResult(ctx, response, c.rest, opts.WaitTimeout)
+	return response, asyncResult, err
+}
+
+// RawDo is RawClient.Do decoding the response into T: a struct, a slice of structs
+// (paginated responses are unpacked), a map or a scalar for non-object responses.
+//
+//	type queryResult struct {
+//	    PropList []string `json:"prop_list"`
+//	    Data     [][]any  `json:"data"`
+//	}
+//	result, _, err := core.RawDo[queryResult](ctx, rest.Raw(), http.MethodGet, "/monitors/{id}/query/", nil, nil,
+//	    &core.RawOptions{PathParams: core.Params{"id": 3}})
+func RawDo[T any](ctx context.Context, raw *RawClient, method, path string, query, body Params, opts *RawOptions) (T, *AsyncResult, error) {
+	var result T
+	response, asyncResult, err := raw.Do(ctx, method, path, query, body, opts)
+	if err != nil {
+		return result, asyncResult, err
+	}
+	if typed, ok := response.(T); ok {
+		return typed, asyncResult, nil
+	}
+
+	var value any = response
+	if record, ok := response.(Record); ok {
+		if rawValue, isRaw := record[customRawKey]; isRaw {
+			value = rawValue
+		} else if results, paginated := record["results"].([]any); paginated && reflect.TypeOf(result) != nil &&
+			reflect.TypeOf(result).Kind() == reflect.Slice {
+			value = results
+		}
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return result, asyncResult, err
+	}
+	if err = json.Unmarshal(data, &result); err != nil {
+		return result, asyncResult, fmt.Errorf("cannot decode %s %s response into %T: %w", method, path, result, err)
+	}
+	return result, asyncResult, nil
+}
+
+// maybeWaitAsyncResult returns the AsyncResult of an async task response
+// and waits up to timeout for it when timeout > 0. It returns nil for other responses.
+func maybeWaitAsyncResult(ctx context.Context, response Renderable, rest VastRest, timeout time.Duration) (*AsyncResult, error) {
+	record, ok := response.(Record)
+	if !ok {
+		return nil, nil
+	}
+	asyncResult := MaybeAsyncResultFromRecord(ctx, record, rest)
+	if asyncResult != nil && timeout > 0 {
+		if _, err := asyncResult.Wait(timeout); err != nil {
+			return asyncResult, err
+		}
+	}
+	return asyncResult, nil
+}
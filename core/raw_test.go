@@ -0,0 +1,116 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRawClient_Do(t *testing.T) {
+	var requests []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.RequestURI()+" "+string(body))
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(r.URL.Path, "/refresh/"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"async_task": map[string]any{"id": 9}})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": 5, "name": "v5"})
+		}
+	}))
+	defer server.Close()
+
+	resource := newBulkTestResource(t, server, "views", "View")
+	var callers []string
+	resource.Session().GetConfig().BeforeRequestFn = func(ctx context.Context, _ *http.Request, _, _ string, _ io.Reader) error {
+		callers = append(callers, ctx.Value(caller).(VastResourceAPIWithContext).GetResourceType())
+		return nil
+	}
+	raw := NewRawClient(resource.Rest)
+	ctx := context.Background()
+
+	response, task, err := raw.Do(ctx, "patch", "/views/{id}/legal_hold/", Params{"dry": true}, Params{"enabled": true},
+		&RawOptions{PathParams: Params{"id": 5}, ResourceType: "View"})
+	if err != nil || task != nil {
+		t.Fatalf("Do: %v, %v", err, task)
+	}
+	if response.(Record)["name"] != "v5" {
+		t.Errorf("response = %v", response)
+	}
+	if want := "PATCH /api/latest/views/5/legal_hold/?dry=true {\"enabled\":true}"; requests[0] != want {
+		t.Errorf("request = %q, want %q", requests[0], want)
+	}
+
+	_, task, err = raw.Do(ctx, http.MethodPatch, "/api/latest/volumes/7/refresh/", nil, nil, nil)
+	if err != nil || task == nil || task.TaskId != 9 {
+		t.Errorf("expected async result for task 9, got %+v, %v", task, err)
+	}
+	if !strings.HasPrefix(requests[1], "PATCH /api/latest/volumes/7/refresh/ ") {
+		t.Errorf("api prefix must not be duplicated, got %q", requests[1])
+	}
+	if strings.Join(callers, ",") != "View,Dummy" {
+		t.Errorf("interceptor callers = %v", callers)
+	}
+
+	if _, _, err = raw.Do(ctx, http.MethodGet, "/views/{id}/", nil, nil, nil); err == nil {
+		t.Error("expected error for missing path param")
+	}
+	if _, _, err = raw.Do(ctx, http.MethodGet, "/views/", nil, nil, &RawOptions{ResourceType: "Nope"}); err == nil {
+		t.Error("expected error for unknown resource type")
+	}
+	if _, _, err = raw.Do(ctx, "TRACE", "/views/", nil, nil, nil); err == nil {
+		t.Error("expected error for unsupported method")
+	}
+	if len(requests) != 2 {
+		t.Errorf("failed calls must not send requests, got %v", requests)
+	}
+}
+
+func TestRawDo(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(r.URL.Path, "/names/"):
+			_ = json.NewEncoder(w).Encode([]any{"a", "b"})
+		case strings.Contains(r.URL.Path, "/paged/"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"count": 2, "results": []any{
+				map[string]any{"id": 1, "name": "x"}, map[string]any{"id": 2, "name": "y"},
+			}})
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": 5, "name": "v5"})
+		}
+	}))
+	defer server.Close()
+
+	raw := NewRawClient(newBulkTestResource(t, server, "views", "View").Rest)
+	ctx := context.Background()
+	type item struct {
+		Id   int64  `json:"id"`
+		Name string `json:"name"`
+	}
+
+	single, _, err := RawDo[item](ctx, raw, http.MethodGet, "/views/5/", nil, nil, nil)
+	if err != nil || single != (item{Id: 5, Name: "v5"}) {
+		t.Errorf("struct = %+v, %v", single, err)
+	}
+	list, _, err := RawDo[[]item](ctx, raw, http.MethodGet, "/paged/", nil, nil, nil)
+	if err != nil || len(list) != 2 || list[1].Name != "y" {
+		t.Errorf("paginated list = %+v, %v", list, err)
+	}
+	names, _, err := RawDo[[]string](ctx, raw, http.MethodGet, "/names/", nil, nil, nil)
+	if err != nil || strings.Join(names, ",") != "a,b" {
+		t.Errorf("raw list = %v, %v", names, err)
+	}
+	record, _, err := RawDo[Record](ctx, raw, http.MethodGet, "/views/5/", nil, nil, nil)
+	if err != nil || record["name"] != "v5" {
+		t.Errorf("record = %v, %v", record, err)
+	}
+	if _, _, err = RawDo[[]item](ctx, raw, http.MethodGet, "/views/5/", nil, nil, nil); err == nil || !strings.Contains(err.Error(), "cannot decode") {
+		t.Errorf("expected decode error, got %v", err)
+	}
+}
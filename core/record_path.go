@@ -0,0 +1,357 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrPathNotFound is returned by the Record getters when the path does not exist.
+var ErrPathNotFound = errors.New("path not found")
+
+// clockDurationPattern matches durations rendered as "[D ]HH:MM:SS[.ffffff]" (the Django/DRF format).
+var clockDurationPattern = regexp.MustCompile(`^(?:(-?\d+)\s+(?:days?,\s*)?)?(-?\d+):(\d{1,2}):(\d{1,2}(?:\.\d+)?)$`)
+
+// timeLayouts are the layouts accepted by GetTime for string values.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// Get returns the value at path. Paths use the dotted/bracket notation of DiffEntry.Path:
+// "default_user_quota.hard_limit" reads a nested field and "ip_ranges[0][1]" indexes lists.
+// The second result is false when any segment is missing or has the wrong type.
+//
+//	limit, ok := record.Get("default_user_quota.hard_limit")
+func (r Record) Get(path string) (any, bool) {
+	var current any = map[string]any(r)
+	for _, segment := range splitPath(path) {
+		index, isIndex, err := parseIndexSegment(segment)
+		if err != nil {
+			return nil, false
+		}
+		if isIndex {
+			list, ok := asList(current)
+			if !ok || index >= len(list) {
+				return nil, false
+			}
+			current = list[index]
+			continue
+		}
+		m, ok := asMap(current)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[segment]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// lookup returns the value at path or an error wrapping ErrPathNotFound.
+func (r Record) lookup(path string) (any, error) {
+	value, ok := r.Get(path)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrPathNotFound, path)
+	}
+	return value, nil
+}
+
+// GetString returns the value at path as a string. Numbers and booleans are formatted
+// the same way FlexibleUnmarshal converts them into string fields; null is "".
+func (r Record) GetString(path string) (string, error) {
+	value, err := r.lookup(path)
+	if err != nil {
+		return "", err
+	}
+	if _, isMap := asMap(value); isMap {
+		return "", fmt.Errorf("%s: cannot convert object to string", path)
+	}
+	if _, isList := asList(value); isList {
+		return "", fmt.Errorf("%s: cannot convert list to string", path)
+	}
+	return convertToString(value), nil
+}
+
+// GetInt64 returns the value at path as an int64. Floats are truncated and numeric
+// strings are parsed; null is 0.
+func (r Record) GetInt64(path string) (int64, error) {
+	value, err := r.lookup(path)
+	if err != nil {
+		return 0, err
+	}
+	switch v := value.(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+	case string:
+		s := strings.TrimSpace(v)
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i, nil
+		}
+	}
+	if f, ok := toFloat(value); ok {
+		return int64(f), nil
+	}
+	return 0, fmt.Errorf("%s: cannot convert %T to int64", path, value)
+}
+
+// GetFloat64 returns the value at path as a float64. Numeric strings are parsed; null is 0.
+func (r Record) GetFloat64(path string) (float64, error) {
+	value, err := r.lookup(path)
+	if err != nil {
+		return 0, err
+	}
+	if value == nil {
+		return 0, nil
+	}
+	if f, ok := toFloat(value); ok {
+		return f, nil
+	}
+	return 0, fmt.Errorf("%s: cannot convert %T to float64", path, value)
+}
+
+// GetBool returns the value at path as a bool using ToBool ("yes", 1, ... are true); null is false.
+func (r Record) GetBool(path string) (bool, error) {
+	value, err := r.lookup(path)
+	if err != nil {
+		return false, err
+	}
+	if value == nil {
+		return false, nil
+	}
+	b, err := ToBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", path, err)
+	}
+	return b, nil
+}
+
+// GetDuration returns the value at path as a time.Duration. Numbers are seconds; strings
+// may be Go durations ("1h30m") or "[D ]HH:MM:SS[.ffffff]" as rendered by the API; null is 0.
+func (r Record) GetDuration(path string) (time.Duration, error) {
+	value, err := r.lookup(path)
+	if err != nil {
+		return 0, err
+	}
+	if value == nil {
+		return 0, nil
+	}
+	if s, ok := value.(string); ok {
+		s = strings.TrimSpace(s)
+		if d, err := time.ParseDuration(s); err == nil {
+			return d, nil
+		}
+		if d, ok := parseClockDuration(s); ok {
+			return d, nil
+		}
+		if seconds, err := strconv.ParseFloat(s, 64); err == nil {
+			return time.Duration(seconds * float64(time.Second)), nil
+		}
+		return 0, fmt.Errorf("%s: cannot parse duration %q", path, s)
+	}
+	if seconds, ok := normalizeNumber(value); ok {
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+	return 0, fmt.Errorf("%s: cannot convert %T to duration", path, value)
+}
+
+// GetTime returns the value at path as a time.Time. Strings are parsed as RFC 3339
+// (with or without zone, "T" or space separated) or as a date; numbers are Unix seconds.
+// Null is the zero time.
+func (r Record) GetTime(path string) (time.Time, error) {
+	value, err := r.lookup(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	switch v := value.(type) {
+	case nil:
+		return time.Time{}, nil
+	case time.Time:
+		return v, nil
+	case string:
+		s := strings.TrimSpace(v)
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("%s: cannot parse time %q", path, s)
+	}
+	if seconds, ok := normalizeNumber(value); ok {
+		sec := int64(seconds)
+		return time.Unix(sec, int64((seconds-float64(sec))*float64(time.Second))).UTC(), nil
+	}
+	return time.Time{}, fmt.Errorf("%s: cannot convert %T to time", path, value)
+}
+
+// GetSlice returns the list at path; null is a nil slice.
+func (r Record) GetSlice(path string) ([]any, error) {
+	value, err := r.lookup(path)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	list, ok := asList(value)
+	if !ok {
+		return nil, fmt.Errorf("%s: %T is not a list", path, value)
+	}
+	return list, nil
+}
+
+// GetRecord returns the object at path as a Record sharing the underlying map; null is nil.
+func (r Record) GetRecord(path string) (Record, error) {
+	value, err := r.lookup(path)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	m, ok := asMap(value)
+	if !ok {
+		return nil, fmt.Errorf("%s: %T is not an object", path, value)
+	}
+	return m, nil
+}
+
+// Set stores value at path, creating intermediate objects and lists as needed.
+// Lists are padded with nil up to the index. Useful for building request bodies:
+//
+//	body := core.Record{}
+//	_ = body.Set("default_user_quota.hard_limit", 1<<30)
+//	_ = body.Set("ip_ranges[0]", []any{"10.0.0.1", "10.0.0.9"})
+func (r Record) Set(path string, value any) error {
+	segments := splitPath(path)
+	if len(segments) == 0 {
+		return fmt.Errorf("empty path")
+	}
+	if _, isIndex, _ := parseIndexSegment(segments[0]); isIndex {
+		return fmt.Errorf("path %q must start with a field name", path)
+	}
+	_, err := setPath(map[string]any(r), segments, value, path)
+	return err
+}
+
+func setPath(container any, segments []string, value any, path string) (any, error) {
+	if len(segments) == 0 {
+		return value, nil
+	}
+	segment := segments[0]
+	index, isIndex, err := parseIndexSegment(segment)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %q: %w", path, err)
+	}
+	if isIndex {
+		list, ok := asList(container)
+		if !ok && container != nil {
+			return nil, fmt.Errorf("path %q: cannot index %T", path, container)
+		}
+		for len(list) <= index {
+			list = append(list, nil)
+		}
+		child, err := setPath(list[index], segments[1:], value, path)
+		if err != nil {
+			return nil, err
+		}
+		list[index] = child
+		return list, nil
+	}
+	m, ok := asMap(container)
+	if !ok {
+		if container != nil {
+			return nil, fmt.Errorf("path %q: cannot set field %q on %T", path, segment, container)
+		}
+		m = map[string]any{}
+	}
+	child, err := setPath(m[segment], segments[1:], value, path)
+	if err != nil {
+		return nil, err
+	}
+	m[segment] = child
+	return m, nil
+}
+
+// Delete removes the field or list element at path and reports whether it existed.
+// Removing a list element shifts the following elements.
+func (r Record) Delete(path string) bool {
+	segments := splitPath(path)
+	if len(segments) == 0 {
+		return false
+	}
+	parentSegments, last := segments[:len(segments)-1], segments[len(segments)-1]
+	var parent any = map[string]any(r)
+	if len(parentSegments) > 0 {
+		var ok bool
+		if parent, ok = r.Get(joinPath(parentSegments)); !ok {
+			return false
+		}
+	}
+	index, isIndex, err := parseIndexSegment(last)
+	if err != nil {
+		return false
+	}
+	if !isIndex {
+		m, ok := asMap(parent)
+		if !ok {
+			return false
+		}
+		if _, ok := m[last]; !ok {
+			return false
+		}
+		delete(m, last)
+		return true
+	}
+	list, ok := asList(parent)
+	if !ok || index >= len(list) || len(parentSegments) == 0 {
+		return false
+	}
+	shrunk := make([]any, 0, len(list)-1)
+	shrunk = append(append(shrunk, list[:index]...), list[index+1:]...)
+	return r.Set(joinPath(parentSegments), shrunk) == nil
+}
+
+// parseIndexSegment parses a "[N]" path segment.
+func parseIndexSegment(segment string) (index int, isIndex bool, err error) {
+	if !strings.HasPrefix(segment, "[") {
+		return 0, false, nil
+	}
+	if !strings.HasSuffix(segment, "]") {
+		return 0, true, fmt.Errorf("unterminated index %q", segment)
+	}
+	index, err = strconv.Atoi(segment[1 : len(segment)-1])
+	if err != nil || index < 0 {
+		return 0, true, fmt.Errorf("invalid index %q", segment)
+	}
+	return index, true, nil
+}
+
+// parseClockDuration parses "[D ]HH:MM:SS[.ffffff]".
+func parseClockDuration(s string) (time.Duration, bool) {
+	match := clockDurationPattern.FindStringSubmatch(s)
+	if match == nil {
+		return 0, false
+	}
+	var days, hours, minutes int64
+	if match[1] != "" {
+		days, _ = strconv.ParseInt(match[1], 10, 64)
+	}
+	hours, _ = strconv.ParseInt(match[2], 10, 64)
+	minutes, _ = strconv.ParseInt(match[3], 10, 64)
+	seconds, _ := strconv.ParseFloat(match[4], 64)
+	return time.Duration(days)*24*time.Hour +
+		time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute +
+		time.Duration(seconds*float64(time.Second)), true
+}
@@ -0,0 +1,194 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testPathRecord(t *testing.T) Record {
+	t.Helper()
+	var record Record
+	err := json.Unmarshal([]byte(`{
+		"id": 7,
+		"name": "q1",
+		"hard_limit": "1024",
+		"ratio": 0.5,
+		"enabled": "yes",
+		"grace_period": "1 02:03:04.5",
+		"timeout": 90,
+		"created": "2025-03-01T10:20:30.123456Z",
+		"updated": "2025-03-01 10:20:30",
+		"default_user_quota": {"hard_limit": 2048, "soft_limit": null, "name": "u"},
+		"ip_ranges": [["10.0.0.1", "10.0.0.9"], ["10.0.1.1", "10.0.1.9"]],
+		"hosts": []
+	}`), &record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return record
+}
+
+func TestRecord_Get(t *testing.T) {
+	record := testPathRecord(t)
+	tests := []struct {
+		path string
+		want any
+		ok   bool
+	}{
+		{"name", "q1", true},
+		{"default_user_quota.hard_limit", float64(2048), true},
+		{"default_user_quota.soft_limit", nil, true},
+		{"ip_ranges[1][0]", "10.0.1.1", true},
+		{"ip_ranges[2]", nil, false},
+		{"ip_ranges[x]", nil, false},
+		{"name.first", nil, false},
+		{"hosts[0]", nil, false},
+		{"missing", nil, false},
+	}
+	for _, tt := range tests {
+		got, ok := record.Get(tt.path)
+		if ok != tt.ok || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Get(%q) = %v, %v; want %v, %v", tt.path, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestRecord_TypedGetters(t *testing.T) {
+	record := testPathRecord(t)
+
+	if s, err := record.GetString("id"); err != nil || s != "7" {
+		t.Errorf("GetString(id) = %q, %v", s, err)
+	}
+	if s, err := record.GetString("default_user_quota.soft_limit"); err != nil || s != "" {
+		t.Errorf("GetString(null) = %q, %v", s, err)
+	}
+	if _, err := record.GetString("default_user_quota"); err == nil {
+		t.Error("GetString on an object must fail")
+	}
+	if n, err := record.GetInt64("hard_limit"); err != nil || n != 1024 {
+		t.Errorf("GetInt64(hard_limit) = %d, %v", n, err)
+	}
+	if n, err := record.GetInt64("default_user_quota.hard_limit"); err != nil || n != 2048 {
+		t.Errorf("GetInt64(nested) = %d, %v", n, err)
+	}
+	if n, err := record.GetInt64("ratio"); err != nil || n != 0 {
+		t.Errorf("GetInt64(ratio) = %d, %v", n, err)
+	}
+	if _, err := record.GetInt64("name"); err == nil {
+		t.Error("GetInt64 on a non-numeric string must fail")
+	}
+	if f, err := record.GetFloat64("ratio"); err != nil || f != 0.5 {
+		t.Errorf("GetFloat64(ratio) = %v, %v", f, err)
+	}
+	if b, err := record.GetBool("enabled"); err != nil || !b {
+		t.Errorf("GetBool(enabled) = %v, %v", b, err)
+	}
+	if _, err := record.GetBool("name"); err == nil {
+		t.Error("GetBool on a non-boolean string must fail")
+	}
+	if d, err := record.GetDuration("grace_period"); err != nil || d != 26*time.Hour+3*time.Minute+4500*time.Millisecond {
+		t.Errorf("GetDuration(grace_period) = %v, %v", d, err)
+	}
+	if d, err := record.GetDuration("timeout"); err != nil || d != 90*time.Second {
+		t.Errorf("GetDuration(timeout) = %v, %v", d, err)
+	}
+	want := time.Date(2025, 3, 1, 10, 20, 30, 123456000, time.UTC)
+	if tm, err := record.GetTime("created"); err != nil || !tm.Equal(want) {
+		t.Errorf("GetTime(created) = %v, %v", tm, err)
+	}
+	if tm, err := record.GetTime("updated"); err != nil || !tm.Equal(want.Truncate(time.Second)) {
+		t.Errorf("GetTime(updated) = %v, %v", tm, err)
+	}
+	if list, err := record.GetSlice("ip_ranges[0]"); err != nil || len(list) != 2 {
+		t.Errorf("GetSlice(ip_ranges[0]) = %v, %v", list, err)
+	}
+	quota, err := record.GetRecord("default_user_quota")
+	if err != nil || quota.RecordName() != "u" {
+		t.Errorf("GetRecord(default_user_quota) = %v, %v", quota, err)
+	}
+	if _, err := record.GetRecord("name"); err == nil {
+		t.Error("GetRecord on a string must fail")
+	}
+	if _, err := record.GetInt64("missing.path"); !errors.Is(err, ErrPathNotFound) {
+		t.Errorf("expected ErrPathNotFound, got %v", err)
+	}
+}
+
+func TestParseClockDuration(t *testing.T) {
+	tests := map[string]time.Duration{
+		"00:30:00":         30 * time.Minute,
+		"2 days, 01:00:00": 49 * time.Hour,
+		"-1 23:00:00":      -time.Hour,
+	}
+	for s, want := range tests {
+		if got, ok := parseClockDuration(s); !ok || got != want {
+			t.Errorf("parseClockDuration(%q) = %v, %v; want %v", s, got, ok, want)
+		}
+	}
+	if _, ok := parseClockDuration("soon"); ok {
+		t.Error("expected parse failure")
+	}
+}
+
+func TestRecord_Set(t *testing.T) {
+	body := Record{}
+	steps := []struct {
+		path  string
+		value any
+	}{
+		{"name", "q1"},
+		{"default_user_quota.hard_limit", 100},
+		{"default_user_quota.soft_limit", 50},
+		{"ip_ranges[1][0]", "10.0.0.1"},
+		{"ip_ranges[0]", []any{"a", "b"}},
+	}
+	for _, step := range steps {
+		if err := body.Set(step.path, step.value); err != nil {
+			t.Fatalf("Set(%q): %v", step.path, err)
+		}
+	}
+	want := Record{
+		"name":               "q1",
+		"default_user_quota": map[string]any{"hard_limit": 100, "soft_limit": 50},
+		"ip_ranges":          []any{[]any{"a", "b"}, []any{"10.0.0.1"}},
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("body = %#v\nwant %#v", body, want)
+	}
+
+	for _, path := range []string{"", "[0]", "name.first", "ip_ranges.first", "ip_ranges[x]"} {
+		if err := body.Set(path, 1); err == nil {
+			t.Errorf("Set(%q) must fail", path)
+		}
+	}
+}
+
+func TestRecord_Delete(t *testing.T) {
+	record := testPathRecord(t)
+	if !record.Delete("default_user_quota.name") {
+		t.Error("Delete(default_user_quota.name) = false")
+	}
+	if _, ok := record.Get("default_user_quota.name"); ok {
+		t.Error("field still present")
+	}
+	if !record.Delete("ip_ranges[0]") {
+		t.Error("Delete(ip_ranges[0]) = false")
+	}
+	if first, _ := record.GetString("ip_ranges[0][0]"); first != "10.0.1.1" {
+		t.Errorf("following elements must shift, got %v", record["ip_ranges"])
+	}
+	if !record.Delete("ip_ranges[0][1]") {
+		t.Error("Delete(ip_ranges[0][1]) = false")
+	}
+	if list, _ := record.GetSlice("ip_ranges[0]"); len(list) != 1 {
+		t.Errorf("ip_ranges[0] = %v", list)
+	}
+	for _, path := range []string{"missing", "name.first", "ip_ranges[5]", "hosts[0]", ""} {
+		if record.Delete(path) {
+			t.Errorf("Delete(%q) = true", path)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ######################################################
+//              RELATIONSHIPS
+// ######################################################
+
+// RelatedKey is the reserved Record key under which resolved relations are attached.
+// Its value is a Record keyed by relation name, holding a Record for scalar
+// references (e.g. "tenant_id") and a RecordSet for list references (e.g. "cnode_ids").
+const RelatedKey = "@related"
+
+// relationBatchSize caps the number of ids sent in a single id__in request.
+const relationBatchSize = 100
+
+// Relation describes a foreign-key reference from one resource type to another.
+type Relation struct {
+	Name         string // e.g., "tenant"
+	Field        string // e.g., "tenant_id"
+	ResourceType string // e.g., "Tenant"
+}
+
+// RelationRegistry is a global registry of resource relationships
+// This is populated by code generation during build time
+var RelationRegistry = map[string]map[string]Relation{
+	// Key is resource type (e.g., "View")
+	// Value is map of relation name to relation
+}
+
+// RegisterRelation registers a relation of resourceType
+// This is called by generated init() functions
+func RegisterRelation(resourceType, name, field, targetType string) {
+	if RelationRegistry[resourceType] == nil {
+		RelationRegistry[resourceType] = make(map[string]Relation)
+	}
+	RelationRegistry[resourceType][name] = Relation{
+		Name:         name,
+		Field:        field,
+		ResourceType: targetType,
+	}
+}
+
+// GetRelation retrieves a relation by resource type and relation name.
+// When resourceType is empty, the relation is looked up across all resource types
+// and is only returned if every resource type declaring it agrees on field and target.
+func GetRelation(resourceType, name string) (Relation, bool) {
+	if resourceType != "" {
+		relation, found := RelationRegistry[resourceType][name]
+		return relation, found
+	}
+	var (
+		result Relation
+		found  bool
+	)
+	for _, relations := range RelationRegistry {
+		relation, ok := relations[name]
+		if !ok {
+			continue
+		}
+		if found && relation != result {
+			return Relation{}, false
+		}
+		result, found = relation, true
+	}
+	return result, found
+}
+
+// GetAllRelationsForResource returns all relations of a resource type sorted by name
+func GetAllRelationsForResource(resourceType string) []Relation {
+	relations, ok := RelationRegistry[resourceType]
+	if !ok {
+		return nil
+	}
+	result := make([]Relation, 0, len(relations))
+	for _, relation := range relations {
+		result = append(result, relation)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// Related returns the resolved record of a scalar relation, or nil if it was not resolved.
+func (r Record) Related(name string) Record {
+	related, ok := asMap(r[RelatedKey])
+	if !ok {
+		return nil
+	}
+	record, _ := asMap(related[name])
+	return record
+}
+
+// RelatedSet returns the resolved records of a list relation, or nil if it was not resolved.
+func (r Record) RelatedSet(name string) RecordSet {
+	related, ok := asMap(r[RelatedKey])
+	if !ok {
+		return nil
+	}
+	records, _ := related[name].(RecordSet)
+	return records
+}
+
+// ResolveRelations fetches the objects referenced by the named relations of records
+// and attaches them under RelatedKey. Referenced objects are fetched in batches with
+// an id__in filter and cached for the duration of the call, so each object is requested
+// at most once even if referenced by many records or several relations.
+//
+// resourceType is the type of records (e.g., "View"). If empty, each relation name must
+// be unambiguous across the registry (see GetRelation).
+// References to objects that no longer exist are left unresolved.
+func ResolveRelations(ctx context.Context, rest VastRest, resourceType string, records RecordSet, names ...string) error {
+	relations := make([]Relation, 0, len(names))
+	for _, name := range names {
+		relation, ok := GetRelation(resourceType, name)
+		if !ok {
+			if resourceType == "" {
+				return fmt.Errorf("unknown or ambiguous relation %q", name)
+			}
+			return fmt.Errorf("resource %q has no relation %q", resourceType, name)
+		}
+		relations = append(relations, relation)
+	}
+
+	// Collect ids per target resource type so that relations sharing a target are fetched together.
+	wanted := make(map[string]map[string]any)
+	for _, relation := range relations {
+		for _, record := range records {
+			for _, id := range relationIDs(record[relation.Field]) {
+				if wanted[relation.ResourceType] == nil {
+					wanted[relation.ResourceType] = make(map[string]any)
+				}
+				wanted[relation.ResourceType][relationKey(id)] = id
+			}
+		}
+	}
+
+	cache := make(map[string]map[string]Record, len(wanted))
+	for targetType, ids := range wanted {
+		resource, ok := rest.GetResourceMap()[targetType]
+		if !ok {
+			return fmt.Errorf("resource type %q is not registered", targetType)
+		}
+		fetched, err := fetchByIDs(ctx, resource, ids)
+		if err != nil {
+			return fmt.Errorf("failed to resolve %s: %w", targetType, err)
+		}
+		cache[targetType] = fetched
+	}
+
+	for _, record := range records {
+		related, ok := record[RelatedKey].(Record)
+		if !ok {
+			related = Record{}
+		}
+		for _, relation := range relations {
+			value := record[relation.Field]
+			if _, isList := asList(value); isList {
+				var set RecordSet
+				for _, id := range relationIDs(value) {
+					if target, ok := cache[relation.ResourceType][relationKey(id)]; ok {
+						set = append(set, target)
+					}
+				}
+				related[relation.Name] = set
+				continue
+			}
+			if target, ok := cache[relation.ResourceType][relationKey(value)]; ok {
+				related[relation.Name] = target
+			}
+		}
+		record[RelatedKey] = related
+	}
+	return nil
+}
+
+// fetchByIDs lists resource objects with the given ids, keyed by relationKey.
+func fetchByIDs(ctx context.Context, resource VastResourceAPIWithContext, ids map[string]any) (map[string]Record, error) {
+	keys := make([]string, 0, len(ids))
+	for key := range ids {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make(map[string]Record, len(keys))
+	for start := 0; start < len(keys); start += relationBatchSize {
+		end := min(start+relationBatchSize, len(keys))
+		records, err := resource.ListWithContext(ctx, Params{
+			LookupKey("id", LookupIn): strings.Join(keys[start:end], ","),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range records {
+			result[relationKey(record["id"])] = record
+		}
+	}
+	return result, nil
+}
+
+// relationIDs returns the non-null ids of a scalar or list reference.
+func relationIDs(value any) []any {
+	if value == nil {
+		return nil
+	}
+	list, ok := asList(value)
+	if !ok {
+		return []any{value}
+	}
+	ids := make([]any, 0, len(list))
+	for _, id := range list {
+		if id != nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// relationKey normalizes an id so that 7, int64(7), float64(7) and "7" share a cache entry.
+func relationKey(id any) string {
+	if f, ok := normalizeNumber(id); ok && f == float64(int64(f)) {
+		return strconv.FormatInt(int64(f), 10)
+	}
+	return fmt.Sprintf("%v", id)
+}
+
+// ResolveWithContext resolves the named relations of records returned by this resource.
+// See ResolveRelations.
+func (e *VastResource) ResolveWithContext(ctx context.Context, records RecordSet, names ...string) error {
+	return ResolveRelations(ctx, e.Rest, e.resourceType, records, names...)
+}
+
+// Resolve resolves the named relations of records using the bound REST context.
+func (e *VastResource) Resolve(records RecordSet, names ...string) error {
+	return e.ResolveWithContext(e.Rest.GetCtx(), records, names...)
+}
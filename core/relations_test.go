@@ -0,0 +1,159 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// relationTestServer serves tenants and cnodes filtered by id__in and counts requests per collection.
+type relationTestServer struct {
+	mu       sync.Mutex
+	requests map[string][]string
+}
+
+func (s *relationTestServer) handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		collection := "tenants"
+		if strings.Contains(r.URL.Path, "/cnodes") {
+			collection = "cnodes"
+		}
+		ids := r.URL.Query().Get("id__in")
+		s.mu.Lock()
+		s.requests[collection] = append(s.requests[collection], ids)
+		s.mu.Unlock()
+
+		var results []any
+		for _, id := range strings.Split(ids, ",") {
+			if id == "404" {
+				continue
+			}
+			results = append(results, map[string]any{"id": json.Number(id), "name": collection[:len(collection)-1] + "-" + id})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(results)
+	}
+}
+
+func withTestRelations(t *testing.T) {
+	t.Helper()
+	saved := RelationRegistry
+	RelationRegistry = make(map[string]map[string]Relation)
+	t.Cleanup(func() { RelationRegistry = saved })
+	RegisterRelation("View", "tenant", "tenant_id", "Tenant")
+	RegisterRelation("View", "policy", "policy_id", "ViewPolicy")
+	RegisterRelation("VipPool", "tenant", "tenant_id", "Tenant")
+	RegisterRelation("VipPool", "cnodes", "cnode_ids", "Cnode")
+	RegisterRelation("Quota", "policy", "policy_id", "QosPolicy")
+}
+
+func newRelationTestRest(t *testing.T, server *httptest.Server) *DummyRest {
+	t.Helper()
+	rest := &DummyRest{
+		ctx:         context.Background(),
+		Session:     newTestSession(t, server),
+		resourceMap: make(map[string]VastResourceAPIWithContext),
+	}
+	rest.resourceMap["Tenant"] = NewVastResource("tenants", "Tenant", rest, NewResourceOps(L), nil)
+	rest.resourceMap["Cnode"] = NewVastResource("cnodes", "Cnode", rest, NewResourceOps(L), nil)
+	rest.resourceMap["VipPool"] = NewVastResource("vippools", "VipPool", rest, NewResourceOps(L), nil)
+	return rest
+}
+
+func TestGetRelation(t *testing.T) {
+	withTestRelations(t)
+
+	if rel, ok := GetRelation("View", "policy"); !ok || rel.ResourceType != "ViewPolicy" {
+		t.Errorf("GetRelation(View, policy) = %+v, %v", rel, ok)
+	}
+	if _, ok := GetRelation("View", "cnodes"); ok {
+		t.Error("expected View to have no cnodes relation")
+	}
+	if rel, ok := GetRelation("", "tenant"); !ok || rel.Field != "tenant_id" || rel.ResourceType != "Tenant" {
+		t.Errorf("GetRelation(\"\", tenant) = %+v, %v", rel, ok)
+	}
+	if _, ok := GetRelation("", "policy"); ok {
+		t.Error("expected policy to be ambiguous across resource types")
+	}
+
+	relations := GetAllRelationsForResource("VipPool")
+	if len(relations) != 2 || relations[0].Name != "cnodes" || relations[1].Name != "tenant" {
+		t.Errorf("GetAllRelationsForResource(VipPool) = %+v", relations)
+	}
+}
+
+func TestResolveRelations(t *testing.T) {
+	withTestRelations(t)
+	srv := &relationTestServer{requests: map[string][]string{}}
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newRelationTestRest(t, server)
+
+	records := RecordSet{
+		{"id": 1, "tenant_id": float64(1), "cnode_ids": []any{float64(3), float64(2)}},
+		{"id": 2, "tenant_id": int64(2), "cnode_ids": []any{float64(2), float64(404)}},
+		{"id": 3, "tenant_id": "1", "cnode_ids": []any{}},
+		{"id": 4, "tenant_id": nil},
+	}
+	pools := rest.resourceMap["VipPool"].(*VastResource)
+	if err := pools.Resolve(records, "tenant", "cnodes"); err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+
+	if got := srv.requests["tenants"]; len(got) != 1 || got[0] != "1,2" {
+		t.Errorf("tenant requests = %v, want one batched id__in=1,2", got)
+	}
+	if got := srv.requests["cnodes"]; len(got) != 1 || got[0] != "2,3,404" {
+		t.Errorf("cnode requests = %v, want one batched id__in=2,3,404", got)
+	}
+
+	if name := records[0].Related("tenant").RecordName(); name != "tenant-1" {
+		t.Errorf("records[0] tenant = %q", name)
+	}
+	if name := records[2].Related("tenant").RecordName(); name != "tenant-1" {
+		t.Errorf("records[2] tenant = %q", name)
+	}
+	if records[3].Related("tenant") != nil {
+		t.Error("expected null reference to stay unresolved")
+	}
+
+	cnodes := records[0].RelatedSet("cnodes")
+	if len(cnodes) != 2 || cnodes[0].RecordName() != "cnode-3" || cnodes[1].RecordName() != "cnode-2" {
+		t.Errorf("records[0] cnodes = %v", cnodes)
+	}
+	if cnodes := records[1].RelatedSet("cnodes"); len(cnodes) != 1 {
+		t.Errorf("expected missing cnode to be skipped, got %v", cnodes)
+	}
+	if _, isRecord := records[0][RelatedKey].(Record); !isRecord {
+		t.Errorf("expected %s to hold a Record, got %T", RelatedKey, records[0][RelatedKey])
+	}
+}
+
+func TestResolveRelations_Errors(t *testing.T) {
+	withTestRelations(t)
+	srv := &relationTestServer{requests: map[string][]string{}}
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newRelationTestRest(t, server)
+	ctx := context.Background()
+
+	err := ResolveRelations(ctx, rest, "View", RecordSet{{"tenant_id": 1}}, "owner")
+	if err == nil || !strings.Contains(err.Error(), `no relation "owner"`) {
+		t.Errorf("expected unknown relation error, got %v", err)
+	}
+	err = ResolveRelations(ctx, rest, "", RecordSet{{"policy_id": 1}}, "policy")
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("expected ambiguous relation error, got %v", err)
+	}
+	err = ResolveRelations(ctx, rest, "View", RecordSet{{"policy_id": 1}}, "policy")
+	if err == nil || !strings.Contains(err.Error(), `"ViewPolicy" is not registered`) {
+		t.Errorf("expected unregistered resource error, got %v", err)
+	}
+	if len(srv.requests) != 0 {
+		t.Errorf("expected no requests, got %v", srv.requests)
+	}
+}
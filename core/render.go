@@ -0,0 +1,310 @@
+package core
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/bndr/gotabulate"
+	"gopkg.in/yaml.v3"
+)
+
+// Format is an output format supported by Render.
+type Format string
+
+const (
+	FormatTable    Format = "table"    // grid table, one row per record
+	FormatJSON     Format = "json"     // JSON object or array
+	FormatJSONL    Format = "jsonl"    // JSON Lines, one compact object per record
+	FormatYAML     Format = "yaml"     // YAML mapping or sequence
+	FormatCSV      Format = "csv"      // CSV with a header row
+	FormatMarkdown Format = "markdown" // GitHub-flavored Markdown table
+	FormatTemplate Format = "template" // text/template executed once per record
+)
+
+// RenderOptions controls Render. A nil *RenderOptions renders with defaults.
+type RenderOptions struct {
+	// Columns are the fields rendered by tabular formats (table, csv, markdown), in order.
+	// Nested fields use the Record.Get path syntax ("default_user_quota.hard_limit", "ip_ranges[0]").
+	// For json, jsonl and yaml, Columns projects each record to the selected fields;
+	// when empty, full records are rendered.
+	// When empty for tabular formats, the column set registered for the resource type is used
+	// (see RegisterColumnSet), falling back to the default printable attributes present in the records.
+	Columns []string
+	// ResourceType selects the registered column set. By default it is inferred from the
+	// record (@resourceType or the self url).
+	ResourceType string
+	// Template is the text/template source for FormatTemplate. The template is executed
+	// with the Record as data and can use the "get" (Record.Get by path) and "json" functions:
+	//
+	//	{{.name}}: {{get . "default_user_quota.hard_limit"}}{{"\n"}}
+	Template string
+	// Indent is the JSON indentation (default two spaces). Ignored by other formats.
+	Indent string
+	// NoHeader omits the header row of csv and markdown output.
+	NoHeader bool
+}
+
+var (
+	columnSetsMu sync.RWMutex
+	// columnSets maps resource types (e.g. "View") to their default tabular columns.
+	columnSets = map[string][]string{}
+)
+
+// RegisterColumnSet sets the default columns rendered for records of resourceType by the
+// tabular Render formats and PrettyTable. Columns may be nested paths.
+//
+//	core.RegisterColumnSet("Quota", "id", "name", "path", "hard_limit", "default_user_quota.hard_limit")
+func RegisterColumnSet(resourceType string, columns ...string) {
+	columnSetsMu.Lock()
+	defer columnSetsMu.Unlock()
+	if len(columns) == 0 {
+		delete(columnSets, resourceType)
+		return
+	}
+	columnSets[resourceType] = append([]string(nil), columns...)
+}
+
+// GetColumnSet returns the columns registered for resourceType, or nil.
+func GetColumnSet(resourceType string) []string {
+	columnSetsMu.RLock()
+	defer columnSetsMu.RUnlock()
+	return append([]string(nil), columnSets[resourceType]...)
+}
+
+// Render writes the Record in the given format.
+//
+//	err := record.Render(os.Stdout, core.FormatYAML, nil)
+func (r Record) Render(w io.Writer, format Format, opts *RenderOptions) error {
+	return renderRecords(w, RecordSet{r}, false, format, opts)
+}
+
+// Render writes the RecordSet in the given format.
+//
+//	err := views.Render(os.Stdout, core.FormatCSV, &core.RenderOptions{
+//	    Columns: []string{"id", "name", "path", "tenant_id"},
+//	})
+func (rs RecordSet) Render(w io.Writer, format Format, opts *RenderOptions) error {
+	return renderRecords(w, rs, true, format, opts)
+}
+
+func renderRecords(w io.Writer, records RecordSet, isSet bool, format Format, opts *RenderOptions) error {
+	if opts == nil {
+		opts = &RenderOptions{}
+	}
+	switch format {
+	case FormatJSON:
+		indent := opts.Indent
+		if indent == "" {
+			indent = "  "
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", indent)
+		if isSet {
+			return encoder.Encode(projectRecords(records, opts.Columns))
+		}
+		return encoder.Encode(projectRecord(records[0], opts.Columns))
+	case FormatJSONL:
+		encoder := json.NewEncoder(w)
+		for _, record := range records {
+			if err := encoder.Encode(projectRecord(record, opts.Columns)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case FormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		var value any = projectRecords(records, opts.Columns)
+		if !isSet {
+			value = projectRecord(records[0], opts.Columns)
+		}
+		if err := encoder.Encode(value); err != nil {
+			return err
+		}
+		return encoder.Close()
+	case FormatCSV:
+		columns := renderColumns(records, opts)
+		writer := csv.NewWriter(w)
+		if !opts.NoHeader {
+			if err := writer.Write(columns); err != nil {
+				return err
+			}
+		}
+		for _, record := range records {
+			if err := writer.Write(recordCells(record, columns)); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	case FormatMarkdown:
+		return renderMarkdown(w, records, renderColumns(records, opts), opts.NoHeader)
+	case FormatTable:
+		columns := renderColumns(records, opts)
+		rows := make([][]string, 0, len(records))
+		for _, record := range records {
+			rows = append(rows, recordCells(record, columns))
+		}
+		if len(rows) == 0 {
+			rows = append(rows, make([]string, len(columns)))
+		}
+		t := gotabulate.Create(rows)
+		t.SetHeaders(columns)
+		t.SetAlign("left")
+		t.SetWrapStrings(true)
+		t.SetMaxCellSize(85)
+		_, err := io.WriteString(w, t.Render("grid"))
+		return err
+	case FormatTemplate:
+		if opts.Template == "" {
+			return fmt.Errorf("format %q requires RenderOptions.Template", format)
+		}
+		tmpl, err := template.New("record").Funcs(renderFuncs).Parse(opts.Template)
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			if err := tmpl.Execute(w, record); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported render format %q", format)
+}
+
+var renderFuncs = template.FuncMap{
+	"get": func(r Record, path string) any {
+		value, _ := r.Get(path)
+		return value
+	},
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+// renderColumns returns the columns of tabular output: explicit columns, the column set
+// registered for the resource type, or the printable attributes present in the records
+// (all fields when none of them is present).
+func renderColumns(records RecordSet, opts *RenderOptions) []string {
+	if len(opts.Columns) > 0 {
+		return opts.Columns
+	}
+	resourceType := opts.ResourceType
+	if resourceType == "" && len(records) > 0 {
+		resourceType = recordResourceType(records[0])
+	}
+	if columns := GetColumnSet(resourceType); len(columns) > 0 {
+		return columns
+	}
+	seen := map[string]struct{}{}
+	for _, record := range records {
+		for _, key := range getPrintableAttrs(record) {
+			seen[key] = empty
+		}
+	}
+	if len(seen) == 0 {
+		for _, record := range records {
+			for key := range record {
+				if !strings.HasPrefix(key, "@") {
+					seen[key] = empty
+				}
+			}
+		}
+	}
+	columns := make([]string, 0, len(seen))
+	for key := range seen {
+		columns = append(columns, key)
+	}
+	sort.Strings(columns)
+	return columns
+}
+
+// recordResourceType returns the resource type stored under ResourceTypeKey, or the
+// display name inferred from the record url.
+func recordResourceType(r Record) string {
+	if resourceType, ok := r[ResourceTypeKey].(string); ok && resourceType != "" {
+		return resourceType
+	}
+	return recordDisplayName(r)
+}
+
+// recordCells formats the values of columns for tabular output.
+func recordCells(r Record, columns []string) []string {
+	cells := make([]string, len(columns))
+	for i, column := range columns {
+		value, ok := r.Get(column)
+		if !ok || value == nil {
+			continue
+		}
+		if _, isMap := asMap(value); isMap {
+			b, _ := json.Marshal(value)
+			cells[i] = string(b)
+		} else if _, isList := asList(value); isList {
+			b, _ := json.Marshal(value)
+			cells[i] = string(b)
+		} else {
+			cells[i] = convertToString(value)
+		}
+	}
+	return cells
+}
+
+func renderMarkdown(w io.Writer, records RecordSet, columns []string, noHeader bool) error {
+	var sb strings.Builder
+	writeRow := func(cells []string) {
+		sb.WriteString("|")
+		for _, cell := range cells {
+			cell = strings.ReplaceAll(cell, "|", `\|`)
+			cell = strings.ReplaceAll(cell, "\n", "<br>")
+			sb.WriteString(" " + cell + " |")
+		}
+		sb.WriteString("\n")
+	}
+	if !noHeader {
+		writeRow(columns)
+		separators := make([]string, len(columns))
+		for i := range separators {
+			separators[i] = "---"
+		}
+		writeRow(separators)
+	}
+	for _, record := range records {
+		writeRow(recordCells(record, columns))
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// projectRecord returns r restricted to columns (nested paths are kept nested),
+// or r itself when no columns are given.
+func projectRecord(r Record, columns []string) Record {
+	if len(columns) == 0 {
+		return r
+	}
+	out := Record{}
+	for _, column := range columns {
+		if value, ok := r.Get(column); ok {
+			_ = out.Set(column, value)
+		}
+	}
+	return out
+}
+
+func projectRecords(records RecordSet, columns []string) RecordSet {
+	if len(columns) == 0 {
+		return records
+	}
+	out := make(RecordSet, len(records))
+	for i, record := range records {
+		out[i] = projectRecord(record, columns)
+	}
+	return out
+}
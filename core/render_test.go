@@ -0,0 +1,159 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func testRenderRecords() RecordSet {
+	return RecordSet{
+		{
+			"id": int64(1), "name": "v1", "path": "/v1",
+			"url":                "https://10.0.0.1/api/v5/views/1/",
+			"default_user_quota": map[string]any{"hard_limit": int64(2048)},
+			"protocols":          []any{"NFS", "S3"},
+		},
+		{"id": int64(2), "name": "v|2", "path": "/v2\n", "url": "https://10.0.0.1/api/v5/views/2/"},
+	}
+}
+
+func renderString(t *testing.T, renderable Renderable, format Format, opts *RenderOptions) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := renderable.Render(&buf, format, opts); err != nil {
+		t.Fatalf("Render(%s): %v", format, err)
+	}
+	return buf.String()
+}
+
+func TestRender_CSV(t *testing.T) {
+	records := testRenderRecords()
+	opts := &RenderOptions{Columns: []string{"id", "name", "default_user_quota.hard_limit", "protocols"}}
+	want := "id,name,default_user_quota.hard_limit,protocols\n" +
+		"1,v1,2048,\"[\"\"NFS\"\",\"\"S3\"\"]\"\n" +
+		"2,v|2,,\n"
+	if got := renderString(t, records, FormatCSV, opts); got != want {
+		t.Errorf("csv:\n%s\nwant:\n%s", got, want)
+	}
+
+	opts.NoHeader = true
+	if got := renderString(t, records[0], FormatCSV, opts); strings.HasPrefix(got, "id,") {
+		t.Errorf("header must be omitted, got %q", got)
+	}
+}
+
+func TestRender_Markdown(t *testing.T) {
+	got := renderString(t, testRenderRecords(), FormatMarkdown, &RenderOptions{Columns: []string{"name", "path"}})
+	want := "| name | path |\n| --- | --- |\n| v1 | /v1 |\n| v\\|2 | /v2<br> |\n"
+	if got != want {
+		t.Errorf("markdown:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRender_JSON(t *testing.T) {
+	records := testRenderRecords()
+
+	lines := strings.Split(strings.TrimSpace(renderString(t, records, FormatJSONL, nil)), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("jsonl must have one line per record, got %v", lines)
+	}
+	var first map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil || first["name"] != "v1" {
+		t.Errorf("jsonl line = %s, %v", lines[0], err)
+	}
+
+	out := renderString(t, records[0], FormatJSON, &RenderOptions{Columns: []string{"name", "default_user_quota.hard_limit"}})
+	var projected map[string]any
+	if err := json.Unmarshal([]byte(out), &projected); err != nil {
+		t.Fatal(err)
+	}
+	if len(projected) != 2 || projected["default_user_quota"].(map[string]any)["hard_limit"] != float64(2048) {
+		t.Errorf("projected json = %s", out)
+	}
+	if !strings.Contains(out, "\n  \"") {
+		t.Errorf("json must be indented by default, got %s", out)
+	}
+
+	var list []map[string]any
+	if err := json.Unmarshal([]byte(renderString(t, records, FormatJSON, nil)), &list); err != nil || len(list) != 2 {
+		t.Errorf("json list = %v, %v", list, err)
+	}
+}
+
+func TestRender_YAML(t *testing.T) {
+	records := testRenderRecords()
+
+	var list []map[string]any
+	if err := yaml.Unmarshal([]byte(renderString(t, records, FormatYAML, nil)), &list); err != nil || len(list) != 2 {
+		t.Fatalf("yaml list = %v, %v", list, err)
+	}
+	var single map[string]any
+	out := renderString(t, records[0], FormatYAML, &RenderOptions{Columns: []string{"name"}})
+	if err := yaml.Unmarshal([]byte(out), &single); err != nil || len(single) != 1 || single["name"] != "v1" {
+		t.Errorf("yaml record = %q, %v", out, err)
+	}
+	if out := renderString(t, RecordSet{}, FormatYAML, nil); strings.TrimSpace(out) != "[]" {
+		t.Errorf("empty set = %q", out)
+	}
+}
+
+func TestRender_Template(t *testing.T) {
+	records := testRenderRecords()
+	opts := &RenderOptions{Template: `{{.name}}={{get . "default_user_quota.hard_limit"}} {{json .protocols}}{{"\n"}}`}
+	want := "v1=2048 [\"NFS\",\"S3\"]\nv|2=<no value> null\n"
+	if got := renderString(t, records, FormatTemplate, opts); got != want {
+		t.Errorf("template:\n%q\nwant:\n%q", got, want)
+	}
+
+	var buf bytes.Buffer
+	if err := records.Render(&buf, FormatTemplate, nil); err == nil {
+		t.Error("expected error without a template")
+	}
+	if err := records.Render(&buf, "xml", nil); err == nil || !strings.Contains(err.Error(), "unsupported") {
+		t.Errorf("expected unsupported format error, got %v", err)
+	}
+}
+
+func TestRender_Table(t *testing.T) {
+	out := renderString(t, testRenderRecords(), FormatTable, &RenderOptions{Columns: []string{"id", "name"}})
+	for _, want := range []string{"id", "name", "v1", "v|2"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("table must contain %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "/v1") {
+		t.Errorf("table must only contain the selected columns:\n%s", out)
+	}
+	if out := renderString(t, RecordSet{}, FormatTable, &RenderOptions{Columns: []string{"id"}}); !strings.Contains(out, "id") {
+		t.Errorf("empty table = %q", out)
+	}
+}
+
+func TestRegisterColumnSet(t *testing.T) {
+	RegisterColumnSet("View", "name", "default_user_quota.hard_limit")
+	defer RegisterColumnSet("View")
+
+	records := testRenderRecords()
+	want := "name,default_user_quota.hard_limit\nv1,2048\nv|2,\n"
+	if got := renderString(t, records, FormatCSV, nil); got != want {
+		t.Errorf("column set inferred from url:\n%s\nwant:\n%s", got, want)
+	}
+	got := renderString(t, RecordSet{{"name": "x", "path": "/x"}}, FormatCSV, &RenderOptions{ResourceType: "View"})
+	if got != "name,default_user_quota.hard_limit\nx,\n" {
+		t.Errorf("explicit resource type: %q", got)
+	}
+
+	table := records[0].PrettyTable()
+	if !strings.Contains(table, "default_user_quota.hard_limit") || !strings.Contains(table, "2048") {
+		t.Errorf("PrettyTable must use the column set:\n%s", table)
+	}
+
+	RegisterColumnSet("View")
+	if columns := GetColumnSet("View"); columns != nil {
+		t.Errorf("column set must be removed, got %v", columns)
+	}
+}
@@ -17,7 +17,8 @@ import (
 
 const (
 	ResourceTypeKey = "@resourceType"
-	customRawKey    = "@raw" // used to store raw string values in Record
+	ClusterKey      = "@cluster" // name of the cluster a record was fetched from (see rest.ClusterPool)
+	customRawKey    = "@raw"     // used to store raw string values in Record
 )
 
 var empty = struct{}{}
@@ -329,6 +330,8 @@ func getPrintableAttrs(r Record) []string {
 type Renderable interface {
 	PrettyTable() string
 	PrettyJson(indent ...string) string
+	// Render writes the value in the given format (see Format).
+	Render(w io.Writer, format Format, opts *RenderOptions) error
 }
 
 // Filler is a generic interface for filling a struct or slice of structs.
@@ -473,18 +476,30 @@ func (r Record) PrettyTable() string {
 	if len(r) == 0 {
 		return "<>"
 	}
-	// Iterate over printable attributes and add them to rows
-	for _, key := range getPrintableAttrs(r) {
-		if val, ok := r[key]; ok && val != nil {
+	// Iterate over printable attributes (or the column set of the resource type) and add them to rows
+	printable := printableAttrs
+	columns := GetColumnSet(recordResourceType(r))
+	if len(columns) > 0 {
+		printable = make(map[string]struct{}, len(columns))
+		for _, column := range columns {
+			if segments := splitPath(column); len(segments) == 1 {
+				printable[column] = empty
+			}
+		}
+	} else {
+		columns = getPrintableAttrs(r)
+	}
+	for _, key := range columns {
+		if val, ok := r.Get(key); ok && val != nil {
 			rows = append(rows, []any{key, fmt.Sprintf("%v", val)})
 		}
 	}
 
-	// Collect remaining attributes that are not in printableAttrs
+	// Collect remaining attributes that are not printed above
 	remainingAttrs := make(map[string]any)
 	for key, value := range r {
-		if _, ok := printableAttrs[key]; !ok {
-			if key == ResourceTypeKey || value == nil {
+		if _, ok := printable[key]; !ok {
+			if key == ResourceTypeKey || key == RelatedKey || value == nil {
 				continue
 			}
 			remainingAttrs[key] = value
@@ -94,6 +94,7 @@ type VMSSession struct {
 	config *VMSConfig
 	client *http.Client
 	auth   Authenticator
+	parent *VMSSession // session a tenant session was derived from
 }
 
 type VMSSessionMethod func(context.Context, string, Params, []http.Header) (Renderable, error)
@@ -121,6 +122,46 @@ func NewVMSSession(config *VMSConfig) (*VMSSession, error) {
 	return session, nil
 }
 
+// ForTenant returns a session acting on behalf of tenant. It shares the HTTP client
+// (transport, connection pool and MaxConnections limit) of s but authenticates with a
+// tenant-scoped authenticator: a JWT token acquired for the tenant, or the X-Tenant-Name
+// header for API token and basic auth. Authenticators are shared by all sessions with
+// the same identity (user or token, host and tenant).
+//
+// The configuration of the returned session is a copy of the configuration of s with
+// Tenant set and no middlewares of its own; changes made to either afterwards are not shared.
+// Client middlewares of the session it derives from are read at request time, so middlewares
+// added to it later apply to the tenant session too, before the tenant's own middlewares.
+func (s *VMSSession) ForTenant(tenant string) (*VMSSession, error) {
+	parent := s
+	if s.parent != nil {
+		parent = s.parent
+	}
+	config := *s.config
+	config.Tenant = tenant
+	config.Middlewares = nil
+	authenticator, err := createAuthenticator(&config)
+	if err != nil {
+		return nil, fmt.Errorf("tenant %q: %w", tenant, err)
+	}
+	return &VMSSession{
+		config: &config,
+		client: s.client,
+		auth:   authenticator,
+		parent: parent,
+	}, nil
+}
+
+// clientMiddlewares returns the client middlewares of the session: those of the parent
+// session, if any, followed by its own.
+func (s *VMSSession) clientMiddlewares() []Middleware {
+	var middlewares []Middleware
+	if s.parent != nil {
+		middlewares = append(middlewares, s.parent.config.Middlewares...)
+	}
+	return append(middlewares, s.config.Middlewares...)
+}
+
 func Request[T RecordUnion](
 	ctx context.Context,
 	r VastResourceAPIWithContext,
@@ -137,41 +178,7 @@ func RequestWithHeaders[T RecordUnion](
 	params, body Params,
 	headers []http.Header,
 ) (T, error) {
-	var (
-		vmsMethod VMSSessionMethod
-		query     string
-		err       error
-	)
-	if ctx == nil {
-		ctx = context.Background()
-	}
-	ctx = context.WithValue(ctx, caller, r)
-	verb = strings.ToUpper(verb)
-	session := r.Session()
-
-	switch verb {
-	case http.MethodGet:
-		vmsMethod = session.Get
-	case http.MethodPost:
-		vmsMethod = session.Post
-	case http.MethodPut:
-		vmsMethod = session.Put
-	case http.MethodPatch:
-		vmsMethod = session.Patch
-	case http.MethodDelete:
-		vmsMethod = session.Delete
-	default:
-		return nil, fmt.Errorf("unknown verb: %s", verb)
-	}
-	if params != nil {
-		query = params.ToQuery()
-	}
-	url, err := buildUrl(session, path, query, session.GetConfig().ApiVersion)
-	if err != nil {
-		return nil, err
-	}
-
-	response, err := vmsMethod(ctx, url, body, headers)
+	response, url, err := requestRenderable(ctx, r, verb, path, params, body, headers)
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +221,60 @@ func RequestWithHeaders[T RecordUnion](
 	return resultVal, nil
 }
 
+// requestRenderable sends the request on behalf of r and returns the response as received
+// (Record or RecordSet) together with the request URL.
+func requestRenderable(
+	ctx context.Context,
+	r VastResourceAPIWithContext,
+	verb, path string,
+	params, body Params,
+	headers []http.Header,
+) (Renderable, string, error) {
+	var (
+		vmsMethod VMSSessionMethod
+		query     string
+		err       error
+	)
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx = context.WithValue(ctx, caller, r)
+	verb = strings.ToUpper(verb)
+	session := r.Session()
+
+	switch verb {
+	case http.MethodGet:
+		vmsMethod = session.Get
+	case http.MethodPost:
+		vmsMethod = session.Post
+	case http.MethodPut:
+		vmsMethod = session.Put
+	case http.MethodPatch:
+		vmsMethod = session.Patch
+	case http.MethodDelete:
+		vmsMethod = session.Delete
+	default:
+		return nil, "", fmt.Errorf("unknown verb: %s", verb)
+	}
+	if session.GetConfig().ValidateRequests {
+		if err = validateRequest(verb, path, params, body); err != nil {
+			return nil, "", err
+		}
+	}
+	if params != nil {
+		query = params.ToQuery()
+	}
+	url, err := buildUrl(session, path, query, session.GetConfig().ApiVersion)
+	if err != nil {
+		return nil, "", err
+	}
+	response, err := vmsMethod(ctx, url, body, headers)
+	if err != nil {
+		return nil, "", err
+	}
+	return response, url, nil
+}
+
 func (s *VMSSession) Get(ctx context.Context, url string, _ Params, headers []http.Header) (Renderable, error) {
 	return doRequestWithRetries(ctx, s, http.MethodGet, url, nil, headers)
 }
@@ -311,11 +372,8 @@ func setupHeaders(s RESTSession, r *http.Request, headers http.Header) error {
 func doRequest(ctx context.Context, s *VMSSession, verb, url string, body Params, headers []http.Header) (Renderable, error) {
 	// callerExist if request is processed via "request" method
 	var (
-		config            = s.GetConfig()
-		resourceCaller    InterceptableVastResourceAPI
-		requestData       io.Reader
-		beforeRequestData io.Reader
-		err               error
+		resourceCaller InterceptableVastResourceAPI
+		err            error
 	)
 	originResource, resourceExist := ctx.Value(caller).(InterceptableVastResourceAPI)
 	if !resourceExist {
@@ -327,76 +385,60 @@ func doRequest(ctx context.Context, s *VMSSession, verb, url string, body Params
 	if url, err = pathToUrl(s, url); err != nil {
 		return nil, err
 	}
-
-	// Consolidate headers
-	finalHeaders := consolidateHeaders(s, headers)
-
-	// Determine if multipart/form-data is being used
-	contentType := finalHeaders.Get(HeaderContentType)
-	useMultipart := strings.Contains(strings.ToLower(contentType), ContentTypeMultipartForm)
-
-	if body == nil {
-		requestData = bytes.NewReader(nil)
-	} else {
-		if useMultipart {
-			// Use multipart form data
-			multipartData, err := body.ToMultipartFormData()
-			if err != nil {
-				return nil, fmt.Errorf("failed to create multipart form data: %w", err)
-			}
-			requestData = multipartData.Body
-
-			// Update the Content-Type header with the proper boundary
-			finalHeaders.Set(HeaderContentType, multipartData.ContentType)
-		} else {
-			// Use regular JSON body
-			if requestData, err = body.ToBody(); err != nil {
-				return nil, err
-			}
-		}
-	}
-	req, err := http.NewRequestWithContext(ctx, verb, url, requestData)
+	req, err := http.NewRequestWithContext(ctx, verb, url, nil)
 	if err != nil {
 		return nil, err
 	}
-	// Prepare beforeRequestData for interceptors
-	if body != nil {
-		if useMultipart {
-			// For multipart data, create a fresh copy for the interceptor
-			multipartData, err := body.ToMultipartFormData()
-			if err != nil {
-				return nil, fmt.Errorf("failed to create multipart form data for interceptor: %w", err)
-			}
-			beforeRequestData = multipartData.Body
-		} else {
-			if beforeRequestData, err = body.ToBody(); err != nil {
-				return nil, err
-			}
-		}
-	}
 	// Setup headers (both custom and defaults)
-	if err = setupHeaders(s, req, finalHeaders); err != nil {
+	if err = setupHeaders(s, req, consolidateHeaders(s, headers)); err != nil {
 		return nil, err
 	}
 
-	// before request interceptor
-	if err = resourceCaller.doBeforeRequest(ctx, req, verb, url, beforeRequestData); err != nil {
-		return nil, err
+	middlewares := s.clientMiddlewares()
+	if provider, ok := resourceCaller.(interface{ resourceMiddlewares() []Middleware }); ok {
+		middlewares = append(middlewares, provider.resourceMiddlewares()...)
+	}
+	middlewares = append(middlewares, hooksMiddleware(resourceCaller))
+	return chain(s.send, middlewares...)(ctx, &MiddlewareRequest{
+		Request:      req,
+		Body:         body,
+		ResourceType: resourceCaller.GetResourceType(),
+	})
+}
+
+// send is the innermost Handler of the middleware chain: it performs the HTTP call
+// and decodes the response.
+func (s *VMSSession) send(ctx context.Context, mr *MiddlewareRequest) (Renderable, error) {
+	config := s.GetConfig()
+	req := mr.Request.Clone(ctx)
+	if mr.Body != nil {
+		data, contentType, err := encodeBody(mr.Body, req.Header.Get(HeaderContentType))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request body: %w", err)
+		}
+		req.Header.Set(HeaderContentType, contentType)
+		req.Body = io.NopCloser(bytes.NewReader(data))
+		req.ContentLength = int64(len(data))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}
 	}
 	response, responseErr := s.client.Do(req)
 
 	if responseErr != nil {
-		return nil, fmt.Errorf("failed to perform %s request to %s, error %v", verb, url, responseErr)
+		return nil, fmt.Errorf("failed to perform %s request to %s, error %v", req.Method, req.URL, responseErr)
 	}
-	if err = validateResponse(response, config.Host, config.Port); err != nil {
+	if err := validateResponse(response, config.Host, config.Port); err != nil {
 		return nil, err
 	}
 	result, err := unmarshalToRecordUnion(response)
 	if err != nil {
 		return nil, err
 	}
-	// after request interceptor
-	return resourceCaller.doAfterRequest(ctx, result)
+	if config.SchemaDrift != nil {
+		config.SchemaDrift.Observe(mr.ResourceType, req.Method, req.URL.Path, result)
+	}
+	return result, nil
 }
 
 // doRequestWithRetries attempts to perform an HTTP request using doRequest,
@@ -3,11 +3,14 @@ package core
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/vast-data/go-vast-client/openapi_schema"
 )
 
 // newTestSession creates a VMSSession backed by the given TLS httptest.Server
@@ -217,3 +220,70 @@ func TestRequest_SingleObjectRequestedAsRecordSet(t *testing.T) {
 		t.Errorf("result mismatch:\ngot:  %+v\nwant: %+v", got, want)
 	}
 }
+
+// TestRequest_ValidateRequests verifies that with VMSConfig.ValidateRequests invalid
+// bodies are rejected before anything is sent, undeclared query keys are passed through,
+// and operations missing from the OpenAPI schema are sent unvalidated.
+func TestRequest_ValidateRequests(t *testing.T) {
+	var calls int
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"id": 1})
+	}))
+	defer server.Close()
+
+	session := newTestSession(t, server)
+	session.GetConfig().ValidateRequests = true
+	resource := newTestResource(session)
+	ctx := context.Background()
+
+	_, err := Request[Record](ctx, resource, http.MethodPatch, "views/5", Params{"nmae": "x"}, Params{"name": 1})
+	var verr *openapi_schema.ValidationError
+	if !errors.As(err, &verr) || len(verr.Issues) != 1 || verr.Issues[0].Field != "name" || verr.Path != "/views/{id}/" {
+		t.Fatalf("expected a body issue only, got %v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("invalid request must not be sent")
+	}
+
+	if _, err := Request[Record](ctx, resource, http.MethodPatch, "views/5", nil, Params{"name": "v2"}); err != nil {
+		t.Fatalf("valid request: %v", err)
+	}
+	if _, err := Request[Record](ctx, resource, http.MethodGet, "/test/", Params{"anything": 1}, nil); err != nil {
+		t.Fatalf("unknown operation must not be validated: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+}
+
+// TestRequest_ValidateRequestsLibraryQueries verifies that filters sent by the library
+// itself pass validation on endpoints that only declare pagination params.
+func TestRequest_ValidateRequestsLibraryQueries(t *testing.T) {
+	srv := &stableTestServer{ids: []int{1, 2, 3, 4, 5}}
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	resource := newCRUDTestResource(t, server, NewResourceOps(L))
+	resource.Rest.GetSession().GetConfig().ValidateRequests = true
+
+	it := NewResourceIterator(context.Background(), resource, Params{"name": "u"}, 2, WithStableOrdering()).(*ResourceIterator)
+	var ids []int64
+	for record, err := range it.Records() {
+		if err != nil {
+			t.Fatalf("stable iterator: %v", err)
+		}
+		ids = append(ids, record.RecordID())
+	}
+	if len(ids) != 5 {
+		t.Fatalf("expected 5 records, got %v", ids)
+	}
+
+	_, rest := newFakeVTasks(t, map[string][]string{"1": {"completed"}, "2": {"completed"}})
+	rest.GetSession().GetConfig().ValidateRequests = true
+	ctx := context.Background()
+	results := []*AsyncResult{NewAsyncResult(ctx, 1, rest), NewAsyncResult(ctx, 2, rest)}
+	if err := WaitAllWithOptions(ctx, &WaitOptions{Poll: fastPoll()}, results...); err != nil {
+		t.Fatalf("WaitAll: %v", err)
+	}
+}
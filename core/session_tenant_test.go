@@ -0,0 +1,104 @@
+package core
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func mustForTenant(t *testing.T, session *VMSSession, tenant string) *VMSSession {
+	t.Helper()
+	tenantSession, err := session.ForTenant(tenant)
+	if err != nil {
+		t.Fatalf("ForTenant(%q): %v", tenant, err)
+	}
+	return tenantSession
+}
+
+func TestVMSSession_ForTenant(t *testing.T) {
+	var tenants []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tenants = append(tenants, r.Header.Values(HeaderXTenantName)...)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	session := newTestSession(t, server)
+	tenantA := mustForTenant(t, session, "tenant-a")
+
+	if tenantA.client != session.client {
+		t.Fatal("expected the tenant session to share the HTTP client")
+	}
+	if tenantA.auth == session.auth {
+		t.Fatal("expected a separate authenticator for the tenant")
+	}
+	if again := mustForTenant(t, session, "tenant-a"); again.auth != tenantA.auth {
+		t.Fatal("expected the same tenant to reuse its authenticator")
+	}
+	if other := mustForTenant(t, session, "tenant-b"); other.auth == tenantA.auth {
+		t.Fatal("expected different tenants to use different authenticators")
+	}
+	if tenantA.GetConfig().Tenant != "tenant-a" || session.GetConfig().Tenant != "" {
+		t.Fatalf("unexpected tenants: %q, %q", tenantA.GetConfig().Tenant, session.GetConfig().Tenant)
+	}
+
+	if _, err := tenantA.Get(context.Background(), "/views/", nil, nil); err != nil {
+		t.Fatalf("tenant request: %v", err)
+	}
+	if _, err := session.Get(context.Background(), "/views/", nil, nil); err != nil {
+		t.Fatalf("base request: %v", err)
+	}
+	if len(tenants) != 1 || tenants[0] != "tenant-a" {
+		t.Fatalf("expected only the tenant request to carry the tenant header, got %v", tenants)
+	}
+}
+
+func TestVMSSession_ForTenantMiddlewares(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	var calls []string
+	record := func(name string) Middleware {
+		return func(ctx context.Context, req *MiddlewareRequest, next Handler) (Renderable, error) {
+			calls = append(calls, name)
+			return next(ctx, req)
+		}
+	}
+	session := newTestSession(t, server)
+	session.config.Middlewares = []Middleware{record("base")}
+	tenant := mustForTenant(t, session, "tenant-a")
+	tenant.config.Middlewares = append(tenant.config.Middlewares, record("tenant"))
+	session.config.Middlewares = append(session.config.Middlewares, record("late"))
+	nested := mustForTenant(t, tenant, "tenant-b")
+
+	if _, err := tenant.Get(context.Background(), "/views/", nil, nil); err != nil {
+		t.Fatalf("tenant request: %v", err)
+	}
+	if _, err := nested.Get(context.Background(), "/views/", nil, nil); err != nil {
+		t.Fatalf("nested tenant request: %v", err)
+	}
+	if _, err := session.Get(context.Background(), "/views/", nil, nil); err != nil {
+		t.Fatalf("base request: %v", err)
+	}
+	want := "base late tenant | base late | base late"
+	got := calls[0] + " " + calls[1] + " " + calls[2] + " | " + calls[3] + " " + calls[4] + " | " + calls[5] + " " + calls[6]
+	if len(calls) != 7 || got != want {
+		t.Fatalf("calls = %v, want %s", calls, want)
+	}
+}
+
+func TestVMSSession_ForTenantAuthError(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+
+	session := newTestSession(t, server)
+	session.config.ApiToken = ""
+	if _, err := session.ForTenant("tenant-a"); err == nil {
+		t.Fatal("expected an error when the tenant authenticator cannot be created")
+	}
+}
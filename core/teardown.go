@@ -0,0 +1,562 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ######################################################
+//              CASCADING TEARDOWN
+// ######################################################
+
+// DefaultTeardownMaxObjects is the safety limit on the number of objects a teardown
+// plan may contain when TeardownOptions.MaxObjects is not set.
+const DefaultTeardownMaxObjects = 200
+
+const (
+	defaultTeardownConflictRetries = 5
+	defaultTeardownConflictBackoff = 2 * time.Second
+)
+
+// ErrTeardownLimitExceeded is returned when a teardown plan would delete more objects
+// than TeardownOptions.MaxObjects allows.
+var ErrTeardownLimitExceeded = errors.New("teardown limit exceeded")
+
+// ErrTeardownSkipped marks plan nodes that were not deleted because an earlier level failed.
+var ErrTeardownSkipped = errors.New("teardown skipped")
+
+// TeardownPathFields maps resource types to the record field holding their filesystem path.
+// It is used to discover objects under a path (PlanPathTeardown) and under the path of a View.
+var TeardownPathFields = map[string]string{
+	"View":          "path",
+	"Quota":         "path",
+	"ProtectedPath": "source_dir",
+	"Snapshot":      "path",
+	"EncryptedPath": "path",
+}
+
+// TeardownOptions controls planning and execution of a cascading teardown.
+//
+// Example:
+//
+//	plan, err := core.PlanTeardown(ctx, rest, "Tenant", tenantID, &core.TeardownOptions{
+//	    MaxObjects: 50,
+//	    SkipTypes:  []string{"ActiveDirectory", "Ldap"},
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Println(plan) // dry run
+//	result, err := plan.Execute(ctx)
+type TeardownOptions struct {
+	// Concurrency is the maximum number of parallel deletions within a level (default: DefaultBulkConcurrency).
+	Concurrency int
+	// MaxObjects refuses plans with more objects, including the root (default: DefaultTeardownMaxObjects).
+	// Use a negative value to disable the limit.
+	MaxObjects int
+	// SkipTypes lists resource types that are neither traversed nor deleted.
+	SkipTypes []string
+	// WaitTimeout, when > 0, waits for async tasks returned by deletions (see MaybeAsyncResultFromRecord).
+	WaitTimeout time.Duration
+	// ConflictRetries is the number of retries when VMS answers 409 Conflict (default: 5).
+	// Use a negative value to disable retries.
+	ConflictRetries int
+	// ConflictBackoff is the delay between conflict retries (default: 2s).
+	ConflictBackoff time.Duration
+}
+
+func (o *TeardownOptions) normalize() *TeardownOptions {
+	out := TeardownOptions{}
+	if o != nil {
+		out = *o
+	}
+	if out.Concurrency <= 0 {
+		out.Concurrency = DefaultBulkConcurrency
+	}
+	if out.MaxObjects == 0 {
+		out.MaxObjects = DefaultTeardownMaxObjects
+	}
+	if out.ConflictRetries == 0 {
+		out.ConflictRetries = defaultTeardownConflictRetries
+	}
+	if out.ConflictBackoff <= 0 {
+		out.ConflictBackoff = defaultTeardownConflictBackoff
+	}
+	return &out
+}
+
+func (o *TeardownOptions) skips(resourceType string) bool {
+	for _, t := range o.SkipTypes {
+		if t == resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+// TeardownNode is a single object of a teardown plan.
+type TeardownNode struct {
+	ResourceType string
+	ID           any
+	Name         string
+	Record       Record
+	// Dependents must be deleted before this node.
+	Dependents []*TeardownNode
+	// Level is the deletion wave: all dependents of a node have a lower level.
+	Level int
+	// Via describes how the node was discovered (e.g. "tenant_id", "path").
+	Via string
+}
+
+func (n *TeardownNode) String() string {
+	if n.ResourceType == "" {
+		return fmt.Sprintf("path %v", n.ID)
+	}
+	if n.Name != "" {
+		return fmt.Sprintf("%s %v (%s)", n.ResourceType, n.ID, n.Name)
+	}
+	return fmt.Sprintf("%s %v", n.ResourceType, n.ID)
+}
+
+// TeardownPlan is a deletion DAG built by PlanTeardown or PlanPathTeardown.
+type TeardownPlan struct {
+	// Root is the object the plan was built for. For path plans it is a synthetic
+	// node with an empty ResourceType that is not deleted.
+	Root *TeardownNode
+	// Nodes holds every object to delete in deletion order (by level, then type and id).
+	Nodes []*TeardownNode
+
+	rest VastRest
+	opts *TeardownOptions
+}
+
+// Len returns the number of objects the plan deletes.
+func (p *TeardownPlan) Len() int {
+	return len(p.Nodes)
+}
+
+// Levels groups nodes by deletion wave. Nodes of the same level do not depend
+// on each other and are deleted concurrently.
+func (p *TeardownPlan) Levels() [][]*TeardownNode {
+	var levels [][]*TeardownNode
+	for _, node := range p.Nodes {
+		for len(levels) <= node.Level {
+			levels = append(levels, nil)
+		}
+		levels[node.Level] = append(levels[node.Level], node)
+	}
+	return levels
+}
+
+// String renders the plan level by level, suitable for dry-run output.
+func (p *TeardownPlan) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "teardown of %s: %d objects\n", p.Root, len(p.Nodes))
+	for i, level := range p.Levels() {
+		fmt.Fprintf(&sb, "level %d:\n", i)
+		for _, node := range level {
+			fmt.Fprintf(&sb, "  - %s", node)
+			if node.Via != "" {
+				fmt.Fprintf(&sb, " [via %s]", node.Via)
+			}
+			sb.WriteString("\n")
+		}
+	}
+	return sb.String()
+}
+
+// TeardownItemResult holds the outcome of deleting a single plan node.
+type TeardownItemResult struct {
+	Node   *TeardownNode
+	Record Record
+	Err    error
+}
+
+// TeardownResult contains one TeardownItemResult per plan node, in plan order.
+type TeardownResult []TeardownItemResult
+
+// Deleted returns the number of nodes deleted without error.
+func (r TeardownResult) Deleted() int {
+	n := 0
+	for _, item := range r {
+		if item.Err == nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Failed returns nodes that failed or were skipped.
+func (r TeardownResult) Failed() TeardownResult {
+	var out TeardownResult
+	for _, item := range r {
+		if item.Err != nil {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+// PlanTeardown discovers every object that depends on the resourceType object with the given id
+// and builds a deletion DAG rooted at it. Dependents are found through the relation registry
+// (see RegisterRelation): an object depends on the root if one of its relations references it.
+// For Views, objects located under the view path (see TeardownPathFields) are dependents too.
+// Discovery is recursive; resource types that cannot be listed or deleted are ignored.
+func PlanTeardown(ctx context.Context, rest VastRest, resourceType string, id any, opts *TeardownOptions) (*TeardownPlan, error) {
+	planner := newTeardownPlanner(ctx, rest, opts)
+	resource, ok := rest.GetResourceMap()[resourceType]
+	if !ok {
+		return nil, fmt.Errorf("resource type %q is not registered", resourceType)
+	}
+	record, err := resource.GetByIdWithContext(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	root, err := planner.add(resourceType, record, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := planner.expand(root); err != nil {
+		return nil, err
+	}
+	return planner.plan(root), nil
+}
+
+// PlanPathTeardown builds a deletion DAG for every object located at or under path
+// (see TeardownPathFields) and their dependents.
+func PlanPathTeardown(ctx context.Context, rest VastRest, path string, opts *TeardownOptions) (*TeardownPlan, error) {
+	planner := newTeardownPlanner(ctx, rest, opts)
+	root := &TeardownNode{ID: path, Name: path}
+	if err := planner.expandPath(root, path, ""); err != nil {
+		return nil, err
+	}
+	return planner.plan(root), nil
+}
+
+// PlanTeardown builds a teardown plan rooted at the object with the given id of this resource.
+// See PlanTeardown.
+func (e *VastResource) PlanTeardown(ctx context.Context, id any, opts *TeardownOptions) (*TeardownPlan, error) {
+	return PlanTeardown(ctx, e.Rest, e.resourceType, id, opts)
+}
+
+// Execute deletes the plan nodes level by level, with bounded concurrency inside a level.
+// Deletions answered with 404 are treated as done; 409 Conflict is retried. If any node of a
+// level fails, later levels are not attempted and their nodes are reported with ErrTeardownSkipped.
+func (p *TeardownPlan) Execute(ctx context.Context) (TeardownResult, error) {
+	opts := p.opts
+	if opts.MaxObjects >= 0 && len(p.Nodes) > opts.MaxObjects {
+		return nil, fmt.Errorf("%w: plan has %d objects, limit is %d", ErrTeardownLimitExceeded, len(p.Nodes), opts.MaxObjects)
+	}
+	index := make(map[*TeardownNode]int, len(p.Nodes))
+	for i, node := range p.Nodes {
+		index[node] = i
+	}
+	results := make(TeardownResult, len(p.Nodes))
+	for i, node := range p.Nodes {
+		results[i] = TeardownItemResult{Node: node}
+	}
+
+	var errs []error
+	for _, level := range p.Levels() {
+		if len(errs) > 0 || ctx.Err() != nil {
+			for _, node := range level {
+				results[index[node]].Err = ErrTeardownSkipped
+			}
+			continue
+		}
+		sem := make(chan struct{}, opts.Concurrency)
+		var wg sync.WaitGroup
+		for _, node := range level {
+			wg.Add(1)
+			sem <- empty
+			go func(node *TeardownNode) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				item := &results[index[node]]
+				item.Record, item.Err = p.delete(ctx, node)
+			}(node)
+		}
+		wg.Wait()
+		for _, node := range level {
+			if err := results[index[node]].Err; err != nil {
+				errs = append(errs, fmt.Errorf("delete %s: %w", node, err))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return results, fmt.Errorf("teardown of %s: %d of %d objects failed: %w", p.Root, len(errs), len(p.Nodes), errors.Join(errs...))
+	}
+	return results, nil
+}
+
+func (p *TeardownPlan) delete(ctx context.Context, node *TeardownNode) (Record, error) {
+	resource := p.rest.GetResourceMap()[node.ResourceType]
+	for attempt := 0; ; attempt++ {
+		record, err := resource.DeleteByIdWithContext(ctx, node.ID, nil, nil)
+		if err == nil {
+			if p.opts.WaitTimeout > 0 {
+				if asyncResult := MaybeAsyncResultFromRecord(ctx, record, p.rest); asyncResult != nil {
+					return asyncResult.Wait(p.opts.WaitTimeout)
+				}
+			}
+			return record, nil
+		}
+		if ExpectStatusCodes(err, http.StatusNotFound) {
+			return nil, nil
+		}
+		if !ExpectStatusCodes(err, http.StatusConflict) || attempt >= p.opts.ConflictRetries {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(p.opts.ConflictBackoff):
+		}
+	}
+}
+
+// teardownSource is a relation of Source pointing to the type being expanded.
+type teardownSource struct {
+	resourceType string
+	relation     Relation
+}
+
+type teardownPlanner struct {
+	ctx     context.Context
+	rest    VastRest
+	opts    *TeardownOptions
+	nodes   map[string]*TeardownNode
+	sources map[string][]teardownSource // target type -> relations referencing it
+	listed  map[string]RecordSet        // full listings of types that cannot be filtered server-side
+}
+
+func newTeardownPlanner(ctx context.Context, rest VastRest, opts *TeardownOptions) *teardownPlanner {
+	p := &teardownPlanner{
+		ctx:     ctx,
+		rest:    rest,
+		opts:    opts.normalize(),
+		nodes:   make(map[string]*TeardownNode),
+		sources: make(map[string][]teardownSource),
+		listed:  make(map[string]RecordSet),
+	}
+	for resourceType := range RelationRegistry {
+		if !p.deletable(resourceType) {
+			continue
+		}
+		for _, relation := range GetAllRelationsForResource(resourceType) {
+			p.sources[relation.ResourceType] = append(p.sources[relation.ResourceType], teardownSource{resourceType, relation})
+		}
+	}
+	for target := range p.sources {
+		sort.Slice(p.sources[target], func(i, j int) bool {
+			a, b := p.sources[target][i], p.sources[target][j]
+			if a.resourceType != b.resourceType {
+				return a.resourceType < b.resourceType
+			}
+			return a.relation.Name < b.relation.Name
+		})
+	}
+	return p
+}
+
+// deletable reports whether resourceType is registered, listable, deletable and not skipped.
+func (p *teardownPlanner) deletable(resourceType string) bool {
+	resource, ok := p.rest.GetResourceMap()[resourceType]
+	if !ok || p.opts.skips(resourceType) {
+		return false
+	}
+	ops := GetCRUDHintsFromResource(resource)
+	return ops.isListable() && ops.isDeletable()
+}
+
+// add registers a node for record, returning nil if it was already planned.
+func (p *teardownPlanner) add(resourceType string, record Record, via string) (*TeardownNode, error) {
+	id, ok := record["id"]
+	if !ok {
+		return nil, fmt.Errorf("%s record has no id", resourceType)
+	}
+	key := resourceType + ":" + relationKey(id)
+	if _, exists := p.nodes[key]; exists {
+		return nil, nil
+	}
+	if p.opts.MaxObjects >= 0 && len(p.nodes) >= p.opts.MaxObjects {
+		return nil, fmt.Errorf("%w: more than %d objects to delete", ErrTeardownLimitExceeded, p.opts.MaxObjects)
+	}
+	node := &TeardownNode{ResourceType: resourceType, ID: id, Record: record, Via: via}
+	if name, ok := record["name"].(string); ok {
+		node.Name = name
+	} else if field, ok := TeardownPathFields[resourceType]; ok {
+		node.Name, _ = record[field].(string)
+	}
+	p.nodes[key] = node
+	return node, nil
+}
+
+// attach adds a planned or already known dependent to parent.
+func (p *teardownPlanner) attach(parent *TeardownNode, resourceType string, record Record, via string) (*TeardownNode, error) {
+	node, err := p.add(resourceType, record, via)
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		existing := p.nodes[resourceType+":"+relationKey(record["id"])]
+		if existing != parent {
+			parent.Dependents = append(parent.Dependents, existing)
+		}
+		return nil, nil
+	}
+	parent.Dependents = append(parent.Dependents, node)
+	return node, nil
+}
+
+// expand recursively discovers the dependents of node.
+func (p *teardownPlanner) expand(node *TeardownNode) error {
+	var discovered []*TeardownNode
+	for _, source := range p.sources[node.ResourceType] {
+		if strings.HasSuffix(source.relation.Field, "_ids") {
+			continue // list references are owned by the referencing object, not dependents
+		}
+		records, err := p.list(source.resourceType, Params{source.relation.Field: node.ID})
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			child, err := p.attach(node, source.resourceType, record, source.relation.Field)
+			if err != nil {
+				return err
+			}
+			if child != nil {
+				discovered = append(discovered, child)
+			}
+		}
+	}
+	if node.ResourceType == "View" {
+		if path, ok := node.Record[TeardownPathFields["View"]].(string); ok && path != "" {
+			if err := p.expandPath(node, path, "View"); err != nil {
+				return err
+			}
+		}
+	}
+	for _, child := range discovered {
+		if err := p.expand(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expandPath attaches objects located at or under path to parent, skipping excludeType.
+func (p *teardownPlanner) expandPath(parent *TeardownNode, path, excludeType string) error {
+	types := make([]string, 0, len(TeardownPathFields))
+	for resourceType := range TeardownPathFields {
+		if resourceType != excludeType && p.deletable(resourceType) {
+			types = append(types, resourceType)
+		}
+	}
+	sort.Strings(types)
+
+	var discovered []*TeardownNode
+	for _, resourceType := range types {
+		field := TeardownPathFields[resourceType]
+		records, err := p.list(resourceType, Params{LookupKey(field, LookupStartsWith): strings.TrimSuffix(path, "/")})
+		if err != nil {
+			return err
+		}
+		for _, record := range records {
+			if value, _ := record[field].(string); !pathWithin(value, path) {
+				continue
+			}
+			child, err := p.attach(parent, resourceType, record, field)
+			if err != nil {
+				return err
+			}
+			if child != nil {
+				discovered = append(discovered, child)
+			}
+		}
+	}
+	for _, child := range discovered {
+		if err := p.expand(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// list returns records of resourceType matching filter. Keys the GET endpoint does not declare
+// are evaluated client-side, so an ignored query parameter can never widen the result.
+func (p *teardownPlanner) list(resourceType string, filter Params) (RecordSet, error) {
+	resource := p.rest.GetResourceMap()[resourceType]
+	server, _, err := SplitQueryParams(resource.GetResourcePath(), filter)
+	if err != nil {
+		server = Params{}
+	}
+	var records RecordSet
+	if len(server) == 0 {
+		cached, ok := p.listed[resourceType]
+		if !ok {
+			if cached, err = resource.ListWithContext(p.ctx, Params{}); err != nil {
+				return nil, err
+			}
+			p.listed[resourceType] = cached
+		}
+		records = cached
+	} else if records, err = resource.ListWithContext(p.ctx, server); err != nil {
+		return nil, err
+	}
+	return FilterRecords(records, filter)
+}
+
+// plan assigns levels and orders nodes for deletion.
+func (p *teardownPlanner) plan(root *TeardownNode) *TeardownPlan {
+	levels := make(map[*TeardownNode]int, len(p.nodes))
+	var level func(node *TeardownNode, visiting map[*TeardownNode]bool) int
+	level = func(node *TeardownNode, visiting map[*TeardownNode]bool) int {
+		if l, ok := levels[node]; ok {
+			return l
+		}
+		if visiting[node] {
+			return 0 // dependency cycle: break it here
+		}
+		visiting[node] = true
+		l := 0
+		for _, dependent := range node.Dependents {
+			l = max(l, level(dependent, visiting)+1)
+		}
+		delete(visiting, node)
+		levels[node] = l
+		return l
+	}
+
+	nodes := make([]*TeardownNode, 0, len(p.nodes))
+	for _, node := range p.nodes {
+		node.Level = level(node, map[*TeardownNode]bool{})
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		a, b := nodes[i], nodes[j]
+		if a.Level != b.Level {
+			return a.Level < b.Level
+		}
+		if a.ResourceType != b.ResourceType {
+			return a.ResourceType < b.ResourceType
+		}
+		return relationKey(a.ID) < relationKey(b.ID)
+	})
+	return &TeardownPlan{Root: root, Nodes: nodes, rest: p.rest, opts: p.opts}
+}
+
+// pathWithin reports whether path equals root or is located under it.
+func pathWithin(path, root string) bool {
+	if path == "" {
+		return false
+	}
+	root = strings.TrimSuffix(root, "/")
+	return path == root || root == "" || strings.HasPrefix(path, root+"/")
+}
@@ -0,0 +1,313 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// teardownTestServer is an in-memory VMS that ignores list filters, so the planner
+// must filter client-side. Deletions are recorded in order.
+type teardownTestServer struct {
+	mu        sync.Mutex
+	data      map[string]map[string]map[string]any // collection -> id -> record
+	deleted   []string
+	conflicts map[string]int // "collection/id" -> remaining 409 answers
+	failures  map[string]bool
+}
+
+func newTeardownTestServer() *teardownTestServer {
+	data := map[string]map[string]map[string]any{
+		"tenants": {
+			"1": {"id": 1, "name": "t1"},
+			"2": {"id": 2, "name": "t2"},
+		},
+		"viewpolicies": {
+			"20": {"id": 20, "name": "p20", "tenant_id": 1},
+			"21": {"id": 21, "name": "p21", "tenant_id": 2},
+		},
+		"views": {
+			"10": {"id": 10, "path": "/a", "tenant_id": 1, "policy_id": 20},
+			"11": {"id": 11, "path": "/b", "tenant_id": 2, "policy_id": 21},
+			"12": {"id": 12, "path": "/a/b", "tenant_id": 1, "policy_id": 20},
+			"13": {"id": 13, "path": "/ab", "tenant_id": 2, "policy_id": 21},
+		},
+		"quotas": {
+			"30": {"id": 30, "name": "q30", "path": "/a/q", "tenant_id": 1},
+			"31": {"id": 31, "name": "q31", "path": "/b", "tenant_id": 2},
+		},
+		"s3lifecyclerules": {
+			"40": {"id": 40, "name": "r40", "view_id": 10},
+			"41": {"id": 41, "name": "r41", "view_id": 11},
+		},
+	}
+	return &teardownTestServer{data: data, conflicts: map[string]int{}, failures: map[string]bool{}}
+}
+
+func (s *teardownTestServer) handler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/") // api, latest, collection[, id]
+		collection := parts[2]
+		w.Header().Set("Content-Type", "application/json")
+		if len(parts) == 3 {
+			var results []any
+			for _, record := range s.data[collection] {
+				results = append(results, record)
+			}
+			_ = json.NewEncoder(w).Encode(results)
+			return
+		}
+		id := parts[3]
+		record, ok := s.data[collection][id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail": "not found"}`))
+			return
+		}
+		key := collection + "/" + id
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(record)
+		case http.MethodDelete:
+			if s.failures[key] {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"detail": "boom"}`))
+				return
+			}
+			if s.conflicts[key] > 0 {
+				s.conflicts[key]--
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"detail": "in use"}`))
+				return
+			}
+			delete(s.data[collection], id)
+			s.deleted = append(s.deleted, key)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+}
+
+func newTeardownTestRest(t *testing.T, server *httptest.Server) *DummyRest {
+	t.Helper()
+	saved := RelationRegistry
+	RelationRegistry = make(map[string]map[string]Relation)
+	t.Cleanup(func() { RelationRegistry = saved })
+	RegisterRelation("View", "tenant", "tenant_id", "Tenant")
+	RegisterRelation("View", "policy", "policy_id", "ViewPolicy")
+	RegisterRelation("ViewPolicy", "tenant", "tenant_id", "Tenant")
+	RegisterRelation("Quota", "tenant", "tenant_id", "Tenant")
+	RegisterRelation("S3LifeCycleRule", "view", "view_id", "View")
+	RegisterRelation("Cluster", "tenant", "tenant_id", "Tenant") // not deletable: ignored
+
+	rest := &DummyRest{
+		ctx:         context.Background(),
+		Session:     newTestSession(t, server),
+		resourceMap: make(map[string]VastResourceAPIWithContext),
+	}
+	for resourceType, path := range map[string]string{
+		"Tenant":          "tenants",
+		"ViewPolicy":      "viewpolicies",
+		"View":            "views",
+		"Quota":           "quotas",
+		"S3LifeCycleRule": "s3lifecyclerules",
+	} {
+		rest.resourceMap[resourceType] = NewVastResource(path, resourceType, rest, NewResourceOps(L, R, D), nil)
+	}
+	rest.resourceMap["Cluster"] = NewVastResource("clusters", "Cluster", rest, NewResourceOps(L, R), nil)
+	return rest
+}
+
+func planKeys(plan *TeardownPlan) map[string]int {
+	out := make(map[string]int, len(plan.Nodes))
+	for _, node := range plan.Nodes {
+		out[node.ResourceType+"/"+relationKey(node.ID)] = node.Level
+	}
+	return out
+}
+
+func TestPlanTeardown_Tenant(t *testing.T) {
+	srv := newTeardownTestServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTeardownTestRest(t, server)
+
+	plan, err := PlanTeardown(context.Background(), rest, "Tenant", 1, nil)
+	if err != nil {
+		t.Fatalf("PlanTeardown: %v", err)
+	}
+	want := map[string]int{
+		"S3LifeCycleRule/40": 0,
+		"Quota/30":           0,
+		"View/12":            0,
+		"View/10":            1,
+		"ViewPolicy/20":      2,
+		"Tenant/1":           3,
+	}
+	got := planKeys(plan)
+	if len(got) != len(want) {
+		t.Fatalf("plan = %v, want %v", got, want)
+	}
+	for key, level := range want {
+		if got[key] != level {
+			t.Errorf("%s level = %d, want %d (plan %v)", key, got[key], level, got)
+		}
+	}
+	if plan.Root.ResourceType != "Tenant" || plan.Nodes[len(plan.Nodes)-1] != plan.Root {
+		t.Errorf("expected root to be deleted last, got %v", plan.Nodes[len(plan.Nodes)-1])
+	}
+	if out := plan.String(); !strings.Contains(out, "teardown of Tenant 1 (t1): 6 objects") || !strings.Contains(out, "Quota 30 (q30) [via tenant_id]") {
+		t.Errorf("unexpected dry-run output:\n%s", out)
+	}
+	if len(srv.deleted) != 0 {
+		t.Errorf("planning must not delete anything, deleted %v", srv.deleted)
+	}
+}
+
+func TestPlanTeardown_Execute(t *testing.T) {
+	srv := newTeardownTestServer()
+	srv.conflicts["viewpolicies/20"] = 2
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTeardownTestRest(t, server)
+
+	plan, err := rest.resourceMap["Tenant"].(*VastResource).PlanTeardown(context.Background(), 1, &TeardownOptions{
+		Concurrency:     2,
+		ConflictBackoff: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatalf("PlanTeardown: %v", err)
+	}
+	result, err := plan.Execute(context.Background())
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if result.Deleted() != 6 || len(result.Failed()) != 0 {
+		t.Errorf("deleted %d, failed %v", result.Deleted(), result.Failed())
+	}
+
+	position := make(map[string]int, len(srv.deleted))
+	for i, key := range srv.deleted {
+		position[key] = i
+	}
+	for _, before := range [][2]string{
+		{"s3lifecyclerules/40", "views/10"},
+		{"quotas/30", "views/10"},
+		{"views/10", "viewpolicies/20"},
+		{"views/12", "viewpolicies/20"},
+		{"viewpolicies/20", "tenants/1"},
+	} {
+		if position[before[0]] >= position[before[1]] {
+			t.Errorf("%s deleted after %s (order %v)", before[0], before[1], srv.deleted)
+		}
+	}
+	if _, ok := srv.data["views"]["11"]; !ok {
+		t.Error("view of another tenant was deleted")
+	}
+}
+
+func TestPlanTeardown_ExecuteStopsOnFailure(t *testing.T) {
+	srv := newTeardownTestServer()
+	srv.failures["quotas/30"] = true
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTeardownTestRest(t, server)
+
+	plan, err := PlanTeardown(context.Background(), rest, "Tenant", 1, &TeardownOptions{ConflictRetries: -1})
+	if err != nil {
+		t.Fatalf("PlanTeardown: %v", err)
+	}
+	result, err := plan.Execute(context.Background())
+	var apiErr *ApiError
+	if err == nil || !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected wrapped ApiError, got %v", err)
+	}
+	skipped := 0
+	for _, item := range result.Failed() {
+		if errors.Is(item.Err, ErrTeardownSkipped) {
+			skipped++
+		}
+	}
+	if skipped != 3 {
+		t.Errorf("expected 3 skipped nodes (levels 1-3), got %d: %v", skipped, result.Failed())
+	}
+	if _, ok := srv.data["tenants"]["1"]; !ok {
+		t.Error("tenant must not be deleted after a failure")
+	}
+}
+
+func TestPlanTeardown_MaxObjects(t *testing.T) {
+	srv := newTeardownTestServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTeardownTestRest(t, server)
+
+	_, err := PlanTeardown(context.Background(), rest, "Tenant", 1, &TeardownOptions{MaxObjects: 3})
+	if !errors.Is(err, ErrTeardownLimitExceeded) {
+		t.Fatalf("expected ErrTeardownLimitExceeded, got %v", err)
+	}
+
+	plan, err := PlanTeardown(context.Background(), rest, "Tenant", 1, &TeardownOptions{SkipTypes: []string{"S3LifeCycleRule", "Quota"}})
+	if err != nil {
+		t.Fatalf("PlanTeardown: %v", err)
+	}
+	if plan.Len() != 4 {
+		t.Errorf("expected skipped types to be excluded, got %v", planKeys(plan))
+	}
+}
+
+func TestPlanPathTeardown(t *testing.T) {
+	srv := newTeardownTestServer()
+	server := httptest.NewTLSServer(srv.handler())
+	defer server.Close()
+	rest := newTeardownTestRest(t, server)
+
+	plan, err := PlanPathTeardown(context.Background(), rest, "/a/", nil)
+	if err != nil {
+		t.Fatalf("PlanPathTeardown: %v", err)
+	}
+	got := planKeys(plan)
+	for _, key := range []string{"View/10", "View/12", "Quota/30", "S3LifeCycleRule/40"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("expected %s in plan %v", key, got)
+		}
+	}
+	if len(got) != 4 {
+		t.Errorf("unexpected plan %v (/ab must not match /a)", got)
+	}
+	if !strings.HasPrefix(plan.String(), "teardown of path /a/: 4 objects") {
+		t.Errorf("unexpected dry-run output:\n%s", plan)
+	}
+	if _, err := plan.Execute(context.Background()); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if len(srv.deleted) != 4 {
+		t.Errorf("deleted %v", srv.deleted)
+	}
+}
+
+func TestPathWithin(t *testing.T) {
+	tests := []struct {
+		path, root string
+		want       bool
+	}{
+		{"/a", "/a", true},
+		{"/a/b", "/a", true},
+		{"/a/b", "/a/", true},
+		{"/ab", "/a", false},
+		{"/b", "/", true},
+		{"", "/", false},
+	}
+	for _, tt := range tests {
+		if got := pathWithin(tt.path, tt.root); got != tt.want {
+			t.Errorf("pathWithin(%q, %q) = %v, want %v", tt.path, tt.root, got, tt.want)
+		}
+	}
+}
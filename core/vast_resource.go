@@ -61,6 +61,7 @@ type VastResource struct {
 	resourceType string
 	Rest         VastRest
 	mu           *KeyLocker
+	middlewares  []Middleware
 	resourceOps  ResourceOps
 	parent       any // Reference to the parent resource that embeds this VastResource
 }
@@ -341,8 +342,44 @@ func (e *VastResource) GetIterator(params Params, pageSize int) Iterator {
 // This allows for convenient deferring of unlock operations:
 //
 //	defer resource.Lock()()
+//
+// It panics if the configured LockBackend fails (e.g. the lock directory is not writable);
+// use LockWithContext or TryLock to handle backend errors.
 func (e *VastResource) Lock(keys ...any) func() {
-	return e.mu.Lock(keys...)
+	return e.locker().Lock(keys...)
+}
+
+// TryLock acquires the resource-level lock without waiting.
+// It fails with ErrLockHeld, describing the current holder, when the lock is taken.
+func (e *VastResource) TryLock(keys ...any) (func(), error) {
+	return e.locker().TryLock(keys...)
+}
+
+// LockWithContext waits for the resource-level lock until it is acquired or ctx is done:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+//	defer cancel()
+//	unlock, err := resource.LockWithContext(ctx, "path", "/shared")
+func (e *VastResource) LockWithContext(ctx context.Context, keys ...any) (func(), error) {
+	return e.locker().LockWithContext(ctx, keys...)
+}
+
+// LockHolder returns the current holder of the resource-level lock, or nil when it is free.
+func (e *VastResource) LockHolder(keys ...any) (*LockHolder, error) {
+	return e.locker().Holder(keys...)
+}
+
+// locker returns the KeyLocker of the configured LockBackend, keyed by resource type,
+// or the in-process KeyLocker of the resource.
+func (e *VastResource) locker() *KeyLocker {
+	if e.Rest != nil {
+		if session := e.Rest.GetSession(); session != nil {
+			if backend := session.GetConfig().LockBackend; backend != nil {
+				return NewKeyLockerWithBackend(backend, e.resourceType)
+			}
+		}
+	}
+	return e.mu
 }
 
 // ExtraMethodInfo contains information about an extra method discovered on a resource.
@@ -466,10 +503,27 @@ func (e *TypedVastResource) GetResourceType() string {
 // This allows for convenient deferring of unlock operations:
 //
 //	defer resource.Lock()()
+//
+// It panics if the configured LockBackend fails; use LockWithContext or TryLock to handle backend errors.
 func (e *TypedVastResource) Lock(keys ...any) func() {
 	return e.getUntypedVastResource().Lock(keys...)
 }
 
+// TryLock acquires the resource-level lock without waiting (see VastResource.TryLock).
+func (e *TypedVastResource) TryLock(keys ...any) (func(), error) {
+	return e.getUntypedVastResource().(ResourceLocker).TryLock(keys...)
+}
+
+// LockWithContext waits for the resource-level lock until it is acquired or ctx is done.
+func (e *TypedVastResource) LockWithContext(ctx context.Context, keys ...any) (func(), error) {
+	return e.getUntypedVastResource().(ResourceLocker).LockWithContext(ctx, keys...)
+}
+
+// LockHolder returns the current holder of the resource-level lock, or nil when it is free.
+func (e *TypedVastResource) LockHolder(keys ...any) (*LockHolder, error) {
+	return e.getUntypedVastResource().(ResourceLocker).LockHolder(keys...)
+}
+
 func (e *TypedVastResource) String() string {
 	return fmt.Sprintf("%s", e.getUntypedVastResource())
 }
@@ -0,0 +1,148 @@
+package core
+
+import (
+	"context"
+	"fmt"
+)
+
+// ######################################################
+//              WAIT PREDICATES
+// ######################################################
+
+// WaitPredicate is a condition on a polled record, usable as the verifyFn of WaitAPICondition
+// and with VastResource.WaitFor. record is nil when the object does not exist.
+// Field paths use the Record.Get syntax ("state", "status.phase", "hosts[0].name").
+//
+//	record, err := rest.Views.WaitFor(core.Params{"id": 5},
+//	    core.All(core.FieldEquals("state", "ACTIVE"), core.FieldNotEmpty("bucket")), nil)
+type WaitPredicate func(record Record) (bool, error)
+
+// FieldEquals is satisfied when the value at path equals value (numbers compare by value).
+func FieldEquals(path string, value any) WaitPredicate {
+	return FieldIn(path, value)
+}
+
+// FieldIn is satisfied when the value at path equals one of values.
+func FieldIn(path string, values ...any) WaitPredicate {
+	return func(record Record) (bool, error) {
+		actual, ok := record.Get(path)
+		if !ok {
+			return false, nil
+		}
+		for _, value := range values {
+			if valuesEqual(actual, value) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// FieldNotEmpty is satisfied when the value at path is set and not null, "", 0, false or an empty list/map.
+func FieldNotEmpty(path string) WaitPredicate {
+	return func(record Record) (bool, error) {
+		actual, ok := record.Get(path)
+		if !ok || actual == nil {
+			return false, nil
+		}
+		switch v := actual.(type) {
+		case string:
+			return v != "", nil
+		case bool:
+			return v, nil
+		case []any:
+			return len(v) > 0, nil
+		case map[string]any:
+			return len(v) > 0, nil
+		}
+		if n, isNumber := normalizeNumber(actual); isNumber {
+			return n != 0, nil
+		}
+		return true, nil
+	}
+}
+
+// NumericAtLeast is satisfied when the value at path is a number (or numeric string) >= min.
+// It fails when the value is set but not numeric.
+func NumericAtLeast(path string, min float64) WaitPredicate {
+	return func(record Record) (bool, error) {
+		actual, ok := record.Get(path)
+		if !ok || actual == nil {
+			return false, nil
+		}
+		n, isNumber := toFloat(actual)
+		if !isNumber {
+			return false, fmt.Errorf("%s: %v is not numeric", path, actual)
+		}
+		return n >= min, nil
+	}
+}
+
+// UntilDeleted is satisfied when the object no longer exists (not found).
+// WaitFor accepts not found; with WaitAPICondition, set WaitAPIConditionConfig.AcceptNotFound.
+func UntilDeleted() WaitPredicate {
+	return func(record Record) (bool, error) {
+		return record == nil, nil
+	}
+}
+
+// All is satisfied when all predicates are; it stops at the first error.
+func All(predicates ...WaitPredicate) WaitPredicate {
+	return func(record Record) (bool, error) {
+		for _, predicate := range predicates {
+			if ok, err := predicate(record); err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+}
+
+// Any is satisfied when one of predicates is; it stops at the first error.
+func Any(predicates ...WaitPredicate) WaitPredicate {
+	return func(record Record) (bool, error) {
+		for _, predicate := range predicates {
+			if ok, err := predicate(record); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	}
+}
+
+// WaitFor polls the object matching searchParams until predicate is satisfied (see WaitForWithContext).
+func (e *VastResource) WaitFor(searchParams Params, predicate WaitPredicate, opts *WaitAPIConditionConfig) (Record, error) {
+	return e.WaitForWithContext(e.Rest.GetCtx(), searchParams, predicate, opts)
+}
+
+// WaitForWithContext polls the object matching searchParams ({"id": 5} polls by ID) until predicate
+// is satisfied, the timeout of opts expires or ctx is cancelled. It returns the last polled record,
+// nil when the predicate was satisfied by the object being deleted:
+//
+//	_, err := rest.Volumes.WaitForWithContext(ctx, core.Params{"id": id}, core.UntilDeleted(),
+//	    &core.WaitAPIConditionConfig{Timeout: 5 * time.Minute})
+func (e *VastResource) WaitForWithContext(ctx context.Context, searchParams Params, predicate WaitPredicate, opts *WaitAPIConditionConfig) (Record, error) {
+	return waitFor(ctx, e, searchParams, predicate, opts)
+}
+
+// WaitFor polls the object matching searchParams until predicate is satisfied (see VastResource.WaitFor).
+func (e *TypedVastResource) WaitFor(searchParams Params, predicate WaitPredicate, opts *WaitAPIConditionConfig) (Record, error) {
+	return e.WaitForWithContext(e.Untyped.GetCtx(), searchParams, predicate, opts)
+}
+
+// WaitForWithContext polls the object matching searchParams until predicate is satisfied
+// (see VastResource.WaitForWithContext).
+func (e *TypedVastResource) WaitForWithContext(ctx context.Context, searchParams Params, predicate WaitPredicate, opts *WaitAPIConditionConfig) (Record, error) {
+	return waitFor(ctx, e.getUntypedVastResource().(VastResourceAPIWithContext), searchParams, predicate, opts)
+}
+
+func waitFor(ctx context.Context, resource VastResourceAPIWithContext, searchParams Params, predicate WaitPredicate, opts *WaitAPIConditionConfig) (Record, error) {
+	// WaitAPICondition normalizes and advances the config: keep the caller's one intact.
+	var copied WaitAPIConditionConfig
+	if opts != nil {
+		copied = *opts
+	}
+	// Predicates see a missing object as a nil record.
+	copied.AcceptNotFound = true
+	return WaitAPICondition(ctx, resource, searchParams, &copied, predicate)
+}
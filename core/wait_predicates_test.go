@@ -0,0 +1,173 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock advances instantly on After so that polling tests do not sleep.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.sleeps = append(c.sleeps, d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+func TestWaitPredicates(t *testing.T) {
+	record := Record{
+		"state": "ACTIVE", "progress": json.Number("75"), "bucket": "", "hosts": []any{"h1"},
+		"status": map[string]any{"phase": "ready"}, "count": "12",
+	}
+	cases := []struct {
+		name      string
+		predicate WaitPredicate
+		want      bool
+		wantErr   bool
+	}{
+		{"equals", FieldEquals("state", "ACTIVE"), true, false},
+		{"equals nested", FieldEquals("status.phase", "ready"), true, false},
+		{"equals number", FieldEquals("progress", 75), true, false},
+		{"equals missing", FieldEquals("nope", "ACTIVE"), false, false},
+		{"in", FieldIn("state", "PENDING", "ACTIVE"), true, false},
+		{"not in", FieldIn("state", "PENDING", "FAILED"), false, false},
+		{"not empty", FieldNotEmpty("hosts"), true, false},
+		{"empty string", FieldNotEmpty("bucket"), false, false},
+		{"at least", NumericAtLeast("progress", 75), true, false},
+		{"below", NumericAtLeast("progress", 100), false, false},
+		{"numeric string", NumericAtLeast("count", 10), true, false},
+		{"not numeric", NumericAtLeast("state", 1), false, true},
+		{"all", All(FieldEquals("state", "ACTIVE"), FieldNotEmpty("hosts")), true, false},
+		{"all fails", All(FieldEquals("state", "ACTIVE"), FieldNotEmpty("bucket")), false, false},
+		{"any", Any(FieldEquals("state", "FAILED"), NumericAtLeast("progress", 50)), true, false},
+		{"any error", Any(NumericAtLeast("state", 1), FieldEquals("state", "ACTIVE")), false, true},
+		{"until deleted exists", UntilDeleted(), false, false},
+	}
+	for _, tc := range cases {
+		got, err := tc.predicate(record)
+		if got != tc.want || (err != nil) != tc.wantErr {
+			t.Errorf("%s: got %v, %v", tc.name, got, err)
+		}
+	}
+	if ok, _ := UntilDeleted()(nil); !ok {
+		t.Error("UntilDeleted must be satisfied by a missing record")
+	}
+	if ok, _ := FieldEquals("state", "ACTIVE")(nil); ok {
+		t.Error("field predicates must not be satisfied by a missing record")
+	}
+}
+
+func TestWaitAPICondition_FakeClock(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	polls := 0
+	mockAPI := &mockVastResourceAPI{
+		getByIdFunc: func(ctx context.Context, id any) (Record, error) {
+			polls++
+			return Record{"state": "PENDING"}, nil
+		},
+	}
+	start := time.Now()
+	_, err := WaitAPICondition(context.Background(), mockAPI, Params{"id": 1},
+		&WaitAPIConditionConfig{Timeout: time.Hour, Interval: time.Minute, MaxInterval: 10 * time.Minute, Clock: clock},
+		FieldEquals("state", "ACTIVE"))
+	if err == nil || !strings.Contains(err.Error(), "timeout") {
+		t.Fatalf("expected timeout, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("fake clock waits must be instant, took %v", elapsed)
+	}
+	var slept time.Duration
+	for _, d := range clock.sleeps {
+		slept += d
+	}
+	if slept != time.Hour || polls < 5 {
+		t.Errorf("slept %v in %d polls, want the whole timeout", slept, polls)
+	}
+}
+
+func TestWaitAPICondition_CancelDuringSleep(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	mockAPI := &mockVastResourceAPI{
+		getByIdFunc: func(context.Context, any) (Record, error) {
+			cancel()
+			return Record{"state": "PENDING"}, nil
+		},
+	}
+	start := time.Now()
+	_, err := WaitAPICondition(ctx, mockAPI, Params{"id": 1},
+		&WaitAPIConditionConfig{Timeout: time.Minute, Interval: 10 * time.Second}, FieldEquals("state", "ACTIVE"))
+	if err == nil || !strings.Contains(err.Error(), "cancelled") {
+		t.Errorf("expected cancellation, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("cancellation must interrupt the sleep, took %v", elapsed)
+	}
+}
+
+func TestVastResource_WaitFor(t *testing.T) {
+	var mu sync.Mutex
+	gets := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		gets++
+		w.Header().Set("Content-Type", "application/json")
+		if gets >= 3 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail": "Not found."}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": 5, "state": []string{"DELETING", "ACTIVE"}[gets-1]})
+	}))
+	defer server.Close()
+
+	resource := newBulkTestResource(t, server, "volumes", "Volume")
+	opts := &WaitAPIConditionConfig{Clock: &fakeClock{now: time.Unix(0, 0)}}
+
+	record, err := resource.WaitFor(Params{"id": 5}, FieldIn("state", "ACTIVE", "FAILED"), opts)
+	if err != nil || record["state"] != "ACTIVE" {
+		t.Fatalf("WaitFor = %v, %v", record, err)
+	}
+	if opts.Interval != 0 {
+		t.Error("WaitFor must not modify the caller's config")
+	}
+
+	record, err = resource.WaitFor(Params{"id": 5}, UntilDeleted(), opts)
+	if err != nil || record != nil {
+		t.Errorf("UntilDeleted must treat not found as success, got %v, %v", record, err)
+	}
+	var apiErr *ApiError
+	if _, err = resource.WaitFor(Params{"id": 5}, FieldEquals("state", "ACTIVE"), opts); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("other predicates must fail on not found, got %v", err)
+	}
+
+	// Plain verifyFns are not called for a missing object unless AcceptNotFound is set.
+	notActive := func(r Record) (bool, error) { return r["state"] != "ACTIVE", nil }
+	if _, err = WaitAPICondition(context.Background(), resource, Params{"id": 5}, &WaitAPIConditionConfig{Clock: opts.Clock}, notActive); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("WaitAPICondition must fail on not found, got %v", err)
+	}
+	if _, err = WaitAPICondition(context.Background(), resource, Params{"id": 5}, &WaitAPIConditionConfig{Clock: opts.Clock, AcceptNotFound: true}, UntilDeleted()); err != nil {
+		t.Errorf("AcceptNotFound must pass the missing object to verifyFn, got %v", err)
+	}
+}
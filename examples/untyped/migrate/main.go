@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	client "github.com/vast-data/go-vast-client"
+	"github.com/vast-data/go-vast-client/apply"
+)
+
+func main() {
+	ctx := context.Background()
+
+	source, err := client.NewVMSRest(&client.VMSConfig{
+		Host:     "l101", // replace with your source VAST address
+		Username: "admin",
+		Password: "123456",
+	})
+	if err != nil {
+		panic(err)
+	}
+	target, err := client.NewVMSRest(&client.VMSConfig{
+		Host:     "l102", // replace with your target VAST address
+		Username: "admin",
+		Password: "123456",
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	bundle, err := apply.Export(ctx, source, nil)
+	if err != nil {
+		panic(err)
+	}
+	for _, warning := range bundle.Warnings {
+		fmt.Println("warning:", warning)
+	}
+	if err := bundle.Save("bundle.yaml"); err != nil {
+		panic(err)
+	}
+
+	// Dry run first: print what would be created and what conflicts.
+	report, err := apply.Import(ctx, target, bundle, &apply.ImportOptions{DryRun: true})
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(report)
+
+	report, err = apply.Import(ctx, target, bundle, nil)
+	if err != nil {
+		panic(err)
+	}
+	for _, mapping := range report.IDs {
+		fmt.Printf("%s: %v -> %v\n", mapping.Ref, mapping.SourceID, mapping.TargetID)
+	}
+}
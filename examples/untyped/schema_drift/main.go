@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	client "github.com/vast-data/go-vast-client"
+	"github.com/vast-data/go-vast-client/core"
+)
+
+// Lists the first page of every listable resource and reports where the cluster
+// responses drift from the OpenAPI schema the client was generated from.
+// Exits with status 1 when drift is found, so it can gate upgrades in CI.
+func main() {
+	ctx := context.Background()
+	report := core.NewDriftReport()
+
+	rest, err := client.NewVMSRest(&client.VMSConfig{
+		Host:        "10.27.40.1", // replace with your staging VAST address
+		Username:    "admin",
+		Password:    "123456",
+		SchemaDrift: report,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	resourceMap := rest.GetResourceMap()
+	names := make([]string, 0, len(resourceMap))
+	for name := range resourceMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resource := resourceMap[name]
+		if core.GetCRUDHintsFromResource(resource)&core.L == 0 {
+			continue
+		}
+		if _, err := resource.GetIteratorWithContext(ctx, nil, 20).Next(); err != nil {
+			fmt.Printf("%s: %v\n", name, err)
+		}
+	}
+
+	fmt.Print(report)
+	if b, err := json.MarshalIndent(report, "", "  "); err == nil {
+		_ = os.WriteFile("schema-drift.json", b, 0o644)
+	}
+	if report.HasDrift() {
+		os.Exit(1)
+	}
+}
@@ -1,6 +1,6 @@
 module github.com/vast-data/go-vast-client
 
-go 1.22.5
+go 1.23
 
 toolchain go1.24.4
 
@@ -8,6 +8,7 @@ require (
 	github.com/bndr/gotabulate v1.1.2
 	github.com/getkin/kin-openapi v0.134.0
 	github.com/hashicorp/go-version v1.9.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -20,5 +21,4 @@ require (
 	github.com/oasdiff/yaml3 v0.0.0-20260224194419-61cd415a242b // indirect
 	github.com/perimeterx/marshmallow v1.1.5 // indirect
 	github.com/woodsbury/decimal128 v1.3.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
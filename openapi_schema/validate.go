@@ -0,0 +1,605 @@
+package openapi_schema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// ######################################################
+//              CLIENT-SIDE REQUEST VALIDATION
+// ######################################################
+
+// ErrOperationNotFound is returned by the validators when the path or method is not
+// declared in the OpenAPI schema.
+var ErrOperationNotFound = errors.New("operation not found in OpenAPI schema")
+
+// reservedQueryParams are accepted by every list endpoint even when not declared.
+var reservedQueryParams = map[string]struct{}{
+	"page":      {},
+	"page_size": {},
+	"ordering":  {},
+	"fields":    {},
+}
+
+// IssueKind classifies a ValidationIssue.
+type IssueKind string
+
+const (
+	IssueUnknownField IssueKind = "unknown_field" // field not declared in the schema
+	IssueMissingField IssueKind = "missing_field" // required field absent
+	IssueTypeMismatch IssueKind = "type_mismatch" // value of the wrong JSON type
+	IssueConstraint   IssueKind = "constraint"    // enum, bounds, length, pattern or item count violated
+)
+
+// ValidationIssue is a single problem found by request or response validation.
+type ValidationIssue struct {
+	In      string // "body", "query" or "response"
+	Field   string // dotted/bracket path of the offending field (e.g. "share_acl.acl[0].grantee")
+	Kind    IssueKind
+	Message string
+}
+
+func (i ValidationIssue) String() string {
+	return fmt.Sprintf("%s.%s: %s", i.In, i.Field, i.Message)
+}
+
+// ValidationError lists every problem found in a request or response. Use errors.As to inspect the issues:
+//
+//	var verr *openapi_schema.ValidationError
+//	if errors.As(err, &verr) {
+//	    for _, issue := range verr.Issues { ... }
+//	}
+type ValidationError struct {
+	Method string
+	Path   string // OpenAPI path the request was validated against (e.g. "/views/{id}/")
+	Issues []ValidationIssue
+}
+
+func (e *ValidationError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s %s: %d validation error(s)", e.Method, e.Path, len(e.Issues))
+	for _, issue := range e.Issues {
+		sb.WriteString("\n  - ")
+		sb.WriteString(issue.String())
+	}
+	return sb.String()
+}
+
+// ValidateRequest validates params against the OpenAPI schema of the operation.
+// For GET and DELETE params are validated as query parameters, for POST, PUT and PATCH
+// as the request body. path may be concrete ("views/5") or templated ("/views/{id}/").
+// It returns a *ValidationError listing every problem, or an error wrapping
+// ErrOperationNotFound when the operation is not declared.
+//
+//	err := openapi_schema.ValidateRequest("POST", "/views/", map[string]any{"nme": "v1"})
+//	// POST /views/: 2 validation error(s)
+//	//   - body.nme: unknown field (did you mean "name"?)
+//	//   - body.path: required field is missing
+func ValidateRequest(method, path string, params map[string]any) error {
+	switch strings.ToUpper(method) {
+	case "GET", "DELETE", "HEAD", "OPTIONS":
+		return ValidateQueryParams(method, path, params)
+	default:
+		return ValidateRequestBody(method, path, params)
+	}
+}
+
+// ValidateRequestBody validates body against the request body schema of the operation:
+// unknown and missing required fields, types, enums, numeric bounds, string lengths and
+// patterns, and item counts. Nested objects and lists are validated recursively.
+// Required fields are only enforced for POST and PUT, since PATCH bodies are partial.
+// null values are accepted for any field.
+func ValidateRequestBody(method, path string, body map[string]any) error {
+	method = strings.ToUpper(method)
+	specPath, err := MatchOperationPath(method, path)
+	if err != nil {
+		return err
+	}
+	schemaRef, err := GetRequestBodySchema(method, specPath)
+	if err != nil {
+		return err
+	}
+	v := &validator{in: "body", requireFields: method == "POST" || method == "PUT"}
+	if !IsEmptySchema(schemaRef) {
+		v.validateObject("", body, schemaRef.Value)
+	}
+	return v.result(method, specPath)
+}
+
+// ValidateQueryParams validates query against the query parameters of the operation.
+// Keys must be declared (or be page, page_size, ordering or fields); a key with a lookup
+// suffix ("name__icontains") is accepted when its field is declared. Values of declared
+// keys are checked against the parameter schema; string values are parsed for numeric
+// and boolean parameters.
+func ValidateQueryParams(method, path string, query map[string]any) error {
+	return validateQuery(method, path, query, true)
+}
+
+// ValidateDeclaredQueryParams is ValidateQueryParams without reporting undeclared keys.
+// Many list endpoints only declare page and page_size while VMS accepts filters on any
+// field, so only the values of declared keys and required parameters are checked.
+func ValidateDeclaredQueryParams(method, path string, query map[string]any) error {
+	return validateQuery(method, path, query, false)
+}
+
+func validateQuery(method, path string, query map[string]any, strict bool) error {
+	method = strings.ToUpper(method)
+	specPath, err := MatchOperationPath(method, path)
+	if err != nil {
+		return err
+	}
+	declared, err := GetQueryParameters(method, specPath)
+	if err != nil {
+		return err
+	}
+	params := make(map[string]*openapi3.Parameter, len(declared))
+	names := make([]string, 0, len(declared)+len(reservedQueryParams))
+	for _, p := range declared {
+		params[p.Name] = p
+		names = append(names, p.Name)
+	}
+	for name := range reservedQueryParams {
+		names = append(names, name)
+	}
+
+	v := &validator{in: "query"}
+	for _, key := range sortedKeys(query) {
+		value := query[key]
+		if _, ok := reservedQueryParams[key]; ok {
+			continue
+		}
+		param, ok := params[key]
+		if !ok {
+			if !strict {
+				continue
+			}
+			if field, _, hasLookup := cutLookup(key); hasLookup {
+				if _, ok := params[field]; ok {
+					continue
+				}
+			}
+			v.unknown(key, names)
+			continue
+		}
+		if param.Schema == nil || value == nil {
+			continue
+		}
+		schema := ResolveComposedSchema(ResolveAllRefs(param.Schema))
+		v.validateValue(key, coerceQueryValue(value, schema), schema)
+	}
+	for _, p := range declared {
+		if _, ok := query[p.Name]; p.Required && !ok {
+			v.add(IssueMissingField, p.Name, "required parameter is missing")
+		}
+	}
+	return v.result(method, specPath)
+}
+
+// ValidateResponse checks a response record against the response model schema of the
+// operation (GetResponseModelSchema) and reports structural drift: unknown fields,
+// missing required fields and type mismatches. Enums and other constraints are not checked.
+// Records of list responses are validated one by one against the item schema.
+//
+//	err := openapi_schema.ValidateResponse("GET", "views/5", record)
+func ValidateResponse(method, path string, record map[string]any) error {
+	method = strings.ToUpper(method)
+	specPath, err := MatchOperationPath(method, path)
+	if err != nil {
+		return err
+	}
+	schemaRef, err := GetResponseModelSchema(method, specPath)
+	if err != nil {
+		return err
+	}
+	v := &validator{in: "response", requireFields: true}
+	if !IsEmptySchema(schemaRef) && IsObject(schemaRef.Value) {
+		v.validateObject("", record, schemaRef.Value)
+	}
+	return v.result(method, specPath)
+}
+
+// MatchOperationPath returns the OpenAPI path declaring method for a concrete request
+// path, e.g. "views/5" -> "/views/{id}/". Literal segments win over path parameters.
+func MatchOperationPath(method, path string) (string, error) {
+	doc, err := loadOpenAPIDocOnce()
+	if err != nil {
+		return "", fmt.Errorf("failed to load OpenAPI document: %w", err)
+	}
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	best, bestParams := "", -1
+	for specPath, item := range doc.Paths.Map() {
+		if item.GetOperation(strings.ToUpper(method)) == nil {
+			continue
+		}
+		specSegments := strings.Split(strings.Trim(specPath, "/"), "/")
+		if len(specSegments) != len(segments) {
+			continue
+		}
+		params, ok := 0, true
+		for i, segment := range specSegments {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				params++
+				continue
+			}
+			if segment != segments[i] {
+				ok = false
+				break
+			}
+		}
+		if ok && (bestParams < 0 || params < bestParams || params == bestParams && specPath < best) {
+			best, bestParams = specPath, params
+		}
+	}
+	if bestParams < 0 {
+		return "", fmt.Errorf("%w: %s %s", ErrOperationNotFound, strings.ToUpper(method), path)
+	}
+	return best, nil
+}
+
+// validator accumulates issues for one request or response.
+type validator struct {
+	in            string
+	requireFields bool
+	issues        []ValidationIssue
+}
+
+func (v *validator) add(kind IssueKind, field, format string, args ...any) {
+	if kind == IssueConstraint && v.in == "response" {
+		// Responses are only checked for structural drift.
+		return
+	}
+	v.issues = append(v.issues, ValidationIssue{In: v.in, Field: field, Kind: kind, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) unknown(field string, candidates []string) {
+	name := field
+	if i := strings.LastIndexAny(field, ".]"); i >= 0 {
+		name = field[i+1:]
+	}
+	kind := "field"
+	if v.in == "query" {
+		kind = "parameter"
+	}
+	if suggestion := closestName(name, candidates); suggestion != "" {
+		v.add(IssueUnknownField, field, "unknown %s (did you mean %q?)", kind, suggestion)
+		return
+	}
+	v.add(IssueUnknownField, field, "unknown %s", kind)
+}
+
+func (v *validator) result(method, specPath string) error {
+	if len(v.issues) == 0 {
+		return nil
+	}
+	return &ValidationError{Method: method, Path: specPath, Issues: v.issues}
+}
+
+func (v *validator) validateObject(path string, value map[string]any, schema *openapi3.Schema) {
+	// Free-form objects (no declared properties) accept any field.
+	if len(schema.Properties) > 0 {
+		names := make([]string, 0, len(schema.Properties))
+		for name := range schema.Properties {
+			names = append(names, name)
+		}
+		for _, key := range sortedKeys(value) {
+			prop, ok := schema.Properties[key]
+			if !ok {
+				if schema.AdditionalProperties.Schema == nil && !isTrue(schema.AdditionalProperties.Has) {
+					v.unknown(joinField(path, key), names)
+				}
+				continue
+			}
+			v.validateValue(joinField(path, key), value[key], ResolveComposedSchema(ResolveAllRefs(prop)))
+		}
+	}
+	if additional := schema.AdditionalProperties.Schema; additional != nil {
+		propSchema := ResolveComposedSchema(ResolveAllRefs(additional))
+		for _, key := range sortedKeys(value) {
+			if _, declared := schema.Properties[key]; !declared {
+				v.validateValue(joinField(path, key), value[key], propSchema)
+			}
+		}
+	}
+	if v.requireFields {
+		for _, name := range schema.Required {
+			if _, ok := value[name]; ok {
+				continue
+			}
+			if prop, ok := schema.Properties[name]; ok && v.in != "response" && ResolveAllRefs(prop).ReadOnly {
+				continue
+			}
+			v.add(IssueMissingField, joinField(path, name), "required field is missing")
+		}
+	}
+}
+
+func (v *validator) validateValue(path string, value any, schema *openapi3.Schema) {
+	if value == nil || schema == nil {
+		return
+	}
+	schemaType := GetSchemaType(schema)
+	rv := reflect.ValueOf(value)
+
+	switch schemaType {
+	case openapi3.TypeObject:
+		m, ok := toStringMap(value)
+		if !ok {
+			v.add(IssueTypeMismatch, path, "expected object, got %s", jsonType(value))
+			return
+		}
+		v.validateObject(path, m, schema)
+		return
+	case openapi3.TypeArray:
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			v.add(IssueTypeMismatch, path, "expected array, got %s", jsonType(value))
+			return
+		}
+		n := uint64(rv.Len())
+		if n < schema.MinItems {
+			v.add(IssueConstraint, path, "must have at least %d items, got %d", schema.MinItems, n)
+		}
+		if schema.MaxItems != nil && n > *schema.MaxItems {
+			v.add(IssueConstraint, path, "must have at most %d items, got %d", *schema.MaxItems, n)
+		}
+		if schema.Items != nil {
+			items := ResolveComposedSchema(ResolveAllRefs(schema.Items))
+			for i := 0; i < rv.Len(); i++ {
+				v.validateValue(fmt.Sprintf("%s[%d]", path, i), rv.Index(i).Interface(), items)
+			}
+		}
+		return
+	case openapi3.TypeString:
+		s, ok := value.(string)
+		if !ok {
+			if rv.Kind() != reflect.String {
+				v.add(IssueTypeMismatch, path, "expected string, got %s", jsonType(value))
+				return
+			}
+			s = rv.String()
+		}
+		if n := uint64(len([]rune(s))); n < schema.MinLength {
+			v.add(IssueConstraint, path, "must be at least %d characters long", schema.MinLength)
+		} else if schema.MaxLength != nil && n > *schema.MaxLength {
+			v.add(IssueConstraint, path, "must be at most %d characters long", *schema.MaxLength)
+		}
+		if re := compilePattern(schema.Pattern); re != nil && !re.MatchString(s) {
+			v.add(IssueConstraint, path, "must match pattern %q", schema.Pattern)
+		}
+	case openapi3.TypeInteger, openapi3.TypeNumber:
+		f, ok := toNumber(value)
+		if !ok {
+			v.add(IssueTypeMismatch, path, "expected %s, got %s", schemaType, jsonType(value))
+			return
+		}
+		if schemaType == openapi3.TypeInteger && f != float64(int64(f)) {
+			v.add(IssueTypeMismatch, path, "expected integer, got %v", f)
+			return
+		}
+		exclusiveMin, exclusiveMax := isExclusiveBound(schema.ExclusiveMin), isExclusiveBound(schema.ExclusiveMax)
+		if schema.Min != nil && (f < *schema.Min || exclusiveMin && f == *schema.Min) {
+			v.add(IssueConstraint, path, "must be %s %v", boundWord(">=", ">", exclusiveMin), *schema.Min)
+		}
+		if schema.Max != nil && (f > *schema.Max || exclusiveMax && f == *schema.Max) {
+			v.add(IssueConstraint, path, "must be %s %v", boundWord("<=", "<", exclusiveMax), *schema.Max)
+		}
+	case openapi3.TypeBoolean:
+		if rv.Kind() != reflect.Bool {
+			v.add(IssueTypeMismatch, path, "expected boolean, got %s", jsonType(value))
+			return
+		}
+	}
+
+	if len(schema.Enum) > 0 && !enumContains(schema.Enum, value) {
+		allowed := make([]string, len(schema.Enum))
+		for i, e := range schema.Enum {
+			allowed[i] = fmt.Sprint(e)
+		}
+		v.add(IssueConstraint, path, "must be one of [%s], got %v", strings.Join(allowed, ", "), value)
+	}
+}
+
+// coerceQueryValue parses string query values for numeric and boolean parameters,
+// since query values are commonly passed as strings.
+func coerceQueryValue(value any, schema *openapi3.Schema) any {
+	s, ok := value.(string)
+	if !ok {
+		return value
+	}
+	switch GetSchemaType(schema) {
+	case openapi3.TypeInteger, openapi3.TypeNumber:
+		if f, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err == nil {
+			return f
+		}
+	case openapi3.TypeBoolean:
+		if b, err := strconv.ParseBool(strings.TrimSpace(s)); err == nil {
+			return b
+		}
+	}
+	return value
+}
+
+// cutLookup splits "field__lookup" at the last "__".
+func cutLookup(key string) (field, lookup string, ok bool) {
+	i := strings.LastIndex(key, "__")
+	if i <= 0 || i+2 == len(key) {
+		return key, "", false
+	}
+	return key[:i], key[i+2:], true
+}
+
+func toStringMap(value any) (map[string]any, bool) {
+	if m, ok := value.(map[string]any); ok {
+		return m, true
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+	m := make(map[string]any, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		m[iter.Key().String()] = iter.Value().Interface()
+	}
+	return m, true
+}
+
+func toNumber(value any) (float64, bool) {
+	if n, ok := value.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
+
+// jsonType names the JSON type of a Go value for error messages.
+func jsonType(value any) string {
+	if _, ok := value.(json.Number); ok {
+		return "number"
+	}
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Map, reflect.Struct:
+		return "object"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	}
+	if _, ok := toNumber(value); ok {
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func enumContains(enum []any, value any) bool {
+	f, isNumber := toNumber(value)
+	for _, e := range enum {
+		if ef, ok := toNumber(e); ok && isNumber {
+			if ef == f {
+				return true
+			}
+			continue
+		}
+		if fmt.Sprint(e) == fmt.Sprint(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// isExclusiveBound reports whether an exclusiveMinimum/exclusiveMaximum modifier is set.
+// The field is a bool in the kin-openapi release used by this module and an ExclusiveBound
+// struct in newer releases (used by the code generators, which import this package).
+func isExclusiveBound(bound any) bool {
+	switch b := bound.(type) {
+	case bool:
+		return b
+	case interface{ IsTrue() bool }:
+		return b.IsTrue()
+	}
+	return false
+}
+
+func boundWord(inclusive, exclusive string, isExclusive bool) string {
+	if isExclusive {
+		return exclusive
+	}
+	return inclusive
+}
+
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
+
+var (
+	patternCacheMu sync.Mutex
+	patternCache   = map[string]*regexp.Regexp{}
+)
+
+// compilePattern compiles an OpenAPI (ECMA-262) pattern. Patterns Go's regexp cannot
+// compile are skipped (nil).
+func compilePattern(pattern string) *regexp.Regexp {
+	if pattern == "" {
+		return nil
+	}
+	patternCacheMu.Lock()
+	defer patternCacheMu.Unlock()
+	re, ok := patternCache[pattern]
+	if !ok {
+		re, _ = regexp.Compile(pattern)
+		patternCache[pattern] = re
+	}
+	return re
+}
+
+func joinField(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// closestName returns the candidate within edit distance 2 of name (the closest one), or "".
+func closestName(name string, candidates []string) string {
+	best, bestDistance := "", 3
+	sort.Strings(candidates)
+	for _, candidate := range candidates {
+		if d := editDistance(name, candidate); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,143 @@
+package openapi_schema
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+func validationIssues(t *testing.T, err error) map[string]string {
+	t.Helper()
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected *ValidationError, got %v", err)
+	}
+	issues := map[string]string{}
+	for _, issue := range verr.Issues {
+		issues[issue.In+"."+issue.Field] = issue.Message
+	}
+	return issues
+}
+
+func TestMatchOperationPath(t *testing.T) {
+	tests := map[string]string{
+		"views":      "/views/",
+		"/views/":    "/views/",
+		"views/5":    "/views/{id}/",
+		"/views/5/?": "/views/{id}/",
+	}
+	for path, want := range tests {
+		if got, err := MatchOperationPath("GET", path); err != nil || got != want {
+			t.Errorf("MatchOperationPath(%q) = %q, %v; want %q", path, got, err, want)
+		}
+	}
+	if _, err := MatchOperationPath("GET", "no/such/path"); !errors.Is(err, ErrOperationNotFound) {
+		t.Errorf("expected ErrOperationNotFound, got %v", err)
+	}
+	if _, err := MatchOperationPath("POST", "views/5"); !errors.Is(err, ErrOperationNotFound) {
+		t.Errorf("expected ErrOperationNotFound for undeclared method, got %v", err)
+	}
+}
+
+func TestValidateRequest_Body(t *testing.T) {
+	err := ValidateRequest("POST", "views", map[string]any{
+		"nme":           "v1",
+		"policy_id":     1,
+		"protocols":     []string{"NFS", "FTP"},
+		"abe_max_depth": "3",
+		"share_acl":     map[string]any{"enabled": 1},
+	})
+	issues := validationIssues(t, err)
+	want := map[string]string{
+		"body.nme":               `unknown field (did you mean "name"?)`,
+		"body.path":              "required field is missing",
+		"body.abe_max_depth":     "expected integer, got string",
+		"body.share_acl.enabled": "expected boolean, got number",
+	}
+	for field, message := range want {
+		if issues[field] != message {
+			t.Errorf("%s = %q, want %q", field, issues[field], message)
+		}
+	}
+	if !strings.HasPrefix(issues["body.protocols[1]"], "must be one of [NFS") {
+		t.Errorf("enum issue = %q", issues["body.protocols[1]"])
+	}
+	if len(issues) != 5 {
+		t.Errorf("issues = %v", issues)
+	}
+	if msg := err.Error(); !strings.HasPrefix(msg, "POST /views/: 5 validation error(s)") {
+		t.Errorf("unexpected message %q", msg)
+	}
+
+	valid := map[string]any{"name": "v1", "path": "/v1", "policy_id": 1, "protocols": []any{"NFS"}, "abe_max_depth": 3.0}
+	if err := ValidateRequest("POST", "/views/", valid); err != nil {
+		t.Errorf("valid body rejected: %v", err)
+	}
+	// PATCH bodies are partial: required fields are not enforced.
+	if err := ValidateRequest("PATCH", "views/5", map[string]any{"name": "v2", "path": nil}); err != nil {
+		t.Errorf("valid partial body rejected: %v", err)
+	}
+}
+
+func TestValidateRequest_Query(t *testing.T) {
+	err := ValidateRequest("GET", "views", map[string]any{
+		"name__icontains": "prod",
+		"nmae":            "v1",
+		"page_size":       10,
+		"tenant_id":       "abc",
+	})
+	issues := validationIssues(t, err)
+	if len(issues) != 2 ||
+		issues["query.nmae"] != `unknown parameter (did you mean "name"?)` ||
+		issues["query.tenant_id"] != "expected integer, got string" {
+		t.Errorf("issues = %v", issues)
+	}
+	if err := ValidateRequest("GET", "views", map[string]any{"tenant_id": "5", "name": "v1"}); err != nil {
+		t.Errorf("valid query rejected: %v", err)
+	}
+}
+
+func TestValidateValue_Bounds(t *testing.T) {
+	minimum, maximum, maxLength, maxItems := 1.0, 10.0, uint64(3), uint64(1)
+	v := &validator{in: "body"}
+	schema := openapi3.NewIntegerSchema()
+	schema.Min, schema.Max, schema.ExclusiveMax = &minimum, &maximum, true
+	v.validateValue("n", 0, schema)
+	v.validateValue("m", 10, schema)
+	v.validateValue("f", 2.5, schema)
+	str := openapi3.NewStringSchema()
+	str.MaxLength, str.Pattern = &maxLength, "^[a-z]+$"
+	v.validateValue("s", "abcd1", str)
+	arr := openapi3.NewArraySchema()
+	arr.MaxItems = &maxItems
+	v.validateValue("a", []any{1, 2}, arr)
+
+	issues := map[string]string{}
+	for _, issue := range v.issues {
+		issues[issue.Field] += issue.Message + ";"
+	}
+	want := map[string]string{
+		"n": "must be >= 1;",
+		"m": "must be < 10;",
+		"f": "expected integer, got 2.5;",
+		"s": `must be at most 3 characters long;must match pattern "^[a-z]+$";`,
+		"a": "must have at most 1 items, got 2;",
+	}
+	for field, message := range want {
+		if issues[field] != message {
+			t.Errorf("%s = %q, want %q", field, issues[field], message)
+		}
+	}
+}
+
+func TestClosestName(t *testing.T) {
+	candidates := []string{"name", "path", "tenant_id"}
+	if got := closestName("tenantid", candidates); got != "tenant_id" {
+		t.Errorf("closestName = %q", got)
+	}
+	if got := closestName("completely_different", candidates); got != "" {
+		t.Errorf("closestName = %q", got)
+	}
+}
@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: LIST|READ|DELETE
+// Template: LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -48,6 +49,23 @@ type AlarmSearchParams struct {
 	RawData core.Params `json:"-" yaml:"-"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// AlarmUpdateBody represents the request body for Alarm Update operations
+// Generated from PATCH request body for resource: /alarms/{id}/
+type AlarmUpdateBody struct {
+	Acknowledged bool `json:"acknowledged,omitempty" yaml:"acknowledged,omitempty" required:"false" doc:"Set to true to acknowledge the specified alarm"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -140,6 +158,44 @@ func (r *Alarm) GetByIdWithContext(ctx context.Context, id any) (*AlarmDetailsMo
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing alarm by ID and returns its details
+// summary: Acknowledge Alarm
+func (r *Alarm) Update(id any, req *AlarmUpdateBody) (*AlarmDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing alarm by ID and returns its details using provided context
+// summary: Acknowledge Alarm
+func (r *Alarm) UpdateWithContext(ctx context.Context, id any, req *AlarmUpdateBody) (*AlarmDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response AlarmDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -208,4 +264,3 @@ func (r *Alarm) MustExistsWithContext(ctx context.Context, req *AlarmSearchParam
 // GENERATION ISSUES
 // -----------------------------------------------------
 //   - Extra method PATCH /alarms/clear/ skipped: PATCH /alarms/clear/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /alarms/clear/
-//   - UPDATE operation excluded: PATCH/PUT /alarms/{id}/ has no response schema and doesn't return 204 NO CONTENT
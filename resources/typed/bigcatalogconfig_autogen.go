@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: LIST|READ|DELETE
+// Template: LIST|READ|UPDATE|DELETE
 
 package typed
 
@@ -48,6 +48,24 @@ type BigCatalogConfigSearchParams struct {
 	RawData core.Params `json:"-" yaml:"-"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// BigCatalogConfigUpdateBody represents the request body for BigCatalogConfig Update operations
+// Generated from PATCH request body for resource: /bigcatalogconfig/{id}/
+type BigCatalogConfigUpdateBody struct {
+	Enable bool  `json:"enable,omitempty" yaml:"enable,omitempty" required:"false" doc:"Enablement status of VAST Catalog"`
+	Splits int64 `json:"splits,omitempty" yaml:"splits,omitempty" required:"false" doc:"The number of splits used for query_data scanning speed"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -140,6 +158,44 @@ func (r *BigCatalogConfig) GetByIdWithContext(ctx context.Context, id any) (*Big
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing bigcatalogconfig by ID and returns its details
+// summary: Modify VAST Catalog Configuration
+func (r *BigCatalogConfig) Update(id any, req *BigCatalogConfigUpdateBody) (*BigCatalogConfigDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing bigcatalogconfig by ID and returns its details using provided context
+// summary: Modify VAST Catalog Configuration
+func (r *BigCatalogConfig) UpdateWithContext(ctx context.Context, id any, req *BigCatalogConfigUpdateBody) (*BigCatalogConfigDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response BigCatalogConfigDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -414,4 +470,3 @@ func (r *BigCatalogConfig) BigCatalogConfigStats_GET() (*BigCatalogConfigStats_G
 // GENERATION ISSUES
 // -----------------------------------------------------
 //   - RequestBody skipped: Request schema is ambiguous (object with no properties) - POST bigcatalogconfig. CREATE and UPDATE operations excluded
-//   - UPDATE operation excluded: PATCH/PUT /bigcatalogconfig/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: LIST|READ
+// Template: LIST|READ|UPDATE
 
 package typed
 
@@ -45,6 +45,56 @@ type CallhomeConfigsSearchParams struct {
 	RawData core.Params `json:"-" yaml:"-"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// CallhomeConfigsUpdateBody represents the request body for CallhomeConfigs Update operations
+// Generated from PATCH request body for resource: /callhomeconfigs/{id}/
+type CallhomeConfigsUpdateBody struct {
+	Aggregated           bool   `json:"aggregated,omitempty" yaml:"aggregated,omitempty" required:"false" doc:"If true, send aggregated callhome logs, otherwise upload logs from each node"`
+	AltS3HostPort        string `json:"alt_s3_host_port,omitempty" yaml:"alt_s3_host_port,omitempty" required:"false" doc:"Specifies alternative S3 service host and port for upload. For example: 10.10.10.10:443."`
+	AwsS3Ak              string `json:"aws_s3_ak,omitempty" yaml:"aws_s3_ak,omitempty" required:"false" doc:"S3 Bucket access key"`
+	AwsS3BucketName      string `json:"aws_s3_bucket_name,omitempty" yaml:"aws_s3_bucket_name,omitempty" required:"false" doc:"S3 Bucket for upload"`
+	AwsS3BucketSubdir    string `json:"aws_s3_bucket_subdir,omitempty" yaml:"aws_s3_bucket_subdir,omitempty" required:"false" doc:"Sub-Directory in support bucket"`
+	AwsS3Sk              string `json:"aws_s3_sk,omitempty" yaml:"aws_s3_sk,omitempty" required:"false" doc:"S3 Bucket secret key"`
+	BundleEnabled        bool   `json:"bundle_enabled,omitempty" yaml:"bundle_enabled,omitempty" required:"false" doc:"Set to true to enable periodic sending of bundles to the Support server (deprecated)"`
+	BundleInterval       int64  `json:"bundle_interval,omitempty" yaml:"bundle_interval,omitempty" required:"false" doc:"The frequency for sending bundles to the Support server (deprecated)"`
+	CloudApiDomain       string `json:"cloud_api_domain,omitempty" yaml:"cloud_api_domain,omitempty" required:"false" doc:"Uplink API domain name"`
+	CloudApiKey          string `json:"cloud_api_key,omitempty" yaml:"cloud_api_key,omitempty" required:"false" doc:"Uplink API key"`
+	CloudEnabled         bool   `json:"cloud_enabled,omitempty" yaml:"cloud_enabled,omitempty" required:"false" doc:"Set to true to enable reporting to Uplink, VAST's cloud-based SaaS that enables you to manage all VAST Clusters deployed by your organization anywhere in the world through a single cloud portal."`
+	CloudSubdomain       string `json:"cloud_subdomain,omitempty" yaml:"cloud_subdomain,omitempty" required:"false" doc:"Uplink subdomain, unique per customer and shared by all clusters reporting to the customer's Uplink."`
+	CompressMethod       string `json:"compress_method,omitempty" yaml:"compress_method,omitempty" required:"false" doc:"Compression method for callhome bundles (by default zstd)"`
+	Customer             string `json:"customer,omitempty" yaml:"customer,omitempty" required:"false" doc:"Company name"`
+	CustomerId           string `json:"customer_id,omitempty" yaml:"customer_id,omitempty" required:"false" doc:"The ID issued to the customer"`
+	Location             string `json:"location,omitempty" yaml:"location,omitempty" required:"false" doc:"Site location"`
+	LogEnabled           bool   `json:"log_enabled,omitempty" yaml:"log_enabled,omitempty" required:"false" doc:"Set to true to enable system state data to be logged to the Support server"`
+	LogInterval          int64  `json:"log_interval,omitempty" yaml:"log_interval,omitempty" required:"false" doc:"The frequency for sending system state data to the Support server."`
+	LunaAnalyzePeriod    int64  `json:"luna_analyze_period,omitempty" yaml:"luna_analyze_period,omitempty" required:"false" doc:"Analyzed period size, minutes"`
+	LunaOnAlarmEnabled   bool   `json:"luna_on_alarm_enabled,omitempty" yaml:"luna_on_alarm_enabled,omitempty" required:"false" doc:"Enabled/disabled luna analyzing tool on specific alarms"`
+	LunaOnAlarmInterval  int64  `json:"luna_on_alarm_interval,omitempty" yaml:"luna_on_alarm_interval,omitempty" required:"false" doc:"Maximum allowed frequency in hours for alarm-triggered luna"`
+	MaxUploadBandwidth   int64  `json:"max_upload_bandwidth,omitempty" yaml:"max_upload_bandwidth,omitempty" required:"false" doc:"The maximum upload bandwidth in bytes."`
+	MaxUploadConcurrency int64  `json:"max_upload_concurrency,omitempty" yaml:"max_upload_concurrency,omitempty" required:"false" doc:"The maximum number of parts of a file to upload simultaneously."`
+	Obfuscated           bool   `json:"obfuscated,omitempty" yaml:"obfuscated,omitempty" required:"false" doc:"If true, call home data is obfuscated."`
+	ProxyHost            string `json:"proxy_host,omitempty" yaml:"proxy_host,omitempty" required:"false" doc:"Proxy IP/hostname"`
+	ProxyPassword        string `json:"proxy_password,omitempty" yaml:"proxy_password,omitempty" required:"false" doc:"Proxy password"`
+	ProxyPort            string `json:"proxy_port,omitempty" yaml:"proxy_port,omitempty" required:"false" doc:"Proxy Port"`
+	ProxyScheme          string `json:"proxy_scheme,omitempty" yaml:"proxy_scheme,omitempty" required:"false" doc:""`
+	ProxyUsername        string `json:"proxy_username,omitempty" yaml:"proxy_username,omitempty" required:"false" doc:"Proxy username"`
+	Site                 string `json:"site,omitempty" yaml:"site,omitempty" required:"false" doc:"Site name"`
+	SupportChannel       bool   `json:"support_channel,omitempty" yaml:"support_channel,omitempty" required:"false" doc:"Set to true to enable the VAST Support channel."`
+	TestMode             bool   `json:"test_mode,omitempty" yaml:"test_mode,omitempty" required:"false" doc:"Set to true to enable test mode"`
+	UploadViaVms         bool   `json:"upload_via_vms,omitempty" yaml:"upload_via_vms,omitempty" required:"false" doc:"If true, upload non-aggregated Callhome Bundle via VMS (requires proxy). Otherwise, upload from each node."`
+	VerifySsl            bool   `json:"verify_ssl,omitempty" yaml:"verify_ssl,omitempty" required:"false" doc:"Set to true to enable SSL verification. Set to false to disable. VAST Cluster recognizes SSL certificates from a large range of widely recognized certificate authorities (CAs). VAST Cluster may not recognize an SSL certificate signed by your own in-house CA."`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -137,6 +187,44 @@ func (r *CallhomeConfigs) GetByIdWithContext(ctx context.Context, id any) (*Call
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing callhomeconfigs by ID and returns its details
+// summary: Modify the Call Home Configuration
+func (r *CallhomeConfigs) Update(id any, req *CallhomeConfigsUpdateBody) (*CallhomeConfigsDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing callhomeconfigs by ID and returns its details using provided context
+// summary: Modify the Call Home Configuration
+func (r *CallhomeConfigs) UpdateWithContext(ctx context.Context, id any, req *CallhomeConfigsUpdateBody) (*CallhomeConfigsDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response CallhomeConfigsDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // Exists checks if a callhomeconfigs exists
 func (r *CallhomeConfigs) Exists(req *CallhomeConfigsSearchParams) (bool, error) {
 	return r.ExistsWithContext(r.Untyped.GetCtx(), req)
@@ -254,4 +342,3 @@ func (r *CallhomeConfigs) CallhomeConfigsSend_PATCH(id any, waitTimeout time.Dur
 // GENERATION ISSUES
 // -----------------------------------------------------
 //   - CREATE operation excluded: POST callhomeconfigs has no response schema and doesn't return 204 NO CONTENT
-//   - UPDATE operation excluded: PATCH/PUT /callhomeconfigs/{id}/ has no response schema and doesn't return 204 NO CONTENT
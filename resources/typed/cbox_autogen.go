@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: LIST|READ|DELETE
+// Template: LIST|READ|UPDATE|DELETE
 
 package typed
 
@@ -50,6 +50,23 @@ type CboxSearchParams struct {
 	RawData core.Params `json:"-" yaml:"-"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// CboxUpdateBody represents the request body for Cbox Update operations
+// Generated from PATCH request body for resource: /cboxes/{id}/
+type CboxUpdateBody struct {
+	Description string `json:"description,omitempty" yaml:"description,omitempty" required:"false" doc:"CBox description"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -142,6 +159,44 @@ func (r *Cbox) GetByIdWithContext(ctx context.Context, id any) (*CboxDetailsMode
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing cbox by ID and returns its details
+// summary: Modify CBox
+func (r *Cbox) Update(id any, req *CboxUpdateBody) (*CboxDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing cbox by ID and returns its details using provided context
+// summary: Modify CBox
+func (r *Cbox) UpdateWithContext(ctx context.Context, id any, req *CboxUpdateBody) (*CboxDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response CboxDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -253,4 +308,3 @@ func (r *Cbox) CboxControlLed_PATCH(id any, control string, waitTimeout time.Dur
 // -----------------------------------------------------
 //   - CREATE operation excluded: POST cboxes has no response schema and doesn't return 204 NO CONTENT
 //   - Extra method PATCH /cboxes/{id}/refresh_uid/ skipped: PATCH /cboxes/{id}/refresh_uid/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /cboxes/{id}/refresh_uid/
-//   - UPDATE operation excluded: PATCH/PUT /cboxes/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
@@ -58,6 +58,27 @@ type CertificateRequestBody struct {
 	PrivateKey    string `json:"private_key,omitempty" yaml:"private_key,omitempty" required:"false" doc:""`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// CertificateUpdateBody represents the request body for Certificate Update operations
+// Generated from PATCH request body for resource: /certificates/{id}/
+type CertificateUpdateBody struct {
+	CaCertificate string `json:"ca_certificate,omitempty" yaml:"ca_certificate,omitempty" required:"false" doc:""`
+	CertType      string `json:"cert_type,omitempty" yaml:"cert_type,omitempty" required:"false" doc:"Certificate type"`
+	Certificate   string `json:"certificate,omitempty" yaml:"certificate,omitempty" required:"false" doc:""`
+	Name          string `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Certificate name"`
+	PrivateKey    string `json:"private_key,omitempty" yaml:"private_key,omitempty" required:"false" doc:""`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -183,6 +204,42 @@ func (r *Certificate) CreateWithContext(ctx context.Context, req *CertificateReq
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing certificate by ID and returns its details
+func (r *Certificate) Update(id any, req *CertificateUpdateBody) (*CertificateDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing certificate by ID and returns its details using provided context
+func (r *Certificate) UpdateWithContext(ctx context.Context, id any, req *CertificateUpdateBody) (*CertificateDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response CertificateDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -332,8 +389,3 @@ func (r *Certificate) CertificateValidateComputeClusterCertificatesWithContext_P
 func (r *Certificate) CertificateValidateComputeClusterCertificates_POST(intermediateCertificate string, intermediateKey string, rootCertificate string) (*CertificateValidateComputeClusterCertificates_POST_Model, error) {
 	return r.CertificateValidateComputeClusterCertificatesWithContext_POST(r.Untyped.GetCtx(), intermediateCertificate, intermediateKey, rootCertificate)
 }
-
-// -----------------------------------------------------
-// GENERATION ISSUES
-// -----------------------------------------------------
-//   - UPDATE operation excluded: PATCH/PUT /certificates/{id}/ has no response schema and doesn't return 204 NO CONTENT
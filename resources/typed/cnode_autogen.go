@@ -28,14 +28,14 @@ type Cnode struct {
 
 // CnodeSearchParams represents the search parameters for Cnode operations
 type CnodeSearchParams struct {
-	ClusterId   expr.IntField `json:"cluster_id,omitempty" yaml:"cluster_id,omitempty" required:"false" doc:""`
-	ClusterName expr.StrField `json:"cluster_name,omitempty" yaml:"cluster_name,omitempty" required:"false" doc:""`
-	Enabled     bool          `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"Return only enabled CNodes"`
-	Guid        expr.StrField `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:"Global unique ID"`
-	Ip          expr.StrField `json:"ip,omitempty" yaml:"ip,omitempty" required:"false" doc:"Filter by CNode IP"`
-	Name        expr.StrField `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Filter by CNode name"`
-	State       expr.StrField `json:"state,omitempty" yaml:"state,omitempty" required:"false" doc:"Filter by state"`
-	VippoolId   expr.IntField `json:"vippool_id,omitempty" yaml:"vippool_id,omitempty" required:"false" doc:""`
+	ClusterId   expr.IntField  `json:"cluster_id,omitempty" yaml:"cluster_id,omitempty" required:"false" doc:""`
+	ClusterName expr.StrField  `json:"cluster_name,omitempty" yaml:"cluster_name,omitempty" required:"false" doc:""`
+	Enabled     expr.BoolField `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"Return only enabled CNodes"`
+	Guid        expr.StrField  `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:"Global unique ID"`
+	Ip          expr.StrField  `json:"ip,omitempty" yaml:"ip,omitempty" required:"false" doc:"Filter by CNode IP"`
+	Name        expr.StrField  `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Filter by CNode name"`
+	State       expr.StrField  `json:"state,omitempty" yaml:"state,omitempty" required:"false" doc:"Filter by state"`
+	VippoolId   expr.IntField  `json:"vippool_id,omitempty" yaml:"vippool_id,omitempty" required:"false" doc:""`
 
 	// RawData allows passing arbitrary search parameters as key-value pairs.
 	//
@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: LIST|READ|DELETE
+// Template: LIST|READ|UPDATE|DELETE
 
 package typed
 
@@ -50,6 +50,27 @@ type DboxSearchParams struct {
 	RawData core.Params `json:"-" yaml:"-"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// DboxUpdateBody represents the request body for Dbox Update operations
+// Generated from PATCH request body for resource: /dboxes/{id}/
+type DboxUpdateBody struct {
+	Conclude    bool   `json:"conclude,omitempty" yaml:"conclude,omitempty" required:"false" doc:"True to conclude replacement"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty" required:"false" doc:"DBox description"`
+	Force       bool   `json:"force,omitempty" yaml:"force,omitempty" required:"false" doc:"In case of concluding: do not verify that all devices have been moved. In case of replacing: support moving device while getting to degraded state during replacement"`
+	Name        string `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"The new name of the DBox"`
+	Replace     bool   `json:"replace,omitempty" yaml:"replace,omitempty" required:"false" doc:"True to start replacement"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -142,6 +163,44 @@ func (r *Dbox) GetByIdWithContext(ctx context.Context, id any) (*DboxDetailsMode
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing dbox by ID and returns its details
+// summary: Modify a DBox Description
+func (r *Dbox) Update(id any, req *DboxUpdateBody) (*DboxDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing dbox by ID and returns its details using provided context
+// summary: Modify a DBox Description
+func (r *Dbox) UpdateWithContext(ctx context.Context, id any, req *DboxUpdateBody) (*DboxDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response DboxDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -458,4 +517,3 @@ func (r *Dbox) DboxResume_POST(waitTimeout time.Duration) (*untyped.AsyncResult,
 // GENERATION ISSUES
 // -----------------------------------------------------
 //   - CREATE operation excluded: POST dboxes has no response schema and doesn't return 204 NO CONTENT
-//   - UPDATE operation excluded: PATCH/PUT /dboxes/{id}/ has no response schema and doesn't return 204 NO CONTENT
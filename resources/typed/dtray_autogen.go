@@ -26,10 +26,10 @@ type Dtray struct {
 
 // DtraySearchParams represents the search parameters for Dtray operations
 type DtraySearchParams struct {
-	Enabled bool          `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"Filter by enabled state"`
-	Guid    expr.StrField `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:""`
-	Name    expr.StrField `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Filter list by DTray name"`
-	State   expr.StrField `json:"state,omitempty" yaml:"state,omitempty" required:"false" doc:"Filter list by DTray state"`
+	Enabled expr.BoolField `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"Filter by enabled state"`
+	Guid    expr.StrField  `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:""`
+	Name    expr.StrField  `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Filter list by DTray name"`
+	State   expr.StrField  `json:"state,omitempty" yaml:"state,omitempty" required:"false" doc:"Filter list by DTray state"`
 
 	// RawData allows passing arbitrary search parameters as key-value pairs.
 	//
@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: LIST|READ|DELETE
+// Template: LIST|READ|UPDATE|DELETE
 
 package typed
 
@@ -49,6 +49,28 @@ type EboxSearchParams struct {
 	RawData core.Params `json:"-" yaml:"-"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// EboxUpdateBody represents the request body for Ebox Update operations
+// Generated from PATCH request body for resource: /eboxes/{id}/
+type EboxUpdateBody struct {
+	Enabled           bool   `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"True for activate, False for deactivate"`
+	HostSn            string `json:"host_sn,omitempty" yaml:"host_sn,omitempty" required:"false" doc:"SN of the EBox"`
+	ImmediateShutdown bool   `json:"immediate_shutdown,omitempty" yaml:"immediate_shutdown,omitempty" required:"false" doc:"True to power off an EBox immediately without waiting for phaseout or deactivation"`
+	PowerOff          bool   `json:"power_off,omitempty" yaml:"power_off,omitempty" required:"false" doc:"True to power off an EBox"`
+	PowerOn           bool   `json:"power_on,omitempty" yaml:"power_on,omitempty" required:"false" doc:"True to power on an EBox"`
+	Replace           bool   `json:"replace,omitempty" yaml:"replace,omitempty" required:"false" doc:"True to start replacement"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -141,6 +163,44 @@ func (r *Ebox) GetByIdWithContext(ctx context.Context, id any) (*EboxDetailsMode
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing ebox by ID and returns its details
+// summary: Modify an EBox
+func (r *Ebox) Update(id any, req *EboxUpdateBody) (*EboxDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing ebox by ID and returns its details using provided context
+// summary: Modify an EBox
+func (r *Ebox) UpdateWithContext(ctx context.Context, id any, req *EboxUpdateBody) (*EboxDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response EboxDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -420,4 +480,3 @@ func (r *Ebox) EboxResume_POST(waitTimeout time.Duration) (*untyped.AsyncResult,
 // GENERATION ISSUES
 // -----------------------------------------------------
 //   - CREATE operation excluded: POST eboxes has no response schema and doesn't return 204 NO CONTENT
-//   - UPDATE operation excluded: PATCH/PUT /eboxes/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: LIST|READ|DELETE
+// Template: LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -46,6 +47,23 @@ type EncryptedPathSearchParams struct {
 	RawData core.Params `json:"-" yaml:"-"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// EncryptedPathUpdateBody represents the request body for EncryptedPath Update operations
+// Generated from PATCH request body for resource: /encryptedpaths/{id}/
+type EncryptedPathUpdateBody struct {
+	Name string `json:"name,omitempty" yaml:"name,omitempty" required:"true" doc:"The new name for the encrypted path"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -138,6 +156,44 @@ func (r *EncryptedPath) GetByIdWithContext(ctx context.Context, id any) (*Encryp
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing encryptedpath by ID and returns its details
+// summary: Modify Encrypted Path Name
+func (r *EncryptedPath) Update(id any, req *EncryptedPathUpdateBody) (*EncryptedPathDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing encryptedpath by ID and returns its details using provided context
+// summary: Modify Encrypted Path Name
+func (r *EncryptedPath) UpdateWithContext(ctx context.Context, id any, req *EncryptedPathUpdateBody) (*EncryptedPathDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response EncryptedPathDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
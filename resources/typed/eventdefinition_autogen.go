@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: LIST|READ
+// Template: LIST|READ|UPDATE
 
 package typed
 
@@ -49,6 +49,34 @@ type EventDefinitionSearchParams struct {
 	RawData core.Params `json:"-" yaml:"-"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// EventDefinitionUpdateBody represents the request body for EventDefinition Update operations
+// Generated from PATCH request body for resource: /eventdefinitions/{id}/
+type EventDefinitionUpdateBody struct {
+	AlarmOnly       bool      `json:"alarm_only,omitempty" yaml:"alarm_only,omitempty" required:"false" doc:"Set to true for only alarms to trigger configured actions such as email and webhook. Set to false for all events of the definition to trigger the configured actions."`
+	Cooldown        int64     `json:"cooldown,omitempty" yaml:"cooldown,omitempty" required:"false" doc:"Minimal time to wait between two consecutive events"`
+	DisableActions  bool      `json:"disable_actions,omitempty" yaml:"disable_actions,omitempty" required:"false" doc:"Set to true to disable alert actions for the event definition."`
+	EmailRecipients *[]string `json:"email_recipients,omitempty" yaml:"email_recipients,omitempty" required:"false" doc:"Comma separated list of email recipients for alarms"`
+	Enabled         bool      `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"Set to true to enable events, alarms and actions."`
+	Internal        bool      `json:"internal,omitempty" yaml:"internal,omitempty" required:"false" doc:""`
+	RaiseAtCount    int64     `json:"raise_at_count,omitempty" yaml:"raise_at_count,omitempty" required:"false" doc:"Raise an alarm after a specific number of recurrences"`
+	Severity        string    `json:"severity,omitempty" yaml:"severity,omitempty" required:"false" doc:"The severity of an alarm triggered by this event. INFO means no alarm is triggered."`
+	TimeFrame       string    `json:"time_frame,omitempty" yaml:"time_frame,omitempty" required:"false" doc:"For rate alarms, the The time frame over which to monitor the property."`
+	TriggerOff      string    `json:"trigger_off,omitempty" yaml:"trigger_off,omitempty" required:"false" doc:"For 'Object Modified' alarms: a list of values."`
+	TriggerOn       string    `json:"trigger_on,omitempty" yaml:"trigger_on,omitempty" required:"false" doc:"For 'Object Modified' alarms: a list of values | For 'Threshold/Rate' alarms: a list of 2 members. The first is an operator like gt/ge/lte and the second is a number"`
+	Webhooks        *[]int64  `json:"webhooks,omitempty" yaml:"webhooks,omitempty" required:"false" doc:"List of webhooks IDs"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -141,6 +169,44 @@ func (r *EventDefinition) GetByIdWithContext(ctx context.Context, id any) (*Even
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing eventdefinition by ID and returns its details
+// summary: Modify Event Definition
+func (r *EventDefinition) Update(id any, req *EventDefinitionUpdateBody) (*EventDefinitionDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing eventdefinition by ID and returns its details using provided context
+// summary: Modify Event Definition
+func (r *EventDefinition) UpdateWithContext(ctx context.Context, id any, req *EventDefinitionUpdateBody) (*EventDefinitionDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response EventDefinitionDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // Exists checks if a eventdefinition exists
 func (r *EventDefinition) Exists(req *EventDefinitionSearchParams) (bool, error) {
 	return r.ExistsWithContext(r.Untyped.GetCtx(), req)
@@ -210,4 +276,3 @@ func (r *EventDefinition) EventDefinitionTest_PATCH(id any, waitTimeout time.Dur
 // GENERATION ISSUES
 // -----------------------------------------------------
 //   - CREATE operation excluded: POST eventdefinitions has no response schema and doesn't return 204 NO CONTENT
-//   - UPDATE operation excluded: PATCH/PUT /eventdefinitions/{id}/ has no response schema and doesn't return 204 NO CONTENT
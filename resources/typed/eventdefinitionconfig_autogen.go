@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: LIST|READ
+// Template: LIST|READ|UPDATE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 )
@@ -42,6 +43,48 @@ type EventDefinitionConfigSearchParams struct {
 	RawData core.Params `json:"-" yaml:"-"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// EventDefinitionConfigUpdateBody represents the request body for EventDefinitionConfig Update operations
+// Generated from PATCH request body for resource: /eventdefinitionconfigs/{id}/
+type EventDefinitionConfigUpdateBody struct {
+	AuditLogsRetention    int64     `json:"audit_logs_retention,omitempty" yaml:"audit_logs_retention,omitempty" required:"false" doc:"Audit logs retention in days"`
+	CriticalValue         string    `json:"critical_value,omitempty" yaml:"critical_value,omitempty" required:"false" doc:"Maps CRITICAL severity to a different value. Default: CRITICAL"`
+	DisableActions        bool      `json:"disable_actions,omitempty" yaml:"disable_actions,omitempty" required:"false" doc:"Set to true to disable default actions for events."`
+	EmailRecipients       *[]string `json:"email_recipients,omitempty" yaml:"email_recipients,omitempty" required:"false" doc:"Default email recipients. These recipients receive notifications of all alarms except those triggered by events that have a different list of email recipients specified in the event definition or for which actions are disabled."`
+	EmailSender           string    `json:"email_sender,omitempty" yaml:"email_sender,omitempty" required:"false" doc:"Global for all alarm notification emails, the sender email that appears in the emails."`
+	EmailSubject          string    `json:"email_subject,omitempty" yaml:"email_subject,omitempty" required:"false" doc:"Optional and global email subject for all alarm notification emails. Leave blank to send alarm info in the subject."`
+	Enabled               bool      `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:""`
+	InfoValue             string    `json:"info_value,omitempty" yaml:"info_value,omitempty" required:"false" doc:"Maps INFO severity to a different severity value. Default: INFO"`
+	MajorValue            string    `json:"major_value,omitempty" yaml:"major_value,omitempty" required:"false" doc:"Maps MAJOR severity to a different severity value. Default: MAJOR"`
+	MinorValue            string    `json:"minor_value,omitempty" yaml:"minor_value,omitempty" required:"false" doc:"Maps MINOR severity to a different severity value. Default: MINOR"`
+	QuotaEmailHourlyLimit int64     `json:"quota_email_hourly_limit,omitempty" yaml:"quota_email_hourly_limit,omitempty" required:"false" doc:"Maximum quota alert emails VMS will send per hour"`
+	QuotaEmailInterval    string    `json:"quota_email_interval,omitempty" yaml:"quota_email_interval,omitempty" required:"false" doc:"The minimal interval time between quota alert emails sent to a user."`
+	QuotaEmailProvider    string    `json:"quota_email_provider,omitempty" yaml:"quota_email_provider,omitempty" required:"false" doc:"Specify which query context should be used to query providers for user quota alert emails. 'Aggregated' will perform an aggregated query of all providers. Alternatively, you can specify a specific provider if connected to the cluster."`
+	QuotaEmailSuffix      string    `json:"quota_email_suffix,omitempty" yaml:"quota_email_suffix,omitempty" required:"false" doc:"A default suffix to add to append to user names to form an email address. This is used as the email recipient address for sending a user user quota alert emails. It is only used if an email address is not found for the user on a provider."`
+	SmtpHost              string    `json:"smtp_host,omitempty" yaml:"smtp_host,omitempty" required:"false" doc:"SMTP server host name for alert emails."`
+	SmtpPassword          string    `json:"smtp_password,omitempty" yaml:"smtp_password,omitempty" required:"false" doc:"Password for SMTP authentication"`
+	SmtpPort              string    `json:"smtp_port,omitempty" yaml:"smtp_port,omitempty" required:"false" doc:"The port used by the SMTP server to send outgoing emails."`
+	SmtpUseTls            bool      `json:"smtp_use_tls,omitempty" yaml:"smtp_use_tls,omitempty" required:"false" doc:"Set to true to send email over a TLS connection."`
+	SmtpUser              string    `json:"smtp_user,omitempty" yaml:"smtp_user,omitempty" required:"false" doc:"User for SMTP authentication"`
+	SyslogHost            string    `json:"syslog_host,omitempty" yaml:"syslog_host,omitempty" required:"false" doc:"The syslog server's IP address, for sending event logs to a syslog server."`
+	SyslogIpmiAudit       bool      `json:"syslog_ipmi_audit,omitempty" yaml:"syslog_ipmi_audit,omitempty" required:"false" doc:"CNode and DNode IPMI commands"`
+	SyslogPort            int64     `json:"syslog_port,omitempty" yaml:"syslog_port,omitempty" required:"false" doc:"The port number used by the syslog server to listen on for syslog requests."`
+	SyslogProtocol        string    `json:"syslog_protocol,omitempty" yaml:"syslog_protocol,omitempty" required:"false" doc:"The protocol used for communicating with the remote syslog server."`
+	SyslogSecureAudit     bool      `json:"syslog_secure_audit,omitempty" yaml:"syslog_secure_audit,omitempty" required:"false" doc:"/var/log/secure logs audit"`
+	SyslogShellAudit      bool      `json:"syslog_shell_audit,omitempty" yaml:"syslog_shell_audit,omitempty" required:"false" doc:"CNode and DNode shell commands"`
+	SyslogVmsAudit        bool      `json:"syslog_vms_audit,omitempty" yaml:"syslog_vms_audit,omitempty" required:"false" doc:"VMS audit"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -134,6 +177,44 @@ func (r *EventDefinitionConfig) GetByIdWithContext(ctx context.Context, id any)
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing eventdefinitionconfig by ID and returns its details
+// summary: Modify Event Definition Configuration
+func (r *EventDefinitionConfig) Update(id any, req *EventDefinitionConfigUpdateBody) (*EventDefinitionConfigDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing eventdefinitionconfig by ID and returns its details using provided context
+// summary: Modify Event Definition Configuration
+func (r *EventDefinitionConfig) UpdateWithContext(ctx context.Context, id any, req *EventDefinitionConfigUpdateBody) (*EventDefinitionConfigDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response EventDefinitionConfigDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // Exists checks if a eventdefinitionconfig exists
 func (r *EventDefinitionConfig) Exists(req *EventDefinitionConfigSearchParams) (bool, error) {
 	return r.ExistsWithContext(r.Untyped.GetCtx(), req)
@@ -167,4 +248,3 @@ func (r *EventDefinitionConfig) MustExistsWithContext(ctx context.Context, req *
 // -----------------------------------------------------
 //   - CREATE operation excluded: POST eventdefinitionconfigs has no response schema and doesn't return 204 NO CONTENT
 //   - Extra method PATCH /eventdefinitionconfigs/{id}/test/ skipped: PATCH /eventdefinitionconfigs/{id}/test/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /eventdefinitionconfigs/{id}/test/
-//   - UPDATE operation excluded: PATCH/PUT /eventdefinitionconfigs/{id}/ has no response schema and doesn't return 204 NO CONTENT
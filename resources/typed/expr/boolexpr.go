@@ -0,0 +1,25 @@
+package expr
+
+// Bool is a callable factory for boolean search field expressions.
+// Both true and false are sent explicitly:
+//
+//	type ViewSearchParams struct {
+//	    IsDefaultSubsystem expr.BoolField `json:"is_default_subsystem,omitempty"`
+//	}
+//
+//	ViewSearchParams{IsDefaultSubsystem: expr.Bool(true)}    // ?is_default_subsystem=true
+//	ViewSearchParams{IsDefaultSubsystem: expr.Bool.False()}  // ?is_default_subsystem=false
+//	ViewSearchParams{IsDefaultSubsystem: expr.Bool.IsNull()} // ?is_default_subsystem__isnull=true
+var Bool = boolOp(func(v bool) BoolField {
+	return exactField(v)
+})
+
+// boolOp is a named function type so it can both be called (exact match)
+// and carry lookup methods.
+type boolOp func(bool) BoolField
+
+func (boolOp) Exact(v bool) BoolField { return exactField(v) }
+func (boolOp) True() BoolField        { return exactField(true) }
+func (boolOp) False() BoolField       { return exactField(false) }
+func (boolOp) IsNull() BoolField      { return exprField[bool](nullExpr(true)) }
+func (boolOp) NotNull() BoolField     { return exprField[bool](nullExpr(false)) }
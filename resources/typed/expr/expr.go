@@ -1,9 +1,12 @@
 // Package expr provides typed query field expressions for the VMS typed REST API.
 //
-//	expr.Str  — callable factory for string search fields (call = exact match)
-//	expr.Int  — callable factory for integer search fields (call = exact match)
-//	expr.StrField — field type to use in *SearchParams structs for string params
-//	expr.IntField — field type to use in *SearchParams structs for integer params
+//	expr.Str   — callable factory for string search fields (call = exact match)
+//	expr.Int   — callable factory for integer search fields (call = exact match)
+//	expr.Float — callable factory for number search fields (call = exact match)
+//	expr.Bool  — callable factory for boolean search fields (explicit true/false)
+//	expr.Time  — callable factory for date-time search fields (call = exact match)
+//	expr.StrField, expr.IntField, expr.FloatField, expr.BoolField, expr.TimeField —
+//	    field types to use in *SearchParams structs
 //
 // Usage:
 //
@@ -16,9 +19,16 @@
 //	UserSearchParams{Name: expr.Str.StartsWith("sys")}   // ?name__startswith=sys
 //	UserSearchParams{Uid:  expr.Int(42)}                 // ?uid=42
 //	UserSearchParams{Uid:  expr.Int.GT(1000)}            // ?uid__gt=1000
+//	UserSearchParams{Uid:  expr.Int.Range(10, 20)}       // ?uid__gte=10&uid__lte=20
+//	UserSearchParams{Name: expr.Str.IsNull()}            // ?name__isnull=true
 package expr
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+
+	"github.com/vast-data/go-vast-client/core"
+)
 
 // queryExpr is the private interface for all expression types.
 type queryExpr interface {
@@ -26,13 +36,14 @@ type queryExpr interface {
 }
 
 // suffixExpr is the internal implementation used by all Str / Int factory methods.
+// Lookup names and key layout are shared with core (see core.Lookup / core.LookupKey).
 type suffixExpr struct {
-	suffix string
+	suffix core.Lookup
 	value  string
 }
 
 func (e suffixExpr) queryParam(field string) (string, string) {
-	return field + "__" + e.suffix, e.value
+	return core.LookupKey(field, e.suffix), e.value
 }
 
 // not_ wraps any queryExpr and negates it by prepending "not_" to the lookup suffix.
@@ -42,7 +53,46 @@ func (e not_) queryParam(field string) (string, string) {
 	key, val := e.expr.queryParam(field)
 	prefix := field + "__"
 	if after, ok := strings.CutPrefix(key, prefix); ok {
-		return prefix + "not_" + after, val
+		return core.LookupKey(field, core.Lookup(after).Not()), val
+	}
+	return core.LookupKey(field, core.LookupExact.Not()), val
+}
+
+// allOf combines several expressions on the same field into multiple query params
+// (e.g. a range rendered as field__gte=lo&field__lte=hi).
+type allOf []queryExpr
+
+// queryParam returns the first expression only; use queryParams for the full set.
+func (e allOf) queryParam(field string) (string, string) {
+	if len(e) == 0 {
+		return field, ""
+	}
+	return e[0].queryParam(field)
+}
+
+func (e allOf) queryParams(field string) map[string]any {
+	out := make(map[string]any, len(e))
+	for _, sub := range e {
+		k, v := sub.queryParam(field)
+		out[k] = v
 	}
-	return field + "__not_exact", val
+	return out
+}
+
+// multiQueryExpr is implemented by expressions that emit more than one query param.
+type multiQueryExpr interface {
+	queryParams(field string) map[string]any
+}
+
+// rangeExpr renders an inclusive range as __gte / __lte lookups.
+func rangeExpr(lo, hi string) allOf {
+	return allOf{
+		suffixExpr{suffix: core.LookupGTE, value: lo},
+		suffixExpr{suffix: core.LookupLTE, value: hi},
+	}
+}
+
+// nullExpr renders an __isnull lookup.
+func nullExpr(isNull bool) suffixExpr {
+	return suffixExpr{suffix: core.LookupIsNull, value: strconv.FormatBool(isNull)}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -11,11 +12,14 @@ import (
 
 // searchParams mirrors a typical generated *SearchParams struct.
 type searchParams struct {
-	Name     expr.StrField `json:"name,omitempty"`
-	Guid     expr.StrField `json:"guid,omitempty"`
-	ID       expr.IntField `json:"id,omitempty"`
-	TenantID int64         `json:"tenant_id,omitempty"`
-	RawData  core.Params   `json:"-"`
+	Name     expr.StrField   `json:"name,omitempty"`
+	Guid     expr.StrField   `json:"guid,omitempty"`
+	ID       expr.IntField   `json:"id,omitempty"`
+	TenantID int64           `json:"tenant_id,omitempty"`
+	Ratio    expr.FloatField `json:"ratio,omitempty"`
+	Enabled  expr.BoolField  `json:"enabled,omitempty"`
+	Created  expr.TimeField  `json:"created,omitempty"`
+	RawData  core.Params     `json:"-"`
 }
 
 // ---- helpers ----
@@ -157,7 +161,6 @@ func TestNotWrapperExactFallback(t *testing.T) {
 	has(t, p, "name__not_exact", "root")
 }
 
-
 // ---- unset / mixed / query string ----
 
 func TestUnsetFieldsOmitted(t *testing.T) {
@@ -246,3 +249,48 @@ func TestTypedFieldsOnlyNoRawData(t *testing.T) {
 	has(t, p, "id__gt", "100")
 	absent(t, p, "fields")
 }
+
+// ---- expr.Bool / Float / Time / null / range tests ----
+
+func TestBoolExplicitFalse(t *testing.T) {
+	has(t, toParams(t, searchParams{Enabled: expr.Bool(false)}), "enabled", "false")
+	has(t, toParams(t, searchParams{Enabled: expr.Bool.True()}), "enabled", "true")
+	absent(t, toParams(t, searchParams{}), "enabled")
+}
+
+func TestFloatLookups(t *testing.T) {
+	has(t, toParams(t, searchParams{Ratio: expr.Float(0.5)}), "ratio", "0.5")
+	has(t, toParams(t, searchParams{Ratio: expr.Float.GT(1e6)}), "ratio__gt", "1000000")
+	has(t, toParams(t, searchParams{Ratio: expr.Float.NotLTE(0.25)}), "ratio__not_lte", "0.25")
+	p := toParams(t, searchParams{Ratio: expr.Float.Range(0.1, 0.9)})
+	has(t, p, "ratio__gte", "0.1")
+	has(t, p, "ratio__lte", "0.9")
+}
+
+func TestTimeLookups(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	a := time.Date(2025, 1, 2, 5, 4, 5, 0, loc)
+	b := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	has(t, toParams(t, searchParams{Created: expr.Time(a)}), "created", "2025-01-02T03:04:05Z")
+	has(t, toParams(t, searchParams{Created: expr.Time.After(a)}), "created__gte", "2025-01-02T03:04:05Z")
+	has(t, toParams(t, searchParams{Created: expr.Time.Before(b)}), "created__lte", "2025-02-01T00:00:00Z")
+	p := toParams(t, searchParams{Created: expr.Time.Between(a, b)})
+	has(t, p, "created__gte", "2025-01-02T03:04:05Z")
+	has(t, p, "created__lte", "2025-02-01T00:00:00Z")
+	absent(t, p, "created")
+}
+
+func TestIntRange(t *testing.T) {
+	p := toParams(t, searchParams{ID: expr.Int.Range(10, 20)})
+	has(t, p, "id__gte", "10")
+	has(t, p, "id__lte", "20")
+	absent(t, p, "id")
+}
+
+func TestNullLookups(t *testing.T) {
+	has(t, toParams(t, searchParams{Name: expr.Str.IsNull()}), "name__isnull", "true")
+	has(t, toParams(t, searchParams{ID: expr.Int.NotNull()}), "id__isnull", "false")
+	has(t, toParams(t, searchParams{Created: expr.Time.IsNull()}), "created__isnull", "true")
+	has(t, toParams(t, searchParams{Enabled: expr.Bool.NotNull()}), "enabled__isnull", "false")
+	has(t, toParams(t, searchParams{Ratio: expr.Float.IsNull()}), "ratio__isnull", "true")
+}
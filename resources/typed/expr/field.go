@@ -1,6 +1,8 @@
 package expr
 
-// field is the private backing type for StrField and IntField.
+import "time"
+
+// field is the private backing type for StrField, IntField, FloatField, BoolField and TimeField.
 type field[T any] struct {
 	value *T
 	expr  queryExpr
@@ -12,6 +14,9 @@ func exprField[T any](e queryExpr) field[T] { return field[T]{expr: e} }
 func (f field[T]) isSet() bool { return f.value != nil || f.expr != nil }
 
 func (f field[T]) serializeToParam(key string) map[string]any {
+	if m, ok := f.expr.(multiQueryExpr); ok {
+		return m.queryParams(key)
+	}
 	if f.expr != nil {
 		k, v := f.expr.queryParam(key)
 		return map[string]any{k: v}
@@ -34,3 +39,21 @@ type StrField = field[string]
 //	    Uid expr.IntField `json:"uid,omitempty"`
 //	}
 type IntField = field[int64]
+
+// FloatField is the struct field type for number search parameters.
+// Assign values via expr.Float(...) for exact match or expr.Float.X(...) for lookups.
+type FloatField = field[float64]
+
+// BoolField is the struct field type for boolean search parameters.
+// Unlike a plain bool with omitempty, it can express an explicit false:
+//
+//	type ViewPolicySearchParams struct {
+//	    AppleSid expr.BoolField `json:"apple_sid,omitempty"`
+//	}
+//
+//	ViewPolicySearchParams{AppleSid: expr.Bool(false)} // ?apple_sid=false
+type BoolField = field[bool]
+
+// TimeField is the struct field type for date-time search parameters.
+// Assign values via expr.Time(...) for exact match or expr.Time.X(...) for lookups.
+type TimeField = field[time.Time]
@@ -0,0 +1,54 @@
+package expr
+
+import (
+	"strconv"
+
+	"github.com/vast-data/go-vast-client/core"
+)
+
+// Float is a callable factory for number search field expressions.
+// Calling it directly produces an exact-match field; methods produce lookups.
+//
+//	type ClusterSearchParams struct {
+//	    UsageRatio expr.FloatField `json:"usage_ratio,omitempty"`
+//	}
+//
+//	ClusterSearchParams{UsageRatio: expr.Float(0.5)}             // ?usage_ratio=0.5
+//	ClusterSearchParams{UsageRatio: expr.Float.GT(0.8)}          // ?usage_ratio__gt=0.8
+//	ClusterSearchParams{UsageRatio: expr.Float.Range(0.1, 0.9)}  // ?usage_ratio__gte=0.1&usage_ratio__lte=0.9
+var Float = floatOp(func(v float64) FloatField {
+	return ff(core.LookupExact, v)
+})
+
+// floatOp is a named function type so it can both be called (exact match)
+// and carry lookup methods.
+type floatOp func(float64) FloatField
+
+func (floatOp) Exact(v float64) FloatField { return ff(core.LookupExact, v) }
+func (floatOp) GT(v float64) FloatField    { return ff(core.LookupGT, v) }
+func (floatOp) GTE(v float64) FloatField   { return ff(core.LookupGTE, v) }
+func (floatOp) LT(v float64) FloatField    { return ff(core.LookupLT, v) }
+func (floatOp) LTE(v float64) FloatField   { return ff(core.LookupLTE, v) }
+func (floatOp) IsNull() FloatField         { return exprField[float64](nullExpr(true)) }
+func (floatOp) NotNull() FloatField        { return exprField[float64](nullExpr(false)) }
+
+// Range matches lo <= value <= hi.
+func (floatOp) Range(lo, hi float64) FloatField {
+	return exprField[float64](rangeExpr(formatFloat(lo), formatFloat(hi)))
+}
+
+// Negated variants (not_ prefix)
+func (floatOp) NotExact(v float64) FloatField { return ff(core.LookupExact.Not(), v) }
+func (floatOp) NotGT(v float64) FloatField    { return ff(core.LookupGT.Not(), v) }
+func (floatOp) NotGTE(v float64) FloatField   { return ff(core.LookupGTE.Not(), v) }
+func (floatOp) NotLT(v float64) FloatField    { return ff(core.LookupLT.Not(), v) }
+func (floatOp) NotLTE(v float64) FloatField   { return ff(core.LookupLTE.Not(), v) }
+
+func ff(suffix core.Lookup, v float64) FloatField {
+	return exprField[float64](suffixExpr{suffix: suffix, value: formatFloat(v)})
+}
+
+// formatFloat renders v without exponent notation (1e+06 -> 1000000).
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -1,8 +1,9 @@
 package expr
 
 import (
-	"fmt"
-	"strings"
+	"strconv"
+
+	"github.com/vast-data/go-vast-client/core"
 )
 
 // Int is a callable factory for integer search field expressions.
@@ -17,6 +18,7 @@ import (
 //	UserSearchParams{Uid: expr.Int.GTE(1000)}       // ?uid__gte=1000
 //	UserSearchParams{Uid: expr.Int.In(1, 2, 3)}     // ?uid__in=1,2,3  (PK fields only)
 //	UserSearchParams{Uid: expr.Int.NotGTE(9999)}    // ?uid__not_gte=9999
+//	UserSearchParams{Uid: expr.Int.Range(10, 20)}   // ?uid__gte=10&uid__lte=20
 var Int = intOp(func(v int64) IntField {
 	return exactField(v)
 })
@@ -26,30 +28,34 @@ var Int = intOp(func(v int64) IntField {
 type intOp func(int64) IntField
 
 func (intOp) Exact(v int64) IntField { return exactField(v) }
-func (intOp) GT(v int64) IntField    { return inf("gt", v) }
-func (intOp) GTE(v int64) IntField   { return inf("gte", v) }
-func (intOp) LT(v int64) IntField    { return inf("lt", v) }
-func (intOp) LTE(v int64) IntField   { return inf("lte", v) }
+func (intOp) GT(v int64) IntField    { return inf(core.LookupGT, v) }
+func (intOp) GTE(v int64) IntField   { return inf(core.LookupGTE, v) }
+func (intOp) LT(v int64) IntField    { return inf(core.LookupLT, v) }
+func (intOp) LTE(v int64) IntField   { return inf(core.LookupLTE, v) }
+
+// Range matches lo <= value <= hi.
+func (intOp) Range(lo, hi int64) IntField {
+	return exprField[int64](rangeExpr(strconv.FormatInt(lo, 10), strconv.FormatInt(hi, 10)))
+}
+
+func (intOp) IsNull() IntField  { return exprField[int64](nullExpr(true)) }
+func (intOp) NotNull() IntField { return exprField[int64](nullExpr(false)) }
 
 // In is valid for primary-key columns (AutoField / BigAutoField) only.
-func (intOp) In(vs ...int64) IntField { return infSlice("in", vs) }
+func (intOp) In(vs ...int64) IntField { return infSlice(core.LookupIn, vs) }
 
 // Negated variants (not_ prefix)
-func (intOp) NotExact(v int64) IntField  { return inf("not_exact", v) }
-func (intOp) NotGT(v int64) IntField     { return inf("not_gt", v) }
-func (intOp) NotGTE(v int64) IntField    { return inf("not_gte", v) }
-func (intOp) NotLT(v int64) IntField     { return inf("not_lt", v) }
-func (intOp) NotLTE(v int64) IntField    { return inf("not_lte", v) }
-func (intOp) NotIn(vs ...int64) IntField { return infSlice("not_in", vs) }
-
-func inf(suffix string, v int64) IntField {
-	return exprField[int64](suffixExpr{suffix: suffix, value: fmt.Sprintf("%d", v)})
+func (intOp) NotExact(v int64) IntField  { return inf(core.LookupExact.Not(), v) }
+func (intOp) NotGT(v int64) IntField     { return inf(core.LookupGT.Not(), v) }
+func (intOp) NotGTE(v int64) IntField    { return inf(core.LookupGTE.Not(), v) }
+func (intOp) NotLT(v int64) IntField     { return inf(core.LookupLT.Not(), v) }
+func (intOp) NotLTE(v int64) IntField    { return inf(core.LookupLTE.Not(), v) }
+func (intOp) NotIn(vs ...int64) IntField { return infSlice(core.LookupIn.Not(), vs) }
+
+func inf(suffix core.Lookup, v int64) IntField {
+	return exprField[int64](suffixExpr{suffix: suffix, value: strconv.FormatInt(v, 10)})
 }
 
-func infSlice(suffix string, vs []int64) IntField {
-	parts := make([]string, len(vs))
-	for i, v := range vs {
-		parts[i] = fmt.Sprintf("%d", v)
-	}
-	return exprField[int64](suffixExpr{suffix: suffix, value: strings.Join(parts, ",")})
+func infSlice(suffix core.Lookup, vs []int64) IntField {
+	return exprField[int64](suffixExpr{suffix: suffix, value: core.LookupValue(vs)})
 }
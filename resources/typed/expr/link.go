@@ -10,7 +10,7 @@ import _ "unsafe"
 var coreExprSerializeField func(v any, key string) (map[string]any, bool) = serializeFieldParam
 
 // fieldSerializer is the private interface satisfied by the internal field[T] type
-// (backing StrField and IntField). Never exported — method names stay unexported.
+// (backing StrField, IntField, FloatField, BoolField and TimeField). Never exported — method names stay unexported.
 type fieldSerializer interface {
 	isSet() bool
 	serializeToParam(key string) map[string]any
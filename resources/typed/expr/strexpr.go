@@ -1,6 +1,6 @@
 package expr
 
-import "strings"
+import "github.com/vast-data/go-vast-client/core"
 
 // Str is a callable factory for string search field expressions.
 // Calling it directly produces an exact-match field; methods produce lookups.
@@ -24,26 +24,28 @@ var Str = strOp(func(v string) StrField {
 type strOp func(string) StrField
 
 func (strOp) Exact(v string) StrField      { return exactField(v) }
-func (strOp) IExact(v string) StrField     { return sf("iexact", v) }
-func (strOp) Contains(v string) StrField   { return sf("contains", v) }
-func (strOp) IContains(v string) StrField  { return sf("icontains", v) }
-func (strOp) StartsWith(v string) StrField { return sf("startswith", v) }
-func (strOp) EndsWith(v string) StrField   { return sf("endswith", v) }
-func (strOp) Regex(v string) StrField      { return sf("regex", v) }
-func (strOp) IRegex(v string) StrField     { return sf("iregex", v) }
-func (strOp) In(vs ...string) StrField     { return sf("in", strings.Join(vs, ",")) }
+func (strOp) IExact(v string) StrField     { return sf(core.LookupIExact, v) }
+func (strOp) Contains(v string) StrField   { return sf(core.LookupContains, v) }
+func (strOp) IContains(v string) StrField  { return sf(core.LookupIContains, v) }
+func (strOp) StartsWith(v string) StrField { return sf(core.LookupStartsWith, v) }
+func (strOp) EndsWith(v string) StrField   { return sf(core.LookupEndsWith, v) }
+func (strOp) Regex(v string) StrField      { return sf(core.LookupRegex, v) }
+func (strOp) IRegex(v string) StrField     { return sf(core.LookupIRegex, v) }
+func (strOp) In(vs ...string) StrField     { return sf(core.LookupIn, core.LookupValue(vs)) }
+func (strOp) IsNull() StrField             { return exprField[string](nullExpr(true)) }
+func (strOp) NotNull() StrField            { return exprField[string](nullExpr(false)) }
 
 // Negated variants (not_ prefix)
-func (strOp) NotExact(v string) StrField      { return sf("not_exact", v) }
-func (strOp) NotIExact(v string) StrField     { return sf("not_iexact", v) }
-func (strOp) NotContains(v string) StrField   { return sf("not_contains", v) }
-func (strOp) NotIContains(v string) StrField  { return sf("not_icontains", v) }
-func (strOp) NotStartsWith(v string) StrField { return sf("not_startswith", v) }
-func (strOp) NotEndsWith(v string) StrField   { return sf("not_endswith", v) }
-func (strOp) NotRegex(v string) StrField      { return sf("not_regex", v) }
-func (strOp) NotIRegex(v string) StrField     { return sf("not_iregex", v) }
-func (strOp) NotIn(vs ...string) StrField     { return sf("not_in", strings.Join(vs, ",")) }
+func (strOp) NotExact(v string) StrField      { return sf(core.LookupExact.Not(), v) }
+func (strOp) NotIExact(v string) StrField     { return sf(core.LookupIExact.Not(), v) }
+func (strOp) NotContains(v string) StrField   { return sf(core.LookupContains.Not(), v) }
+func (strOp) NotIContains(v string) StrField  { return sf(core.LookupIContains.Not(), v) }
+func (strOp) NotStartsWith(v string) StrField { return sf(core.LookupStartsWith.Not(), v) }
+func (strOp) NotEndsWith(v string) StrField   { return sf(core.LookupEndsWith.Not(), v) }
+func (strOp) NotRegex(v string) StrField      { return sf(core.LookupRegex.Not(), v) }
+func (strOp) NotIRegex(v string) StrField     { return sf(core.LookupIRegex.Not(), v) }
+func (strOp) NotIn(vs ...string) StrField     { return sf(core.LookupIn.Not(), core.LookupValue(vs)) }
 
-func sf(suffix, value string) StrField {
+func sf(suffix core.Lookup, value string) StrField {
 	return exprField[string](suffixExpr{suffix: suffix, value: value})
 }
@@ -0,0 +1,53 @@
+package expr
+
+import (
+	"time"
+
+	"github.com/vast-data/go-vast-client/core"
+)
+
+// TimeLayout is the layout used to render date-time values in query strings.
+// VMS accepts ISO 8601 timestamps; values are converted to UTC before formatting.
+const TimeLayout = time.RFC3339
+
+// Time is a callable factory for date-time search field expressions.
+// Calling it directly produces an exact-match field; methods produce lookups.
+//
+//	type EventSearchParams struct {
+//	    Timestamp expr.TimeField `json:"timestamp,omitempty"`
+//	}
+//
+//	EventSearchParams{Timestamp: expr.Time.After(t)}       // ?timestamp__gte=2025-01-02T03:04:05Z
+//	EventSearchParams{Timestamp: expr.Time.Before(t)}      // ?timestamp__lte=2025-01-02T03:04:05Z
+//	EventSearchParams{Timestamp: expr.Time.Between(a, b)}  // ?timestamp__gte=...&timestamp__lte=...
+var Time = timeOp(func(v time.Time) TimeField {
+	return tf(core.LookupExact, v)
+})
+
+// timeOp is a named function type so it can both be called (exact match)
+// and carry lookup methods.
+type timeOp func(time.Time) TimeField
+
+func (timeOp) Exact(v time.Time) TimeField { return tf(core.LookupExact, v) }
+
+// After matches values at or after v (__gte).
+func (timeOp) After(v time.Time) TimeField { return tf(core.LookupGTE, v) }
+
+// Before matches values at or before v (__lte).
+func (timeOp) Before(v time.Time) TimeField { return tf(core.LookupLTE, v) }
+
+// Between matches lo <= value <= hi.
+func (timeOp) Between(lo, hi time.Time) TimeField {
+	return exprField[time.Time](rangeExpr(formatTime(lo), formatTime(hi)))
+}
+
+func (timeOp) IsNull() TimeField  { return exprField[time.Time](nullExpr(true)) }
+func (timeOp) NotNull() TimeField { return exprField[time.Time](nullExpr(false)) }
+
+func tf(suffix core.Lookup, v time.Time) TimeField {
+	return exprField[time.Time](suffixExpr{suffix: suffix, value: formatTime(v)})
+}
+
+func formatTime(v time.Time) string {
+	return v.UTC().Format(TimeLayout)
+}
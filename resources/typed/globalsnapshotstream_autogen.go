@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
@@ -75,6 +75,24 @@ type GlobalSnapshotStreamRequestBody struct {
 	ViewReplicationEnabled bool                                          `json:"view_replication_enabled,omitempty" yaml:"view_replication_enabled,omitempty" required:"false" doc:"Enables view replication"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// GlobalSnapshotStreamUpdateBody represents the request body for GlobalSnapshotStream Update operations
+// Generated from PATCH request body for resource: /globalsnapstreams/{id}/
+type GlobalSnapshotStreamUpdateBody struct {
+	Enabled bool   `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"Enabled"`
+	Name    string `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Name"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -202,6 +220,44 @@ func (r *GlobalSnapshotStream) CreateWithContext(ctx context.Context, req *Globa
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing globalsnapshotstream by ID and returns its details
+// summary: Modify a Global Snapshot Stream
+func (r *GlobalSnapshotStream) Update(id any, req *GlobalSnapshotStreamUpdateBody) (*GlobalSnapshotStreamDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing globalsnapshotstream by ID and returns its details using provided context
+// summary: Modify a Global Snapshot Stream
+func (r *GlobalSnapshotStream) UpdateWithContext(ctx context.Context, id any, req *GlobalSnapshotStreamUpdateBody) (*GlobalSnapshotStreamDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response GlobalSnapshotStreamDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -372,4 +428,3 @@ func (r *GlobalSnapshotStream) GlobalSnapshotStreamStop_PATCH(id any, waitTimeou
 // -----------------------------------------------------
 //   - Extra method PATCH /globalsnapstreams/{id}/pause/ skipped: PATCH /globalsnapstreams/{id}/pause/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /globalsnapstreams/{id}/pause/
 //   - Extra method PATCH /globalsnapstreams/{id}/resume/ skipped: PATCH /globalsnapstreams/{id}/resume/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /globalsnapstreams/{id}/resume/
-//   - UPDATE operation excluded: PATCH/PUT /globalsnapstreams/{id}/ has no response schema and doesn't return 204 NO CONTENT
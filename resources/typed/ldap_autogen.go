@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
@@ -97,6 +97,63 @@ type LdapRequestBody struct {
 	UsernamePropertyName       string    `json:"username_property_name,omitempty" yaml:"username_property_name,omitempty" required:"false" doc:"The attribute to use for querying users in VMS user-initated user queries. Default is 'name'. Sometimes set to 'cn'"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// LdapUpdateBody represents the request body for Ldap Update operations
+// Generated from PATCH request body for resource: /ldaps/{id}/
+type LdapUpdateBody struct {
+	AbacReadOnlyValueName      string    `json:"abac_read_only_value_name,omitempty" yaml:"abac_read_only_value_name,omitempty" required:"false" doc:"The attribute to use when querying a provider for a read only attribute access check."`
+	AbacReadWriteValueName     string    `json:"abac_read_write_value_name,omitempty" yaml:"abac_read_write_value_name,omitempty" required:"false" doc:"The attribute to use when querying a provider for a read-write attribute access check."`
+	AdvancedFilter             string    `json:"advanced_filter,omitempty" yaml:"advanced_filter,omitempty" required:"false" doc:"Use this parameter to specify manual filters for the BaseDN. This is useful when accounts are distributed across OUs and the baseDN needs to be wide to include all accounts, while there are also accounts that you would like to exclude from user queries."`
+	Binddn                     string    `json:"binddn,omitempty" yaml:"binddn,omitempty" required:"false" doc:"The bind DN for authenticating to the LDAP domain. You can specify any user account that has read access to the domain."`
+	Bindpw                     string    `json:"bindpw,omitempty" yaml:"bindpw,omitempty" required:"false" doc:"The password used with the Bind DN to authenticate to the LDAP server."`
+	DomainName                 string    `json:"domain_name,omitempty" yaml:"domain_name,omitempty" required:"false" doc:"FQDN of Active Directory domain. Must be resolvable in DNS."`
+	DomainsWithPosixAttributes *[]string `json:"domains_with_posix_attributes,omitempty" yaml:"domains_with_posix_attributes,omitempty" required:"false" doc:"Allows to enumerate specific domains for POSIX attributes in case posix_attributes_source is set to SPECIFIC_DOMAINS."`
+	GidNumber                  string    `json:"gid_number,omitempty" yaml:"gid_number,omitempty" required:"false" doc:"Override 'gidNumber' as the attribute of a group entry that contains the group's GID number. When binding VAST Cluster to AD, you may need to set this to 'gidnumber' (case sensitive)."`
+	GroupLoginName             string    `json:"group_login_name,omitempty" yaml:"group_login_name,omitempty" required:"false" doc:"The attribute used to query Active Directory for the group login name in NFS ID mapping. Applicable only with Active Directory and NFSv4."`
+	GroupSearchbase            string    `json:"group_searchbase,omitempty" yaml:"group_searchbase,omitempty" required:"false" doc:"Base DN for group queries within the joined domain only. When auto discovery is enabled, group queries outside the joined domain use auto-discovered Base DNs."`
+	IsAbacProvider             bool      `json:"is_abac_provider,omitempty" yaml:"is_abac_provider,omitempty" required:"false" doc:"is this Ldap used for ABAC"`
+	IsVmsAuthProvider          bool      `json:"is_vms_auth_provider,omitempty" yaml:"is_vms_auth_provider,omitempty" required:"false" doc:"Enables use of the LDAP for VMS authentication. Two LDAP configurations per cluster can be used for VMS authentication: one with Active Directory and one without."`
+	MailPropertyName           string    `json:"mail_property_name,omitempty" yaml:"mail_property_name,omitempty" required:"false" doc:"The attribute to use for the user's email address."`
+	MatchUser                  string    `json:"match_user,omitempty" yaml:"match_user,omitempty" required:"false" doc:"The attribute to use when querying a provider for a user that matches a user that was already retrieved from another provider. A user entry that contains a matching value in this attribute will be considered the same user as the user previously retrieved."`
+	Method                     string    `json:"method,omitempty" yaml:"method,omitempty" required:"false" doc:"The authentication method configured on the LDAP server for authenticating clients."`
+	MonitorAction              string    `json:"monitor_action,omitempty" yaml:"monitor_action,omitempty" required:"false" doc:"The type of periodic health check that VAST Cluster performs for the LDAP provider. PING (default, less overhead and impact on the provider) = pings the provider. BIND = binds to the provider."`
+	Name                       string    `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Name of the LDAP configuration"`
+	NetgroupSearchbase         string    `json:"netgroup_searchbase,omitempty" yaml:"netgroup_searchbase,omitempty" required:"false" doc:"Base DN for netgroup queries."`
+	Port                       int64     `json:"port,omitempty" yaml:"port,omitempty" required:"false" doc:"The port of the remote LDAP server. Typical values: 389, 636."`
+	PosixAccount               string    `json:"posix_account,omitempty" yaml:"posix_account,omitempty" required:"false" doc:"Override 'posixAccount'as the object class that defines a user entry on the LDAP server. When binding VAST Cluster to AD, set this parameter to 'user' in order for authorization to work properly."`
+	PosixAttributesSource      string    `json:"posix_attributes_source,omitempty" yaml:"posix_attributes_source,omitempty" required:"false" doc:"Defines which domains POSIX attributes will be supported from."`
+	PosixGroup                 string    `json:"posix_group,omitempty" yaml:"posix_group,omitempty" required:"false" doc:"Override 'posixGroup' as the object class that defines a group entry on the LDAP server. When binding VAST Cluster to AD, set this parameter to 'group' in order for authorization to work properly."`
+	QueryGroupsMode            string    `json:"query_groups_mode,omitempty" yaml:"query_groups_mode,omitempty" required:"false" doc:"A mode setting for how groups are queried: Set to COMPATIBLE to look up user groups using the 'memberOf' and 'memberUid' attributes. Set to RFC2307BIS_ONLY to look up user groups using only the 'memberOf' attribute. Set to RFC2307_ONLY to look up user groups using only the 'memberUid' attribute. Set to NONE not to look up user groups other than by leading GID and primary group SID."`
+	QueryPosixAttributesFromGc bool      `json:"query_posix_attributes_from_gc,omitempty" yaml:"query_posix_attributes_from_gc,omitempty" required:"false" doc:"When set to True - users/groups from non-joined domain POSIX attributes are supported, when set to False - Posix attributes of users/groups from non-joined domain are not supported. As a condition Global catalog needs to be configured to support Posix attributes. (deprecated since 4.6)"`
+	ReverseLookup              bool      `json:"reverse_lookup,omitempty" yaml:"reverse_lookup,omitempty" required:"false" doc:"resolve netgroups into hostnames"`
+	Searchbase                 string    `json:"searchbase,omitempty" yaml:"searchbase,omitempty" required:"false" doc:"The entry in the LDAP directory tree to use as a starting point for user queries."`
+	SuperAdminGroups           *[]string `json:"super_admin_groups,omitempty" yaml:"super_admin_groups,omitempty" required:"false" doc:"List of groups on the LDAP provider. Members of these groups can log into VMS as cluster admin users."`
+	TlsCertificate             string    `json:"tls_certificate,omitempty" yaml:"tls_certificate,omitempty" required:"false" doc:"TLS certificate to use for verifying the remote LDAP server's TLS certificate."`
+	Uid                        string    `json:"uid,omitempty" yaml:"uid,omitempty" required:"false" doc:"Override 'uid' as the attribute of a user entry on the LDAP server that contains the user name. When binding VAST Cluster to AD, you may need to set this to 'sAMAccountname'."`
+	UidMember                  string    `json:"uid_member,omitempty" yaml:"uid_member,omitempty" required:"false" doc:"Override 'memberUid' as the attribute of a group entry on the LDAP server that contains names of group members. When binding VAST Cluster to AD, you may need to set this to 'memberUID'"`
+	UidMemberValuePropertyName string    `json:"uid_member_value_property_name,omitempty" yaml:"uid_member_value_property_name,omitempty" required:"false" doc:"The attribute which represents the value of the LDAP group's member property."`
+	UidNumber                  string    `json:"uid_number,omitempty" yaml:"uid_number,omitempty" required:"false" doc:"Override 'uidNumber' as the attribute of a user entry on the LDAP server that contains the UID number. Often when binding VAST Cluster to Active Directory this does not need to be set."`
+	Url                        string    `json:"url,omitempty" yaml:"url,omitempty" required:"false" doc:"LDAP server URI in the format SCHEME://ADDRESS. ADDRESS can be either a DNS name or an IP address. Example: ldap://ldap.company.com"`
+	Urls                       *[]string `json:"urls,omitempty" yaml:"urls,omitempty" required:"false" doc:"Comma separated list of URIs of LDAP servers in the format SCHEME://ADDRESS. The order of listing defines the priority order. The URI with highest priority that has a good health status is used."`
+	UseAutoDiscovery           bool      `json:"use_auto_discovery,omitempty" yaml:"use_auto_discovery,omitempty" required:"false" doc:"When enabled, Active Directory Domain Controllers (DCs) and Active Directory domains are auto discovered. Queries extend beyond the joined domain to all domains in the forest. When disabled, queries are restricted to the joined domain and DCs must be provided in the URLs field."`
+	UseLdaps                   bool      `json:"use_ldaps,omitempty" yaml:"use_ldaps,omitempty" required:"false" doc:"Use LDAPS for Auto-Discovery"`
+	UseMultiForest             bool      `json:"use_multi_forest,omitempty" yaml:"use_multi_forest,omitempty" required:"false" doc:"Allow access for users from trusted domains on other forests."`
+	UsePosix                   bool      `json:"use_posix,omitempty" yaml:"use_posix,omitempty" required:"false" doc:"POSIX support"`
+	UseTls                     bool      `json:"use_tls,omitempty" yaml:"use_tls,omitempty" required:"false" doc:"Set to true to enable use of TLS to secure communication between VAST Cluster and the LDAP server."`
+	UserLoginName              string    `json:"user_login_name,omitempty" yaml:"user_login_name,omitempty" required:"false" doc:"The attribute used to query Active Directory for the user login name in NFS ID mapping. Applicable only with Active Directory and NFSv4."`
+	UsernamePropertyName       string    `json:"username_property_name,omitempty" yaml:"username_property_name,omitempty" required:"false" doc:"The attribute to use for querying users in VMS user-initated user queries. Default is 'name'. Sometimes set to 'cn'"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -224,6 +281,44 @@ func (r *Ldap) CreateWithContext(ctx context.Context, req *LdapRequestBody) (*Ld
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing ldap by ID and returns its details
+// summary: Modify LDAP configuration
+func (r *Ldap) Update(id any, req *LdapUpdateBody) (*LdapDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing ldap by ID and returns its details using provided context
+// summary: Modify LDAP configuration
+func (r *Ldap) UpdateWithContext(ctx context.Context, id any, req *LdapUpdateBody) (*LdapDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response LdapDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -355,8 +450,3 @@ func (r *Ldap) LdapSetPosixPrimaryWithContext_PATCH(ctx context.Context, id any)
 func (r *Ldap) LdapSetPosixPrimary_PATCH(id any) error {
 	return r.LdapSetPosixPrimaryWithContext_PATCH(r.Untyped.GetCtx(), id)
 }
-
-// -----------------------------------------------------
-// GENERATION ISSUES
-// -----------------------------------------------------
-//   - UPDATE operation excluded: PATCH/PUT /ldaps/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -61,6 +62,28 @@ type MonitorRequestBody struct {
 	ToTime      string `json:"to_time,omitempty" yaml:"to_time,omitempty" required:"false" doc:"End time of period to report. e.g. 2021-01-15T13:41:52Z"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// MonitorUpdateBody represents the request body for Monitor Update operations
+// Generated from PATCH request body for resource: /monitors/{id}/
+type MonitorUpdateBody struct {
+	Aggregation string `json:"aggregation,omitempty" yaml:"aggregation,omitempty" required:"false" doc:"If data granularity is minutes, hours or days, the data is aggregated. This parameter selects which aggregation function to use."`
+	FromTime    string `json:"from_time,omitempty" yaml:"from_time,omitempty" required:"false" doc:"Start time of period to report. e.g. 2021-01-15T13:41:52Z"`
+	Granularity string `json:"granularity,omitempty" yaml:"granularity,omitempty" required:"false" doc:"Data granularity: seconds (raw), minutes (five minute aggregated samples), hours (hourly aggregated samples), or days (daily aggregated samples)"`
+	ObjectIds   string `json:"object_ids,omitempty" yaml:"object_ids,omitempty" required:"false" doc:"Specific objects to include in the report, specified as a comma separated list of object IDs."`
+	PropList    string `json:"prop_list,omitempty" yaml:"prop_list,omitempty" required:"false" doc:"A list of metrics to query. To get the full list of metrics, use GET /metrics/."`
+	ToTime      string `json:"to_time,omitempty" yaml:"to_time,omitempty" required:"false" doc:"End time of period to report. e.g. 2021-01-15T13:41:52Z"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -188,6 +211,44 @@ func (r *Monitor) CreateWithContext(ctx context.Context, req *MonitorRequestBody
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing monitor by ID and returns its details
+// summary: Modify Analytics Report
+func (r *Monitor) Update(id any, req *MonitorUpdateBody) (*MonitorDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing monitor by ID and returns its details using provided context
+// summary: Modify Analytics Report
+func (r *Monitor) UpdateWithContext(ctx context.Context, id any, req *MonitorUpdateBody) (*MonitorDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response MonitorDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -298,4 +359,3 @@ func (r *Monitor) MustExistsWithContext(ctx context.Context, req *MonitorSearchP
 // -----------------------------------------------------
 //   - Extra method GET /monitors/topn/ skipped: GET /monitors/topn/ - Response schema contains ambiguous nested objects (objects with no properties)
 //   - Extra method GET /monitors/{id}/query/ skipped: GET /monitors/{id}/query/ - Response schema contains ambiguous nested objects (objects with no properties)
-//   - UPDATE operation excluded: PATCH/PUT /monitors/{id}/ has no response schema and doesn't return 204 NO CONTENT
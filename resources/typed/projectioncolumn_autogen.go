@@ -25,13 +25,13 @@ type ProjectionColumn struct {
 
 // ProjectionColumnSearchParams represents the search parameters for ProjectionColumn operations
 type ProjectionColumnSearchParams struct {
-	CountOnly      bool          `json:"count_only,omitempty" yaml:"count_only,omitempty" required:"false" doc:"Whether to only return count of objects"`
-	DatabaseName   expr.StrField `json:"database_name,omitempty" yaml:"database_name,omitempty" required:"false" doc:"Getting list of objects by database_name"`
-	Name           expr.StrField `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Getting list of objects by exact match"`
-	ProjectionName expr.StrField `json:"projection_name,omitempty" yaml:"projection_name,omitempty" required:"false" doc:"Getting list of objects by projection_name"`
-	SchemaName     expr.StrField `json:"schema_name,omitempty" yaml:"schema_name,omitempty" required:"false" doc:"Getting list of objects by schema_name"`
-	TableName      expr.StrField `json:"table_name,omitempty" yaml:"table_name,omitempty" required:"false" doc:"Getting list of objects by table_name"`
-	TenantId       expr.IntField `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Filter by tenant. Specify tenant ID."`
+	CountOnly      expr.BoolField `json:"count_only,omitempty" yaml:"count_only,omitempty" required:"false" doc:"Whether to only return count of objects"`
+	DatabaseName   expr.StrField  `json:"database_name,omitempty" yaml:"database_name,omitempty" required:"false" doc:"Getting list of objects by database_name"`
+	Name           expr.StrField  `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Getting list of objects by exact match"`
+	ProjectionName expr.StrField  `json:"projection_name,omitempty" yaml:"projection_name,omitempty" required:"false" doc:"Getting list of objects by projection_name"`
+	SchemaName     expr.StrField  `json:"schema_name,omitempty" yaml:"schema_name,omitempty" required:"false" doc:"Getting list of objects by schema_name"`
+	TableName      expr.StrField  `json:"table_name,omitempty" yaml:"table_name,omitempty" required:"false" doc:"Getting list of objects by table_name"`
+	TenantId       expr.IntField  `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Filter by tenant. Specify tenant ID."`
 
 	// RawData allows passing arbitrary search parameters as key-value pairs.
 	//
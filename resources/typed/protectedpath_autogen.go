@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
@@ -77,6 +77,35 @@ type ProtectedPathRequestBody struct {
 	ViewReplicationEnabled   bool   `json:"view_replication_enabled,omitempty" yaml:"view_replication_enabled,omitempty" required:"false" doc:"Manages view replication"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// ProtectedPathUpdateBody represents the request body for ProtectedPath Update operations
+// Generated from PATCH request body for resource: /protectedpaths/{id}/
+type ProtectedPathUpdateBody struct {
+	Abort               bool   `json:"abort,omitempty" yaml:"abort,omitempty" required:"false" doc:"abort the failover process"`
+	Enabled             bool   `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"enable/pause protected path"`
+	Failover            bool   `json:"failover,omitempty" yaml:"failover,omitempty" required:"false" doc:"Trigger failover command"`
+	Graceful            bool   `json:"graceful,omitempty" yaml:"graceful,omitempty" required:"false" doc:"graceful failover"`
+	LeaseExpiryTime     int64  `json:"lease_expiry_time,omitempty" yaml:"lease_expiry_time,omitempty" required:"false" doc:"The lease expiry time, in seconds, for a global access protected path. This is the duration for which data that was already requested at the destination path can be read locally from cache without the destination peer requesting it from the source peer. When the lease expires, the cache is invalidated and the next read request for the data is requested again from the source peer."`
+	Name                string `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:""`
+	ProtectionPolicyId  string `json:"protection_policy_id,omitempty" yaml:"protection_policy_id,omitempty" required:"false" doc:"protection policy id"`
+	ReplicationPolicyId string `json:"replication_policy_id,omitempty" yaml:"replication_policy_id,omitempty" required:"false" doc:"replication policy id"`
+	SourceDir           string `json:"source_dir,omitempty" yaml:"source_dir,omitempty" required:"false" doc:"path to replicate"`
+	State               string `json:"state,omitempty" yaml:"state,omitempty" required:"false" doc:"state"`
+	SyncDisconnectTime  int64  `json:"sync_disconnect_time,omitempty" yaml:"sync_disconnect_time,omitempty" required:"false" doc:"A period of time, in seconds, without communication between sync replication peers, after which the peers are disconnected.\""`
+	SyncInterval        int64  `json:"sync_interval,omitempty" yaml:"sync_interval,omitempty" required:"false" doc:"Minimal duration since the last snapshot shared between all destination peers in a replication group. Applicable if the protected path has more than one replication stream."`
+	TargetExportedDir   string `json:"target_exported_dir,omitempty" yaml:"target_exported_dir,omitempty" required:"false" doc:"where to replicate on the remote"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -204,6 +233,44 @@ func (r *ProtectedPath) CreateWithContext(ctx context.Context, req *ProtectedPat
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing protectedpath by ID and returns its details
+// summary: Modify a Protected Path
+func (r *ProtectedPath) Update(id any, req *ProtectedPathUpdateBody) (*ProtectedPathDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing protectedpath by ID and returns its details using provided context
+// summary: Modify a Protected Path
+func (r *ProtectedPath) UpdateWithContext(ctx context.Context, id any, req *ProtectedPathUpdateBody) (*ProtectedPathDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response ProtectedPathDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -530,4 +597,3 @@ func (r *ProtectedPath) ProtectedPathValidate_GET(id any) (*ProtectedPathValidat
 //   - Extra method PATCH /protectedpaths/{id}/resume/ skipped: PATCH /protectedpaths/{id}/resume/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /protectedpaths/{id}/resume/
 //   - Extra method PATCH /protectedpaths/{id}/stop/ skipped: PATCH /protectedpaths/{id}/stop/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /protectedpaths/{id}/stop/
 //   - Extra method POST /protectedpaths/{id}/restore/ skipped: POST /protectedpaths/{id}/restore/ - Response schema contains ambiguous nested objects (objects with no properties)
-//   - UPDATE operation excluded: PATCH/PUT /protectedpaths/{id}/ has no response schema and doesn't return 204 NO CONTENT
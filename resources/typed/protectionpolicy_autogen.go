@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -64,6 +65,30 @@ type ProtectionPolicyRequestBody struct {
 	TenantId         int64  `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Tenant ID"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// ProtectionPolicyUpdateBody represents the request body for ProtectionPolicy Update operations
+// Generated from PATCH request body for resource: /protectionpolicies/{id}/
+type ProtectionPolicyUpdateBody struct {
+	BigCatalog     bool   `json:"big_catalog,omitempty" yaml:"big_catalog,omitempty" required:"false" doc:"Indicates if Protection Policy will be used for VAST Catalog. There may only be 1 such policy."`
+	CloneType      string `json:"clone_type,omitempty" yaml:"clone_type,omitempty" required:"false" doc:"Specify the type of data protection. CLOUD_REPLICATION is S3 backup. LOCAL means local snapshots without replication."`
+	Guid           string `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:"Do not specify this parameter."`
+	Id             int64  `json:"id,omitempty" yaml:"id,omitempty" required:"false" doc:"Do not specify this parameter."`
+	Indestructible bool   `json:"indestructible,omitempty" yaml:"indestructible,omitempty" required:"false" doc:"Set to true to protect the protection policy from accidental or malicious deletion with the indestructibility feature. If this setting is enabled, authorized unlocking of the cluster's indestructibility mechanism is required to do any of the following: modifying the policy, deleting the policy or disabling this setting."`
+	Name           string `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Name"`
+	Prefix         string `json:"prefix,omitempty" yaml:"prefix,omitempty" required:"false" doc:"The prefix for names of snapshots created by the policy"`
+	TargetObjectId int64  `json:"target_object_id,omitempty" yaml:"target_object_id,omitempty" required:"false" doc:"ID of the remote peer. Specify ID of a ReplicationTarget (aka S3 replication peer) if clone_type is CLOUD_REPLICATION. Specify the ID of a NativeReplicationRemoteTarget if clone_type is NATIVE_REPLICATION."`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -191,6 +216,44 @@ func (r *ProtectionPolicy) CreateWithContext(ctx context.Context, req *Protectio
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing protectionpolicy by ID and returns its details
+// summary: Modify a Protection Policy
+func (r *ProtectionPolicy) Update(id any, req *ProtectionPolicyUpdateBody) (*ProtectionPolicyDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing protectionpolicy by ID and returns its details using provided context
+// summary: Modify a Protection Policy
+func (r *ProtectionPolicy) UpdateWithContext(ctx context.Context, id any, req *ProtectionPolicyUpdateBody) (*ProtectionPolicyDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response ProtectionPolicyDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -295,8 +358,3 @@ func (r *ProtectionPolicy) MustExistsWithContext(ctx context.Context, req *Prote
 	}
 	return r.Untyped.GetResourceMap()[r.GetResourceType()].MustExistsWithContext(ctx, params)
 }
-
-// -----------------------------------------------------
-// GENERATION ISSUES
-// -----------------------------------------------------
-//   - UPDATE operation excluded: PATCH/PUT /protectionpolicies/{id}/ has no response schema and doesn't return 204 NO CONTENT
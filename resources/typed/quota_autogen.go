@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
@@ -26,16 +26,16 @@ type Quota struct {
 
 // QuotaSearchParams represents the search parameters for Quota operations
 type QuotaSearchParams struct {
-	Path            expr.StrField `json:"path,omitempty" yaml:"path,omitempty" required:"true" doc:"Directory path"`
-	Guid            expr.StrField `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:"Quota guid"`
-	HardLimit       expr.StrField `json:"hard_limit,omitempty" yaml:"hard_limit,omitempty" required:"false" doc:"Filter results by hard capacity limit."`
-	HardLimitInodes expr.StrField `json:"hard_limit_inodes,omitempty" yaml:"hard_limit_inodes,omitempty" required:"false" doc:"Filter results by hard limit on number of files and directories"`
-	Name            expr.StrField `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:""`
-	ShowUserRules   bool          `json:"show_user_rules,omitempty" yaml:"show_user_rules,omitempty" required:"false" doc:"Include user and group quota rules in response."`
-	SoftLimit       expr.StrField `json:"soft_limit,omitempty" yaml:"soft_limit,omitempty" required:"false" doc:"Filter results by soft capacity limit."`
-	SoftLimitInodes expr.StrField `json:"soft_limit_inodes,omitempty" yaml:"soft_limit_inodes,omitempty" required:"false" doc:"Filter results by soft limit on number of files and directories."`
-	SystemId        expr.StrField `json:"system_id,omitempty" yaml:"system_id,omitempty" required:"false" doc:""`
-	TenantId        expr.IntField `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Filter by tenant. Specify tenant ID."`
+	Path            expr.StrField  `json:"path,omitempty" yaml:"path,omitempty" required:"true" doc:"Directory path"`
+	Guid            expr.StrField  `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:"Quota guid"`
+	HardLimit       expr.StrField  `json:"hard_limit,omitempty" yaml:"hard_limit,omitempty" required:"false" doc:"Filter results by hard capacity limit."`
+	HardLimitInodes expr.StrField  `json:"hard_limit_inodes,omitempty" yaml:"hard_limit_inodes,omitempty" required:"false" doc:"Filter results by hard limit on number of files and directories"`
+	Name            expr.StrField  `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:""`
+	ShowUserRules   expr.BoolField `json:"show_user_rules,omitempty" yaml:"show_user_rules,omitempty" required:"false" doc:"Include user and group quota rules in response."`
+	SoftLimit       expr.StrField  `json:"soft_limit,omitempty" yaml:"soft_limit,omitempty" required:"false" doc:"Filter results by soft capacity limit."`
+	SoftLimitInodes expr.StrField  `json:"soft_limit_inodes,omitempty" yaml:"soft_limit_inodes,omitempty" required:"false" doc:"Filter results by soft limit on number of files and directories."`
+	SystemId        expr.StrField  `json:"system_id,omitempty" yaml:"system_id,omitempty" required:"false" doc:""`
+	TenantId        expr.IntField  `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Filter by tenant. Specify tenant ID."`
 
 	// RawData allows passing arbitrary search parameters as key-value pairs.
 	//
@@ -103,6 +103,57 @@ type QuotaRequestBody struct {
 	UserQuotas           *[]string                          `json:"user_quotas,omitempty" yaml:"user_quotas,omitempty" required:"false" doc:"An array of user quota rule objects. A user quota rule overrides a default user quota rule for the specified user."`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// QuotaUpdateBody_DefaultGroupQuota represents a nested type for update body
+type QuotaUpdateBody_DefaultGroupQuota struct {
+	GracePeriod     string `json:"grace_period,omitempty" yaml:"grace_period,omitempty" required:"false" doc:"Quota enforcement grace period in seconds, minutes, hours or days. Example: 90m"`
+	HardLimit       int64  `json:"hard_limit,omitempty" yaml:"hard_limit,omitempty" required:"false" doc:"Hard quota limit"`
+	HardLimitInodes int64  `json:"hard_limit_inodes,omitempty" yaml:"hard_limit_inodes,omitempty" required:"false" doc:"Hard inodes quota limit"`
+	QuotaSystemId   int64  `json:"quota_system_id,omitempty" yaml:"quota_system_id,omitempty" required:"false" doc:""`
+	SoftLimit       int64  `json:"soft_limit,omitempty" yaml:"soft_limit,omitempty" required:"false" doc:"Soft quota limit"`
+	SoftLimitInodes int64  `json:"soft_limit_inodes,omitempty" yaml:"soft_limit_inodes,omitempty" required:"false" doc:"Soft inodes quota limit"`
+}
+
+// QuotaUpdateBody_DefaultUserQuota represents a nested type for update body
+type QuotaUpdateBody_DefaultUserQuota struct {
+	GracePeriod     string `json:"grace_period,omitempty" yaml:"grace_period,omitempty" required:"false" doc:"Quota enforcement grace period in seconds, minutes, hours or days. Example: 90m"`
+	HardLimit       int64  `json:"hard_limit,omitempty" yaml:"hard_limit,omitempty" required:"false" doc:"Hard quota limit"`
+	HardLimitInodes int64  `json:"hard_limit_inodes,omitempty" yaml:"hard_limit_inodes,omitempty" required:"false" doc:"Hard inodes quota limit"`
+	QuotaSystemId   int64  `json:"quota_system_id,omitempty" yaml:"quota_system_id,omitempty" required:"false" doc:""`
+	SoftLimit       int64  `json:"soft_limit,omitempty" yaml:"soft_limit,omitempty" required:"false" doc:"Soft quota limit"`
+	SoftLimitInodes int64  `json:"soft_limit_inodes,omitempty" yaml:"soft_limit_inodes,omitempty" required:"false" doc:"Soft inodes quota limit"`
+}
+
+// QuotaUpdateBody represents the request body for Quota Update operations
+// Generated from PATCH request body for resource: /quotas/{id}/
+type QuotaUpdateBody struct {
+	DefaultEmail         string                            `json:"default_email,omitempty" yaml:"default_email,omitempty" required:"false" doc:"Emails are sent to users if and when they exceed their user/group quota limits. default_email is a default email address that is used instead of a user's email address in the event that no email address is found for the user on a provider and no email suffix is set."`
+	DefaultGroupQuota    QuotaUpdateBody_DefaultGroupQuota `json:"default_group_quota,omitempty" yaml:"default_group_quota,omitempty" required:"false" doc:""`
+	DefaultUserQuota     QuotaUpdateBody_DefaultUserQuota  `json:"default_user_quota,omitempty" yaml:"default_user_quota,omitempty" required:"false" doc:""`
+	EnableAlarms         bool                              `json:"enable_alarms,omitempty" yaml:"enable_alarms,omitempty" required:"false" doc:"True by default. Enables alarms on relevant events for user and group quotas. Applicable only if is_user_quota is true. Raises alarms reporting the number of users that exceed their quotas and when one or more users is/are blocked from writing to the quota directory."`
+	EnableEmailProviders bool                              `json:"enable_email_providers,omitempty" yaml:"enable_email_providers,omitempty" required:"false" doc:"Set to true to enable querying Active Directory and LDAP services for user emails when sending user notifications to users if they exceed their user/group quota limits. If enabled, the provider query is the first priority source for a user's email. If a user's email is not found on the provider, a global suffix is used to form an email. If no suffix is set, default_email is used."`
+	GracePeriod          string                            `json:"grace_period,omitempty" yaml:"grace_period,omitempty" required:"false" doc:"Quota enforcement grace period. An alarm is triggered and write operations are blocked if storage usage continues to exceed the soft limit for the grace period. Format: [DD] [HH:[MM:]]s"`
+	GroupQuotas          *[]string                         `json:"group_quotas,omitempty" yaml:"group_quotas,omitempty" required:"false" doc:""`
+	HardLimit            int64                             `json:"hard_limit,omitempty" yaml:"hard_limit,omitempty" required:"false" doc:"Storage usage limit beyond which no writes will be allowed."`
+	HardLimitInodes      int64                             `json:"hard_limit_inodes,omitempty" yaml:"hard_limit_inodes,omitempty" required:"false" doc:"Number of directories and unique files under the path beyond which no writes will be allowed. A file with multiple hardlinks is counted only once."`
+	IsUserQuota          bool                              `json:"is_user_quota,omitempty" yaml:"is_user_quota,omitempty" required:"false" doc:""`
+	Name                 string                            `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Quota name"`
+	QuotaGroupsIds       *[]int64                          `json:"quota_groups_ids,omitempty" yaml:"quota_groups_ids,omitempty" required:"false" doc:""`
+	SoftLimit            int64                             `json:"soft_limit,omitempty" yaml:"soft_limit,omitempty" required:"false" doc:"Storage usage limit at which warnings of exceeding the quota are issued."`
+	SoftLimitInodes      int64                             `json:"soft_limit_inodes,omitempty" yaml:"soft_limit_inodes,omitempty" required:"false" doc:"Number of directories and unique files under the path at which warnings of exceeding the quota will be issued. A file with multiple hardlinks is counted only once."`
+	UserQuotas           *[]string                         `json:"user_quotas,omitempty" yaml:"user_quotas,omitempty" required:"false" doc:"An array of user quota rule objects. A user quota rule overrides a default user quota rule for the specified user."`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -230,6 +281,44 @@ func (r *Quota) CreateWithContext(ctx context.Context, req *QuotaRequestBody) (*
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing quota by ID and returns its details
+// summary: Modify Quota
+func (r *Quota) Update(id any, req *QuotaUpdateBody) (*QuotaDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing quota by ID and returns its details using provided context
+// summary: Modify Quota
+func (r *Quota) UpdateWithContext(ctx context.Context, id any, req *QuotaUpdateBody) (*QuotaDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response QuotaDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -440,8 +529,3 @@ func (r *Quota) QuotaResetGracePeriodWithContext_PATCH(ctx context.Context, id a
 func (r *Quota) QuotaResetGracePeriod_PATCH(id any) error {
 	return r.QuotaResetGracePeriodWithContext_PATCH(r.Untyped.GetCtx(), id)
 }
-
-// -----------------------------------------------------
-// GENERATION ISSUES
-// -----------------------------------------------------
-//   - UPDATE operation excluded: PATCH/PUT /quotas/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -57,6 +58,23 @@ type RealmRequestBody struct {
 	TenantId    int64     `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Tenant ID"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// RealmUpdateBody represents the request body for Realm Update operations
+// Generated from PATCH request body for resource: /realms/{id}/
+type RealmUpdateBody struct {
+	Name string `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Name"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -184,6 +202,44 @@ func (r *Realm) CreateWithContext(ctx context.Context, req *RealmRequestBody) (*
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing realm by ID and returns its details
+// summary: Modify realm
+func (r *Realm) Update(id any, req *RealmUpdateBody) (*RealmDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing realm by ID and returns its details using provided context
+// summary: Modify realm
+func (r *Realm) UpdateWithContext(ctx context.Context, id any, req *RealmUpdateBody) (*RealmDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response RealmDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -294,4 +350,3 @@ func (r *Realm) MustExistsWithContext(ctx context.Context, req *RealmSearchParam
 // -----------------------------------------------------
 //   - Extra method PATCH /realms/{id}/assign/ skipped: PATCH /realms/{id}/assign/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /realms/{id}/assign/
 //   - Extra method PATCH /realms/{id}/unassign/ skipped: PATCH /realms/{id}/unassign/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /realms/{id}/unassign/
-//   - UPDATE operation excluded: PATCH/PUT /realms/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -71,6 +72,33 @@ type ReplicationPeersRequestBody struct {
 	TransportMode   string `json:"transport_mode,omitempty" yaml:"transport_mode,omitempty" required:"false" doc:"Transport mode: TCP for FIPS compliance, QUIC is not FIPS compliance but good for lower latency in high-latency networks."`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// ReplicationPeersUpdateBody represents the request body for ReplicationPeers Update operations
+// Generated from PATCH request body for resource: /nativereplicationremotetargets/{id}/
+type ReplicationPeersUpdateBody struct {
+	Id              int64     `json:"id,omitempty" yaml:"id,omitempty" required:"false" doc:"Native Replication Remote Target ID"`
+	LeadingVip      string    `json:"leading_vip,omitempty" yaml:"leading_vip,omitempty" required:"false" doc:"Any one of the IP addresses that belong to the remote peer's replication VIP pool. This IP is used for the initial connection between the peers."`
+	Mss             int64     `json:"mss,omitempty" yaml:"mss,omitempty" required:"false" doc:"Maximum segment size (MSS), in bytes, that the peer can receive in a single TCP segment."`
+	Name            string    `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Name of the peer configuration, reflected also on the remote cluster."`
+	Password        string    `json:"password,omitempty" yaml:"password,omitempty" required:"false" doc:"Not in use"`
+	PeerCertificate string    `json:"peer_certificate,omitempty" yaml:"peer_certificate,omitempty" required:"false" doc:"Not in use"`
+	PoolId          int64     `json:"pool_id,omitempty" yaml:"pool_id,omitempty" required:"false" doc:"The ID of the local replication VIP Pool"`
+	RemoteVips      *[]string `json:"remote_vips,omitempty" yaml:"remote_vips,omitempty" required:"false" doc:"The IP addresses that belong to the remote peer's replication VIP pool. This IP is used for the initial connection between the peers. Once the connection is established, the peers share their external network topology and form multiple connections between the VIPs."`
+	SecureMode      string    `json:"secure_mode,omitempty" yaml:"secure_mode,omitempty" required:"false" doc:"Secure mode: NONE=no encryption on the replication connection. SECURE=Replication to this peer will be encrypted over the wire with mTLS. Requires a certificate, key and root certificate to be uploaded to VMS on each peer cluster. Upload mTLS certificates with PATCH /clusters/{id}/."`
+	TransportMode   string    `json:"transport_mode,omitempty" yaml:"transport_mode,omitempty" required:"false" doc:"Transport mode: TCP for FIPS compliance, QUIC is not FIPS compliance but good for lower latency in high-latency networks."`
+	Version         string    `json:"version,omitempty" yaml:"version,omitempty" required:"false" doc:""`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -198,6 +226,44 @@ func (r *ReplicationPeers) CreateWithContext(ctx context.Context, req *Replicati
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing replicationpeers by ID and returns its details
+// summary: Modify an Async Replication Peer
+func (r *ReplicationPeers) Update(id any, req *ReplicationPeersUpdateBody) (*ReplicationPeersDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing replicationpeers by ID and returns its details using provided context
+// summary: Modify an Async Replication Peer
+func (r *ReplicationPeers) UpdateWithContext(ctx context.Context, id any, req *ReplicationPeersUpdateBody) (*ReplicationPeersDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response ReplicationPeersDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -301,4 +367,3 @@ func (r *ReplicationPeers) MustExistsWithContext(ctx context.Context, req *Repli
 // GENERATION ISSUES
 // -----------------------------------------------------
 //   - Extra method GET /nativereplicationremotetargets/get_remote_mapping/ skipped: GET /nativereplicationremotetargets/get_remote_mapping/ - Response schema contains ambiguous nested objects (objects with no properties)
-//   - UPDATE operation excluded: PATCH/PUT /nativereplicationremotetargets/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -60,6 +61,29 @@ type ReplicationPolicyRequestBody struct {
 	ScheduleStartTime        string `json:"schedule_start_time,omitempty" yaml:"schedule_start_time,omitempty" required:"false" doc:"Schedule the first restore point after the initial sync"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// ReplicationPolicyUpdateBody represents the request body for ReplicationPolicy Update operations
+// Generated from PATCH request body for resource: /replicationpolicies/{id}/
+type ReplicationPolicyUpdateBody struct {
+	AwsPreferredStorage      string `json:"aws_preferred_storage,omitempty" yaml:"aws_preferred_storage,omitempty" required:"false" doc:"Amazon S3 / Amazon Glacier"`
+	BandwidthLimitationRules string `json:"bandwidth_limitation_rules,omitempty" yaml:"bandwidth_limitation_rules,omitempty" required:"false" doc:"bandwith limitation rules"`
+	Name                     string `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:""`
+	Priority                 string `json:"priority,omitempty" yaml:"priority,omitempty" required:"false" doc:"low / normal / high"`
+	ReplicationTarget        string `json:"replication_target,omitempty" yaml:"replication_target,omitempty" required:"false" doc:"replication target id"`
+	ScheduleFrequency        string `json:"schedule_frequency,omitempty" yaml:"schedule_frequency,omitempty" required:"false" doc:"schedule frequency, in datetime format"`
+	ScheduleStartTime        string `json:"schedule_start_time,omitempty" yaml:"schedule_start_time,omitempty" required:"false" doc:"Schedule the first restore point after the initial sync"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -187,6 +211,44 @@ func (r *ReplicationPolicy) CreateWithContext(ctx context.Context, req *Replicat
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing replicationpolicy by ID and returns its details
+// summary: Modify a Replication Policy (deprecated from VAST Cluster 3.4)
+func (r *ReplicationPolicy) Update(id any, req *ReplicationPolicyUpdateBody) (*ReplicationPolicyDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing replicationpolicy by ID and returns its details using provided context
+// summary: Modify a Replication Policy (deprecated from VAST Cluster 3.4)
+func (r *ReplicationPolicy) UpdateWithContext(ctx context.Context, id any, req *ReplicationPolicyUpdateBody) (*ReplicationPolicyDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response ReplicationPolicyDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -285,8 +347,3 @@ func (r *ReplicationPolicy) MustExistsWithContext(ctx context.Context, req *Repl
 	}
 	return r.Untyped.GetResourceMap()[r.GetResourceType()].MustExistsWithContext(ctx, params)
 }
-
-// -----------------------------------------------------
-// GENERATION ISSUES
-// -----------------------------------------------------
-//   - UPDATE operation excluded: PATCH/PUT /replicationpolicies/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -69,6 +70,30 @@ type ReplicationStreamRequestBody struct {
 	ViewReplicationEnabled bool    `json:"view_replication_enabled,omitempty" yaml:"view_replication_enabled,omitempty" required:"false" doc:"Manages view replication"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// ReplicationStreamUpdateBody represents the request body for ReplicationStream Update operations
+// Generated from PATCH request body for resource: /replicationstreams/{id}/
+type ReplicationStreamUpdateBody struct {
+	Enabled               bool    `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"Enable/pause replication stream"`
+	IsManualPriorityScore bool    `json:"is_manual_priority_score,omitempty" yaml:"is_manual_priority_score,omitempty" required:"false" doc:"Indicates whether the priority score is set manually by a user."`
+	Name                  string  `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:""`
+	PriorityScore         float64 `json:"priority_score,omitempty" yaml:"priority_score,omitempty" required:"false" doc:"Indicates how close replication is to missing its RPO in relation to their current interval. A lower score means a higher priority."`
+	ProtectionPolicyId    string  `json:"protection_policy_id,omitempty" yaml:"protection_policy_id,omitempty" required:"false" doc:"Protection policy id"`
+	ReplicationPolicyId   string  `json:"replication_policy_id,omitempty" yaml:"replication_policy_id,omitempty" required:"false" doc:"replication policy id"`
+	SourceDir             string  `json:"source_dir,omitempty" yaml:"source_dir,omitempty" required:"false" doc:"Path to replicate"`
+	State                 string  `json:"state,omitempty" yaml:"state,omitempty" required:"false" doc:"State"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -196,6 +221,44 @@ func (r *ReplicationStream) CreateWithContext(ctx context.Context, req *Replicat
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing replicationstream by ID and returns its details
+// summary: Modify a Replication Stream
+func (r *ReplicationStream) Update(id any, req *ReplicationStreamUpdateBody) (*ReplicationStreamDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing replicationstream by ID and returns its details using provided context
+// summary: Modify a Replication Stream
+func (r *ReplicationStream) UpdateWithContext(ctx context.Context, id any, req *ReplicationStreamUpdateBody) (*ReplicationStreamDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response ReplicationStreamDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -294,8 +357,3 @@ func (r *ReplicationStream) MustExistsWithContext(ctx context.Context, req *Repl
 	}
 	return r.Untyped.GetResourceMap()[r.GetResourceType()].MustExistsWithContext(ctx, params)
 }
-
-// -----------------------------------------------------
-// GENERATION ISSUES
-// -----------------------------------------------------
-//   - UPDATE operation excluded: PATCH/PUT /replicationstreams/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -63,6 +64,30 @@ type RoleRequestBody struct {
 	TenantIds       *[]int64  `json:"tenant_ids,omitempty" yaml:"tenant_ids,omitempty" required:"false" doc:"Specifies IDs of tenants to associate with the role. Corresponds to 'tenants' in the output schema."`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// RoleUpdateBody represents the request body for Role Update operations
+// Generated from PATCH request body for resource: /roles/{id}/
+type RoleUpdateBody struct {
+	LdapGroups      *[]string `json:"ldap_groups,omitempty" yaml:"ldap_groups,omitempty" required:"false" doc:"Associates group(s) with the role. A group can be any user group on an LDAP-based provider, including Active Directory. The provider must be connected to the cluster. Members of the specified groups can access VMS and are granted whichever permissions are included in the role. A group can be associated with multiple roles."`
+	Name            string    `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Role name"`
+	ObjectId        int64     `json:"object_id,omitempty" yaml:"object_id,omitempty" required:"false" doc:"Object ID. Used to specify a particular object to limit the role to."`
+	ObjectType      string    `json:"object_type,omitempty" yaml:"object_type,omitempty" required:"false" doc:"Object type. Used to specify a particular object to limit the role to."`
+	Permissions     *[]string `json:"permissions,omitempty" yaml:"permissions,omitempty" required:"false" doc:"Permission type. Used to assign all the permissions of given type to a role. Can be used together with 'realm' to narrow resulting permissions list (logical AND). Ignored if provided along with 'permissions_list'. Note, that this is a legacy name, which does not correspond to the output schema's 'permissions'."`
+	PermissionsList *[]string `json:"permissions_list,omitempty" yaml:"permissions_list,omitempty" required:"false" doc:"Specify permissions list as an array of permission codenames in the format <permission>-<realm>. To list permission codenames, run /permissions/get. Takes precedence over 'permissions' or 'realm'."`
+	Realm           string    `json:"realm,omitempty" yaml:"realm,omitempty" required:"false" doc:"Realm name. Used to assigned all the permissions of given realm to a role. Can be used together with 'permissions' to narrow resulting permissions list (logical AND). Ignored if provided along with 'permissions_list'."`
+	TenantIds       *[]int64  `json:"tenant_ids,omitempty" yaml:"tenant_ids,omitempty" required:"false" doc:"IDs of tenants to assign to the role. Corresponds to 'tenants' on the output schema."`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -190,6 +215,44 @@ func (r *Role) CreateWithContext(ctx context.Context, req *RoleRequestBody) (*Ro
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing role by ID and returns its details
+// summary: Modify Role
+func (r *Role) Update(id any, req *RoleUpdateBody) (*RoleDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing role by ID and returns its details using provided context
+// summary: Modify Role
+func (r *Role) UpdateWithContext(ctx context.Context, id any, req *RoleUpdateBody) (*RoleDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response RoleDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -294,8 +357,3 @@ func (r *Role) MustExistsWithContext(ctx context.Context, req *RoleSearchParams)
 	}
 	return r.Untyped.GetResourceMap()[r.GetResourceType()].MustExistsWithContext(ctx, params)
 }
-
-// -----------------------------------------------------
-// GENERATION ISSUES
-// -----------------------------------------------------
-//   - UPDATE operation excluded: PATCH/PUT /roles/{id}/ has no response schema and doesn't return 204 NO CONTENT
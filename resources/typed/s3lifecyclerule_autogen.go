@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -67,6 +68,36 @@ type S3LifeCycleRuleRequestBody struct {
 	Tags                        map[string]string `json:"tags,omitempty" yaml:"tags,omitempty" required:"false" doc:""`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// S3LifeCycleRuleUpdateBody represents the request body for S3LifeCycleRule Update operations
+// Generated from PATCH request body for resource: /s3lifecyclerules/{id}/
+type S3LifeCycleRuleUpdateBody struct {
+	Name                        string            `json:"name,omitempty" yaml:"name,omitempty" required:"true" doc:"The name of the rule"`
+	ViewId                      int64             `json:"view_id,omitempty" yaml:"view_id,omitempty" required:"true" doc:"The ID of the View to which the rule applies"`
+	AbortMpuDaysAfterInitiation int64             `json:"abort_mpu_days_after_initiation,omitempty" yaml:"abort_mpu_days_after_initiation,omitempty" required:"false" doc:"Delete incomplete multipart uploads (MPUs)."`
+	Enabled                     bool              `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"Set to false to disable the rule. This is one way to prevent a rule from continuing to apply to the objects in a bucket."`
+	ExpirationDate              string            `json:"expiration_date,omitempty" yaml:"expiration_date,omitempty" required:"false" doc:"Expires current versions of objects on a specified date. Alternatively, specify expiration_days instead, which sets a numner of days after creation to expire current versions of objects. If the date is in the past when set, all qualified objects become immediately eligible for expiration. Note also that the policy continues to apply the rule after the date passes. Specify the date value in the ISO 8601 format without the time part. (YYYY-MM-DD). The time of expiration is always midnight UTC. Do not set expired_obj_delete_marker to true in the same rule. To clean up expired object delete markers before they reach age criteria, create a separate rule with expired_obj_delete_marker set to true.\""`
+	ExpirationDays              int64             `json:"expiration_days,omitempty" yaml:"expiration_days,omitempty" required:"false" doc:"Expires current versions of objects after a specified number of days counted from object creation. Alternatively, specify expiration_date instead, which sets a date to expire current versions of objects. In a non-versioned bucket, the expiration action results in permanent removal of affected objects. In a versioned bucket, if the current version of an object is not a delete marker, a delete marker is created and becomes the current version, while the existing current version is retained as a non-current version. Versioned objects where the only version is a delete marker are deleted when they meet the age criteria. Do not set expired_obj_delete_marker to true in the same rule. To clean up expired object delete markers before they reach age criteria, create a separate rule with expired_obj_delete_marker set to true. The time of expiration is the next midnight UTC after the number of days following object creation time."`
+	ExpiredObjDeleteMarker      bool              `json:"expired_obj_delete_marker,omitempty" yaml:"expired_obj_delete_marker,omitempty" required:"false" doc:"Set to true to delete versioned objects where the only version is a delete marker. Do not include in the same rule as expiration_days or expiration_date."`
+	MaxSize                     int64             `json:"max_size,omitempty" yaml:"max_size,omitempty" required:"false" doc:"Maximum object size. Restricts the rule to objects with the specified maximum size."`
+	MinSize                     int64             `json:"min_size,omitempty" yaml:"min_size,omitempty" required:"false" doc:"Minimum object size. Restricts the rule to objects with the specified minimum size."`
+	NewerNoncurrentVersions     int64             `json:"newer_noncurrent_versions,omitempty" yaml:"newer_noncurrent_versions,omitempty" required:"false" doc:"A number of newest non-current versions of an object to retain. Specifying this value protects the specified number of non-current versions from being eligible for deletion due to a noncurrent_days setting."`
+	NoncurrentDays              int64             `json:"noncurrent_days,omitempty" yaml:"noncurrent_days,omitempty" required:"false" doc:"A number of days after which to permanently delete non-current versions of objects. The number of days is timed from when the object becomes non-current, which is when a versioned object is deleted or overwritten."`
+	ObjectAgeAttr               string            `json:"object_age_attr,omitempty" yaml:"object_age_attr,omitempty" required:"false" doc:"Defines which time to use for expiration. Default - M_TIME"`
+	Prefix                      string            `json:"prefix,omitempty" yaml:"prefix,omitempty" required:"false" doc:"A path prefix. The rule will be restricted to objects with the specified prefix. If not specified, the rule will apply to all objects in the bucket."`
+	Tags                        map[string]string `json:"tags,omitempty" yaml:"tags,omitempty" required:"false" doc:""`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -194,6 +225,44 @@ func (r *S3LifeCycleRule) CreateWithContext(ctx context.Context, req *S3LifeCycl
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing s3lifecyclerule by ID and returns its details
+// summary: Modify an S3 Lifecycle Rule
+func (r *S3LifeCycleRule) Update(id any, req *S3LifeCycleRuleUpdateBody) (*S3LifeCycleRuleDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing s3lifecyclerule by ID and returns its details using provided context
+// summary: Modify an S3 Lifecycle Rule
+func (r *S3LifeCycleRule) UpdateWithContext(ctx context.Context, id any, req *S3LifeCycleRuleUpdateBody) (*S3LifeCycleRuleDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response S3LifeCycleRuleDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -303,4 +372,3 @@ func (r *S3LifeCycleRule) MustExistsWithContext(ctx context.Context, req *S3Life
 // GENERATION ISSUES
 // -----------------------------------------------------
 //   - Extra method GET /s3lifecyclerules/get_object_expiration/ skipped: GET /s3lifecyclerules/get_object_expiration/ - Response schema contains ambiguous nested objects (objects with no properties)
-//   - UPDATE operation excluded: PATCH/PUT /s3lifecyclerules/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -67,6 +68,32 @@ type S3replicationPeersRequestBody struct {
 	SecretKey       string    `json:"secret_key,omitempty" yaml:"secret_key,omitempty" required:"false" doc:"The secret key of a valid key pair for accessing the destination S3 bucket"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// S3replicationPeersUpdateBody represents the request body for S3replicationPeers Update operations
+// Generated from PATCH request body for resource: /replicationtargets/{id}/
+type S3replicationPeersUpdateBody struct {
+	AccessKey       string    `json:"access_key,omitempty" yaml:"access_key,omitempty" required:"false" doc:"Access key of a valid key pair for accessing the named S3 bucket"`
+	AwsAccountId    string    `json:"aws_account_id,omitempty" yaml:"aws_account_id,omitempty" required:"false" doc:"Not in use"`
+	AwsRegion       string    `json:"aws_region,omitempty" yaml:"aws_region,omitempty" required:"false" doc:"If the target is an AWS S3 bucket, use this parameter to specify the AWS region of the bucket"`
+	AwsRole         string    `json:"aws_role,omitempty" yaml:"aws_role,omitempty" required:"false" doc:"Not in use"`
+	BucketName      string    `json:"bucket_name,omitempty" yaml:"bucket_name,omitempty" required:"false" doc:"The S3 bucket name of an existing S3 bucket that you want to configure as the replication target"`
+	CustomBucketUrl string    `json:"custom_bucket_url,omitempty" yaml:"custom_bucket_url,omitempty" required:"false" doc:"custom bucket url"`
+	HttpProtocol    string    `json:"http_protocol,omitempty" yaml:"http_protocol,omitempty" required:"false" doc:"For custom S3 buckets (not AWS), the protocol to use to connect to the bucket. Can be http or https."`
+	Name            string    `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Name"`
+	Proxies         *[]string `json:"proxies,omitempty" yaml:"proxies,omitempty" required:"false" doc:"If configured, replication traffic is routed via proxies. Separate with commas. Format: http://USERNAME:PASSWORD@IP:PORT"`
+	SecretKey       string    `json:"secret_key,omitempty" yaml:"secret_key,omitempty" required:"false" doc:"The secret key of a valid key pair for accessing the destination S3 bucket"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -194,6 +221,44 @@ func (r *S3replicationPeers) CreateWithContext(ctx context.Context, req *S3repli
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing s3replicationpeers by ID and returns its details
+// summary: Modify an S3 Replication Peer
+func (r *S3replicationPeers) Update(id any, req *S3replicationPeersUpdateBody) (*S3replicationPeersDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing s3replicationpeers by ID and returns its details using provided context
+// summary: Modify an S3 Replication Peer
+func (r *S3replicationPeers) UpdateWithContext(ctx context.Context, id any, req *S3replicationPeersUpdateBody) (*S3replicationPeersDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response S3replicationPeersDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -298,8 +363,3 @@ func (r *S3replicationPeers) MustExistsWithContext(ctx context.Context, req *S3r
 	}
 	return r.Untyped.GetResourceMap()[r.GetResourceType()].MustExistsWithContext(ctx, params)
 }
-
-// -----------------------------------------------------
-// GENERATION ISSUES
-// -----------------------------------------------------
-//   - UPDATE operation excluded: PATCH/PUT /replicationtargets/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -25,14 +26,14 @@ type Snapshot struct {
 
 // SnapshotSearchParams represents the search parameters for Snapshot operations
 type SnapshotSearchParams struct {
-	Name           expr.StrField `json:"name,omitempty" yaml:"name,omitempty" required:"true" doc:""`
-	ExpirationTime expr.StrField `json:"expiration_time,omitempty" yaml:"expiration_time,omitempty" required:"false" doc:"Filter by expiration time"`
-	Guid           expr.StrField `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:""`
-	Locked         bool          `json:"locked,omitempty" yaml:"locked,omitempty" required:"false" doc:"Filter for locked snapshots"`
-	Path           expr.StrField `json:"path,omitempty" yaml:"path,omitempty" required:"false" doc:"Filter by snapshot path"`
-	State          expr.StrField `json:"state,omitempty" yaml:"state,omitempty" required:"false" doc:"Filter by state"`
-	TenantId       expr.IntField `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Filter by tenant. Specify tenant ID."`
-	VolumeId       expr.IntField `json:"volume_id,omitempty" yaml:"volume_id,omitempty" required:"false" doc:"Mapped volume id to filter by."`
+	Name           expr.StrField  `json:"name,omitempty" yaml:"name,omitempty" required:"true" doc:""`
+	ExpirationTime expr.StrField  `json:"expiration_time,omitempty" yaml:"expiration_time,omitempty" required:"false" doc:"Filter by expiration time"`
+	Guid           expr.StrField  `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:""`
+	Locked         expr.BoolField `json:"locked,omitempty" yaml:"locked,omitempty" required:"false" doc:"Filter for locked snapshots"`
+	Path           expr.StrField  `json:"path,omitempty" yaml:"path,omitempty" required:"false" doc:"Filter by snapshot path"`
+	State          expr.StrField  `json:"state,omitempty" yaml:"state,omitempty" required:"false" doc:"Filter by state"`
+	TenantId       expr.IntField  `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Filter by tenant. Specify tenant ID."`
+	VolumeId       expr.IntField  `json:"volume_id,omitempty" yaml:"volume_id,omitempty" required:"false" doc:"Mapped volume id to filter by."`
 
 	// RawData allows passing arbitrary search parameters as key-value pairs.
 	//
@@ -66,6 +67,26 @@ type SnapshotRequestBody struct {
 	TenantId       int64  `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Tenant ID"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// SnapshotUpdateBody represents the request body for Snapshot Update operations
+// Generated from PATCH request body for resource: /snapshots/{id}/
+type SnapshotUpdateBody struct {
+	ExpirationTime string `json:"expiration_time,omitempty" yaml:"expiration_time,omitempty" required:"false" doc:"Snapshot expiration time. Cannot be shortened if the snapshot is indestructible."`
+	Indestructible bool   `json:"indestructible,omitempty" yaml:"indestructible,omitempty" required:"false" doc:"Set to true to protect the snapshot from accidental or malicious deletion with the indestructibility feature. If this setting is enabled, authorized unlocking of the cluster's indestructibility mechanism is required to do any of the following: deleting the snapshot, shortening its expiration time or disabling this setting."`
+	Locked         bool   `json:"locked,omitempty" yaml:"locked,omitempty" required:"false" doc:"Not in use."`
+	Name           string `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Snapshot name"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -193,6 +214,44 @@ func (r *Snapshot) CreateWithContext(ctx context.Context, req *SnapshotRequestBo
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing snapshot by ID and returns its details
+// summary: Modify a Snapshot
+func (r *Snapshot) Update(id any, req *SnapshotUpdateBody) (*SnapshotDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing snapshot by ID and returns its details using provided context
+// summary: Modify a Snapshot
+func (r *Snapshot) UpdateWithContext(ctx context.Context, id any, req *SnapshotUpdateBody) (*SnapshotDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response SnapshotDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -302,4 +361,3 @@ func (r *Snapshot) MustExistsWithContext(ctx context.Context, req *SnapshotSearc
 // GENERATION ISSUES
 // -----------------------------------------------------
 //   - Extra method POST /snapshots/{id}/clone/ skipped: POST /snapshots/{id}/clone/ - Response schema contains ambiguous nested objects (objects with no properties)
-//   - UPDATE operation excluded: PATCH/PUT /snapshots/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -25,12 +26,12 @@ type SnapshotPolicy struct {
 
 // SnapshotPolicySearchParams represents the search parameters for SnapshotPolicy operations
 type SnapshotPolicySearchParams struct {
-	Name               expr.StrField `json:"name,omitempty" yaml:"name,omitempty" required:"true" doc:""`
-	Enabled            bool          `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"Filter to return only enabled snapshots"`
-	Guid               expr.StrField `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:""`
-	LastOperationState expr.StrField `json:"last_operation_state,omitempty" yaml:"last_operation_state,omitempty" required:"false" doc:"Filter by last operation state"`
-	Path               expr.StrField `json:"path,omitempty" yaml:"path,omitempty" required:"false" doc:"Filter by snapshot path"`
-	Schedule           expr.StrField `json:"schedule,omitempty" yaml:"schedule,omitempty" required:"false" doc:"Filter by schedule"`
+	Name               expr.StrField  `json:"name,omitempty" yaml:"name,omitempty" required:"true" doc:""`
+	Enabled            expr.BoolField `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"Filter to return only enabled snapshots"`
+	Guid               expr.StrField  `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:""`
+	LastOperationState expr.StrField  `json:"last_operation_state,omitempty" yaml:"last_operation_state,omitempty" required:"false" doc:"Filter by last operation state"`
+	Path               expr.StrField  `json:"path,omitempty" yaml:"path,omitempty" required:"false" doc:"Filter by snapshot path"`
+	Schedule           expr.StrField  `json:"schedule,omitempty" yaml:"schedule,omitempty" required:"false" doc:"Filter by schedule"`
 
 	// RawData allows passing arbitrary search parameters as key-value pairs.
 	//
@@ -65,6 +66,28 @@ type SnapshotPolicyRequestBody struct {
 	SnapshotExpiration  string `json:"snapshot_expiration,omitempty" yaml:"snapshot_expiration,omitempty" required:"false" doc:"Snapshot expiration time"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// SnapshotPolicyUpdateBody represents the request body for SnapshotPolicy Update operations
+// Generated from PATCH request body for resource: /snapshotpolicies/{id}/
+type SnapshotPolicyUpdateBody struct {
+	Enabled             bool   `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"Enable the policy"`
+	MaxCreatedSnapshots int64  `json:"max_created_snapshots,omitempty" yaml:"max_created_snapshots,omitempty" required:"false" doc:"The maximum number of snapshots that will be retained locally"`
+	Name                string `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Snapshot policy name"`
+	Prefix              string `json:"prefix,omitempty" yaml:"prefix,omitempty" required:"false" doc:"The prefix of the snapshot that will be created"`
+	Schedule            string `json:"schedule,omitempty" yaml:"schedule,omitempty" required:"false" doc:"The schedule to take the snapshot"`
+	SnapshotExpiration  string `json:"snapshot_expiration,omitempty" yaml:"snapshot_expiration,omitempty" required:"false" doc:"Snapshot expiration time"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -192,6 +215,44 @@ func (r *SnapshotPolicy) CreateWithContext(ctx context.Context, req *SnapshotPol
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing snapshotpolicy by ID and returns its details
+// summary: Modify a Snapshot Policy (deprecated from VAST Cluster 3.4)
+func (r *SnapshotPolicy) Update(id any, req *SnapshotPolicyUpdateBody) (*SnapshotPolicyDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing snapshotpolicy by ID and returns its details using provided context
+// summary: Modify a Snapshot Policy (deprecated from VAST Cluster 3.4)
+func (r *SnapshotPolicy) UpdateWithContext(ctx context.Context, id any, req *SnapshotPolicyUpdateBody) (*SnapshotPolicyDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response SnapshotPolicyDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -290,8 +351,3 @@ func (r *SnapshotPolicy) MustExistsWithContext(ctx context.Context, req *Snapsho
 	}
 	return r.Untyped.GetResourceMap()[r.GetResourceType()].MustExistsWithContext(ctx, params)
 }
-
-// -----------------------------------------------------
-// GENERATION ISSUES
-// -----------------------------------------------------
-//   - UPDATE operation excluded: PATCH/PUT /snapshotpolicies/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -262,3 +262,4 @@ func (r *TlsCertificate) TlsCertificateIsOperationHealthy_POST() (*TlsCertificat
 // GENERATION ISSUES
 // -----------------------------------------------------
 //   - UPDATE operation excluded: PATCH/PUT /tlscertificates/{id}/ has no response schema and doesn't return 204 NO CONTENT
+//   - UpdateBody skipped: PATCH /tlscertificates/{id}/: request body is multipart/form-data only, typed Update sends JSON
@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
@@ -68,6 +68,32 @@ type UserRequestBody struct {
 	Uid               int64    `json:"uid,omitempty" yaml:"uid,omitempty" required:"false" doc:"NFS UID"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// UserUpdateBody represents the request body for User Update operations
+// Generated from PATCH request body for resource: /users/{id}/
+type UserUpdateBody struct {
+	AllowCreateBucket bool     `json:"allow_create_bucket,omitempty" yaml:"allow_create_bucket,omitempty" required:"false" doc:"Set to true to give the user permission to create S3 buckets. In case of conflict with an S3 identity policy attached to the user or to a relevant group, this setting is overridden."`
+	AllowDeleteBucket bool     `json:"allow_delete_bucket,omitempty" yaml:"allow_delete_bucket,omitempty" required:"false" doc:"Set to true to give the user permission to delete S3 buckets. In case of conflict with an S3 identity policy attached to the user or to a relevant group, this setting is overridden."`
+	Gids              *[]int64 `json:"gids,omitempty" yaml:"gids,omitempty" required:"false" doc:"List of group GIDs of all groups to which the user should belong."`
+	LeadingGid        int64    `json:"leading_gid,omitempty" yaml:"leading_gid,omitempty" required:"false" doc:"Leading GID"`
+	Local             bool     `json:"local,omitempty" yaml:"local,omitempty" required:"false" doc:"Not in use."`
+	LocalProviderId   int64    `json:"local_provider_id,omitempty" yaml:"local_provider_id,omitempty" required:"false" doc:"The ID of the local provider to which to add the user"`
+	Password          string   `json:"password,omitempty" yaml:"password,omitempty" required:"false" doc:"Password"`
+	S3PoliciesIds     *[]int64 `json:"s3_policies_ids,omitempty" yaml:"s3_policies_ids,omitempty" required:"false" doc:"Specify S3 policies to attach to the user."`
+	S3Superuser       bool     `json:"s3_superuser,omitempty" yaml:"s3_superuser,omitempty" required:"false" doc:"Set to true to give the user S3 superuser permission. In case of conflict with an S3 identity policy attached to the user or to a relevant group, this setting is overridden."`
+	Uid               int64    `json:"uid,omitempty" yaml:"uid,omitempty" required:"false" doc:"NFS UID"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -195,6 +221,44 @@ func (r *User) CreateWithContext(ctx context.Context, req *UserRequestBody) (*Us
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing user by ID and returns its details
+// summary: Modify Local User
+func (r *User) Update(id any, req *UserUpdateBody) (*UserDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing user by ID and returns its details using provided context
+// summary: Modify Local User
+func (r *User) UpdateWithContext(ctx context.Context, id any, req *UserUpdateBody) (*UserDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response UserDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -687,4 +751,3 @@ func (r *User) UserTenantData_PATCH(id any, body *UserTenantData_PATCH_Body) (*U
 //   - Extra method GET /users/query/ skipped: GET /users/query/ - Response schema contains ambiguous nested objects (objects with no properties)
 //   - Extra method PATCH /users/query/ skipped: PATCH /users/query/ - Response schema contains ambiguous nested objects (objects with no properties)
 //   - Extra method PATCH /users/refresh/ skipped: PATCH /users/refresh/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /users/refresh/
-//   - UPDATE operation excluded: PATCH/PUT /users/{id}/ has no response schema and doesn't return 204 NO CONTENT
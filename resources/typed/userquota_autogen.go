@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -25,14 +26,14 @@ type UserQuota struct {
 
 // UserQuotaSearchParams represents the search parameters for UserQuota operations
 type UserQuotaSearchParams struct {
-	Guid          expr.StrField `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:"Quota guid"`
-	IsAccountable bool          `json:"is_accountable,omitempty" yaml:"is_accountable,omitempty" required:"false" doc:"Set to true to list only user and group quotas for users and groups that have defined user quotas. Set to false to list only user quotas for users and groups that wrote to the directory but have no defined user/group quota rules."`
-	Name          expr.StrField `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"A user or group name"`
-	Path          expr.StrField `json:"path,omitempty" yaml:"path,omitempty" required:"false" doc:""`
-	QuotaGroupId  expr.IntField `json:"quota_group_id,omitempty" yaml:"quota_group_id,omitempty" required:"false" doc:"Quota Group ID"`
-	QuotaId       expr.IntField `json:"quota_id,omitempty" yaml:"quota_id,omitempty" required:"false" doc:"Filter by specific directory quota"`
-	QuotaSystemId expr.IntField `json:"quota_system_id,omitempty" yaml:"quota_system_id,omitempty" required:"false" doc:"Filters the list to show only user quotas that belong to a quota with a specified system_id number. This is different to quota ID"`
-	RefreshData   bool          `json:"refresh_data,omitempty" yaml:"refresh_data,omitempty" required:"false" doc:"Set to true to refresh data before returning a response, hence guaranteeing fresh data. If false, the data is fetched from the VMS database without refreshing. Response may be quicker with this parameter set to false."`
+	Guid          expr.StrField  `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:"Quota guid"`
+	IsAccountable expr.BoolField `json:"is_accountable,omitempty" yaml:"is_accountable,omitempty" required:"false" doc:"Set to true to list only user and group quotas for users and groups that have defined user quotas. Set to false to list only user quotas for users and groups that wrote to the directory but have no defined user/group quota rules."`
+	Name          expr.StrField  `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"A user or group name"`
+	Path          expr.StrField  `json:"path,omitempty" yaml:"path,omitempty" required:"false" doc:""`
+	QuotaGroupId  expr.IntField  `json:"quota_group_id,omitempty" yaml:"quota_group_id,omitempty" required:"false" doc:"Quota Group ID"`
+	QuotaId       expr.IntField  `json:"quota_id,omitempty" yaml:"quota_id,omitempty" required:"false" doc:"Filter by specific directory quota"`
+	QuotaSystemId expr.IntField  `json:"quota_system_id,omitempty" yaml:"quota_system_id,omitempty" required:"false" doc:"Filters the list to show only user quotas that belong to a quota with a specified system_id number. This is different to quota ID"`
+	RefreshData   expr.BoolField `json:"refresh_data,omitempty" yaml:"refresh_data,omitempty" required:"false" doc:"Set to true to refresh data before returning a response, hence guaranteeing fresh data. If false, the data is fetched from the VMS database without refreshing. Response may be quicker with this parameter set to false."`
 
 	// RawData allows passing arbitrary search parameters as key-value pairs.
 	//
@@ -70,6 +71,27 @@ type UserQuotaRequestBody struct {
 	SoftLimitInodes int64  `json:"soft_limit_inodes,omitempty" yaml:"soft_limit_inodes,omitempty" required:"false" doc:"Number of directories and unique files under the path at which warnings of exceeding the quota will be issued for the user/group. A file with multiple hardlinks is counted only once."`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// UserQuotaUpdateBody represents the request body for UserQuota Update operations
+// Generated from PATCH request body for resource: /userquotas/{id}/
+type UserQuotaUpdateBody struct {
+	GracePeriod     string `json:"grace_period,omitempty" yaml:"grace_period,omitempty" required:"false" doc:"Quota enforcement grace period. An alarm is triggered and write operations are blocked if storage usage continues to exceed the soft limit for the grace period. Format: [DD] [HH:[MM:]]s"`
+	HardLimit       int64  `json:"hard_limit,omitempty" yaml:"hard_limit,omitempty" required:"false" doc:"Storage usage limit beyond which no writes will be allowed."`
+	HardLimitInodes int64  `json:"hard_limit_inodes,omitempty" yaml:"hard_limit_inodes,omitempty" required:"false" doc:"Number of directories and unique files under the path beyond which no writes will be allowed. A file with multiple hardlinks is counted only once."`
+	SoftLimit       int64  `json:"soft_limit,omitempty" yaml:"soft_limit,omitempty" required:"false" doc:"Storage usage limit at which warnings of exceeding the quota are issued."`
+	SoftLimitInodes int64  `json:"soft_limit_inodes,omitempty" yaml:"soft_limit_inodes,omitempty" required:"false" doc:"Number of directories and unique files under the path at which warnings of exceeding the quota will be issued. A file with multiple hardlinks is counted only once."`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -197,6 +219,44 @@ func (r *UserQuota) CreateWithContext(ctx context.Context, req *UserQuotaRequest
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing userquota by ID and returns its details
+// summary: Modify User Quota
+func (r *UserQuota) Update(id any, req *UserQuotaUpdateBody) (*UserQuotaDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing userquota by ID and returns its details using provided context
+// summary: Modify User Quota
+func (r *UserQuota) UpdateWithContext(ctx context.Context, id any, req *UserQuotaUpdateBody) (*UserQuotaDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response UserQuotaDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -301,8 +361,3 @@ func (r *UserQuota) MustExistsWithContext(ctx context.Context, req *UserQuotaSea
 	}
 	return r.Untyped.GetResourceMap()[r.GetResourceType()].MustExistsWithContext(ctx, params)
 }
-
-// -----------------------------------------------------
-// GENERATION ISSUES
-// -----------------------------------------------------
-//   - UPDATE operation excluded: PATCH/PUT /userquotas/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
@@ -29,15 +29,15 @@ type View struct {
 
 // ViewSearchParams represents the search parameters for View operations
 type ViewSearchParams struct {
-	Alias              expr.StrField `json:"alias,omitempty" yaml:"alias,omitempty" required:"false" doc:"Filter by NFS export alias"`
-	Bucket             expr.StrField `json:"bucket,omitempty" yaml:"bucket,omitempty" required:"false" doc:"Limit response by S3 bucket name"`
-	Guid               expr.StrField `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:""`
-	IsDefaultSubsystem bool          `json:"is_default_subsystem,omitempty" yaml:"is_default_subsystem,omitempty" required:"false" doc:"Filter by whether View is a default Subsystem."`
-	Name               expr.StrField `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Filter by View name"`
-	Nqn                expr.StrField `json:"nqn,omitempty" yaml:"nqn,omitempty" required:"false" doc:"NVMe Qualified Name to filter by."`
-	Path               expr.StrField `json:"path,omitempty" yaml:"path,omitempty" required:"false" doc:"Filter by Element Store path"`
-	Share              expr.StrField `json:"share,omitempty" yaml:"share,omitempty" required:"false" doc:"Filter by share name"`
-	TenantId           expr.IntField `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Filter by tenant. Specify tenant ID."`
+	Alias              expr.StrField  `json:"alias,omitempty" yaml:"alias,omitempty" required:"false" doc:"Filter by NFS export alias"`
+	Bucket             expr.StrField  `json:"bucket,omitempty" yaml:"bucket,omitempty" required:"false" doc:"Limit response by S3 bucket name"`
+	Guid               expr.StrField  `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:""`
+	IsDefaultSubsystem expr.BoolField `json:"is_default_subsystem,omitempty" yaml:"is_default_subsystem,omitempty" required:"false" doc:"Filter by whether View is a default Subsystem."`
+	Name               expr.StrField  `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Filter by View name"`
+	Nqn                expr.StrField  `json:"nqn,omitempty" yaml:"nqn,omitempty" required:"false" doc:"NVMe Qualified Name to filter by."`
+	Path               expr.StrField  `json:"path,omitempty" yaml:"path,omitempty" required:"false" doc:"Filter by Element Store path"`
+	Share              expr.StrField  `json:"share,omitempty" yaml:"share,omitempty" required:"false" doc:"Filter by share name"`
+	TenantId           expr.IntField  `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Filter by tenant. Specify tenant ID."`
 
 	// RawData allows passing arbitrary search parameters as key-value pairs.
 	//
@@ -175,6 +175,111 @@ type ViewRequestBody struct {
 	UserImpersonation             ViewRequestBody_UserImpersonation         `json:"user_impersonation,omitempty" yaml:"user_impersonation,omitempty" required:"false" doc:""`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// ViewUpdateBody_BucketLogging represents a nested type for update body
+type ViewUpdateBody_BucketLogging struct {
+	DestinationId int64  `json:"destination_id,omitempty" yaml:"destination_id,omitempty" required:"true" doc:"Specifies a view ID as the destination bucket for S3 bucket logging. The specified view must have the S3 bucket protocol enabled, must be on the same tenant as the view itself (the source view), must have the same bucket owner, and cannot be the same view as the source view. It also must not have S3 object locking enabled. In bucket logging, a log entry is created in AWS log format for each request made to the source bucket. The log entries are periodically uploaded to the destination bucket. Configuring destination_id enables S3 bucket logging for the view."`
+	KeyFormat     string `json:"key_format,omitempty" yaml:"key_format,omitempty" required:"false" doc:"The format for the S3 bucket logging object keys. SIMPLE_PREFIX=[DestinationPrefix][YYYY]-[MM]-[DD]-[hh]-[mm]-[ss]-[UniqueString], PARTITIONED_PREFIX_EVENT_TIME=[DestinationPrefix][SourceUsername]/[SourceBucket]/[YYYY]/[MM]/[DD]/[YYYY]-[MM]-[DD]-[hh]-[mm]-[ss]-[UniqueString] where the partitioning is done based on the time when the logged events occurred, PARTITIONED_PREFIX_DELIVERY_TIME=[DestinationPrefix][SourceUsername]/[SourceBucket]/[YYYY]/[MM]/[DD]/[YYYY]-[MM]-[DD]-[hh]-[mm]-[ss]-[UniqueString] where the partitioning is done based on the time when the log object has been delivered to the destination bucket. Default: SIMPLE_PREFIX"`
+	Prefix        string `json:"prefix,omitempty" yaml:"prefix,omitempty" required:"false" doc:"Specifies a prefix to be prepended to each key of a log object uploaded to the destination bucket. This prefix can be used to categorize log objects; for example, if you use the same destination bucket for multiple source buckets. The prefix can be up to 128 characters and must follow S3 object naming rules."`
+}
+
+// ViewUpdateBody_EventNotificationsItem represents a nested type for update body
+type ViewUpdateBody_EventNotificationsItem struct {
+	BrokerId     int64     `json:"broker_id,omitempty" yaml:"broker_id,omitempty" required:"false" doc:"Event broker ID"`
+	Name         string    `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Event unique name"`
+	PrefixFilter string    `json:"prefix_filter,omitempty" yaml:"prefix_filter,omitempty" required:"false" doc:"Event prefix filter"`
+	SuffixFilter string    `json:"suffix_filter,omitempty" yaml:"suffix_filter,omitempty" required:"false" doc:"Event suffix filter"`
+	Topic        string    `json:"topic,omitempty" yaml:"topic,omitempty" required:"false" doc:"Event topic"`
+	Triggers     *[]string `json:"triggers,omitempty" yaml:"triggers,omitempty" required:"false" doc:"Event triggers"`
+}
+
+// ViewUpdateBody_ShareAcl represents a nested type for update body
+type ViewUpdateBody_ShareAcl struct {
+	Acl     *[]ViewUpdateBody_ShareAcl_AclItem `json:"acl,omitempty" yaml:"acl,omitempty" required:"false" doc:"Share-level ACL"`
+	Enabled bool                               `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"True if Share ACL is enabled on the view, otherwise False"`
+}
+
+// ViewUpdateBody_ShareAcl_AclItem represents a nested type for update body
+type ViewUpdateBody_ShareAcl_AclItem struct {
+	Fqdn     string `json:"fqdn,omitempty" yaml:"fqdn,omitempty" required:"false" doc:"FQDN of the chosen grantee"`
+	Grantee  string `json:"grantee,omitempty" yaml:"grantee,omitempty" required:"false" doc:"grantee type"`
+	Name     string `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"name of the chosen grantee"`
+	Perm     string `json:"perm,omitempty" yaml:"perm,omitempty" required:"false" doc:"Grantee’s permissions"`
+	SidStr   string `json:"sid_str,omitempty" yaml:"sid_str,omitempty" required:"false" doc:"grantee’s SID"`
+	UidOrGid int64  `json:"uid_or_gid,omitempty" yaml:"uid_or_gid,omitempty" required:"false" doc:"grantee’s uid (if user) or gid (if group)"`
+}
+
+// ViewUpdateBody_UserImpersonation represents a nested type for update body
+type ViewUpdateBody_UserImpersonation struct {
+	Enabled        bool   `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"True if user impersonation is enabled"`
+	Identifier     string `json:"identifier,omitempty" yaml:"identifier,omitempty" required:"false" doc:"Identifier of the user to impersonate"`
+	IdentifierType string `json:"identifier_type,omitempty" yaml:"identifier_type,omitempty" required:"false" doc:"The identifier type of the specified identifier."`
+	LoginName      string `json:"login_name,omitempty" yaml:"login_name,omitempty" required:"false" doc:"Full username of user to impersonate, including domain name"`
+	Username       string `json:"username,omitempty" yaml:"username,omitempty" required:"false" doc:"The username of the user to impersonate"`
+}
+
+// ViewUpdateBody represents the request body for View Update operations
+// Generated from PATCH request body for resource: /views/{id}/
+type ViewUpdateBody struct {
+	AbeMaxDepth                   int64                                    `json:"abe_max_depth,omitempty" yaml:"abe_max_depth,omitempty" required:"false" doc:"Restricts ABE to a specified path depth. For example, if max depth is 3, ABE does not affect paths deeper than three levels. If not specified, ABE affects all path depths."`
+	AbeProtocols                  *[]string                                `json:"abe_protocols,omitempty" yaml:"abe_protocols,omitempty" required:"false" doc:"The protocols for which Access-Based Enumeration (ABE) is enabled"`
+	Alias                         string                                   `json:"alias,omitempty" yaml:"alias,omitempty" required:"false" doc:"For NFS-enabled views, a view alias for NFSv3 clients."`
+	AllowAnonymousAccess          bool                                     `json:"allow_anonymous_access,omitempty" yaml:"allow_anonymous_access,omitempty" required:"false" doc:"Not in use"`
+	AllowS3AnonymousAccess        bool                                     `json:"allow_s3_anonymous_access,omitempty" yaml:"allow_s3_anonymous_access,omitempty" required:"false" doc:"Allow S3 anonymous access to S3 bucket. If true, anonymous requests are granted provided that the object ACL grants access to the All Users group (in S3 Native security flavor) or the permission mode bits on the requested file and directory path grant access permission to 'others' (in NFS security flavor)."`
+	AllowedDelegations            string                                   `json:"allowed_delegations,omitempty" yaml:"allowed_delegations,omitempty" required:"false" doc:"Defines which types of NFSv4 file delegations are enabled for this view. - 'NONE' means NFSv4 file delegations are disabled. - 'READ' means read type NFSv4 file delegations can be granted to a client opening a file. - 'WRITE' means write type NFSv4 file delegations can be granted to a client opening a file. - 'READ_WRITE' means both read and write type NFSv4 file delegations can be granted to a client opening a file. - 'USE_TENANT_ALLOWED_DELEG' (default) means the view inherits the tenant’s 'allowed_delegations'. **Important:** If the tenant has 'DISABLED' delegations, this overrides the view entirely. The effective delegations value for this view is forced to 'NONE', regardless of the view’s setting."`
+	AutoCommit                    string                                   `json:"auto_commit,omitempty" yaml:"auto_commit,omitempty" required:"false" doc:"Applicable if locking is enabled. Sets the auto-commit time for files that are locked automatically. These files are locked automatically after the auto-commit period elapses from the time the file is saved. Files locked automatically are locked for the default-retention-period, after which they are unlocked. Specify as an integer value followed by a letter for the unit (h - hours, d - days, y - years). Example: 2h (2 hours)."`
+	Bucket                        string                                   `json:"bucket,omitempty" yaml:"bucket,omitempty" required:"false" doc:"Not yet implemented"`
+	BucketCreators                *[]string                                `json:"bucket_creators,omitempty" yaml:"bucket_creators,omitempty" required:"false" doc:"For S3 endpoint views, specify a list of users, by user name, whose bucket create requests use this view. Any request to create an S3 bucket that is sent by S3 API by a specified user will use this S3 Endpoint view. Users should not be specified as bucket creators in more than one S3 Endpoint view. Naming a user as a bucket creator in two S3 Endpoint views will fail the creation of the view with an error."`
+	BucketCreatorsGroups          *[]string                                `json:"bucket_creators_groups,omitempty" yaml:"bucket_creators_groups,omitempty" required:"false" doc:"For S3 endpoint views, specify a list of groups, by group name, whose bucket create requests use this view. Any request to create an S3 bucket that is sent by S3 API by a user who belongs to a group listed here will use this S3 Endpoint view. Take extra care not to duplicate bucket creators through groups: If you specify a group as a bucket creator group in one view and you also specify a user who belongs to that group as a bucket creator user in another view, view creation will not fail. Yet, there is a conflict between the two configurations and the selection of a view for configuring the user's buckets is not predictable."`
+	BucketLogging                 ViewUpdateBody_BucketLogging             `json:"bucket_logging,omitempty" yaml:"bucket_logging,omitempty" required:"false" doc:""`
+	BucketOwner                   string                                   `json:"bucket_owner,omitempty" yaml:"bucket_owner,omitempty" required:"false" doc:"S3 Bucket owner"`
+	BucketOwnerType               string                                   `json:"bucket_owner_type,omitempty" yaml:"bucket_owner_type,omitempty" required:"false" doc:""`
+	CreateDir                     bool                                     `json:"create_dir,omitempty" yaml:"create_dir,omitempty" required:"false" doc:"Create a directory at the specified path"`
+	DefaultRetentionPeriod        string                                   `json:"default_retention_period,omitempty" yaml:"default_retention_period,omitempty" required:"false" doc:"Relevant if locking is enabled. Required if s3_locks_retention_mode is set to governance or compliance. Specifies a default retention period for objects in the bucket. If set, object versions that are placed in the bucket are automatically protected with the specified retention lock. Otherwise, by default, each object version has no automatic protection but can be configured with a retention period or legal hold. Specify as an integer followed by h for hours, d for days, m for months, or y for years. For example: 2d or 1y."`
+	EventNotifications            *[]ViewUpdateBody_EventNotificationsItem `json:"event_notifications,omitempty" yaml:"event_notifications,omitempty" required:"false" doc:""`
+	FilesRetentionMode            string                                   `json:"files_retention_mode,omitempty" yaml:"files_retention_mode,omitempty" required:"false" doc:"Applicable if locking is enabled. The retention mode for new files. For views enabled for NFSv3 or SMB, if locking is enabled, files_retention_mode must be set to GOVERNANCE or COMPLIANCE. If the view is enabled for S3 and not for NFSv3 or SMB, files_retention_mode can be set to NONE. If GOVERNANCE, locked files cannot be deleted or changed. The Retention settings can be shortened or extended by users with sufficient permissions. If COMPLIANCE, locked files cannot be deleted or changed. Retention settings can be extended, but not shortened, by users with sufficient permissions. If NONE (S3 only), the retention mode is not set for the view; it is set individually for each object."`
+	IndestructibleObjectDuration  int64                                    `json:"indestructible_object_duration,omitempty" yaml:"indestructible_object_duration,omitempty" required:"false" doc:"Retention period for objects, in days. Each object in the bucket is protected from deletion, overwriting, renaming and metadata changes for the specified number of days after its creation date."`
+	IsDefaultSubsystem            bool                                     `json:"is_default_subsystem,omitempty" yaml:"is_default_subsystem,omitempty" required:"false" doc:"Set to true to set view to be the default subsystem for block storage. There can be up to one default subsystem per tenant. The default subsystem is the default view selected when creating a block volume if no view is specified."`
+	IsIndestructibleObjectEnabled bool                                     `json:"is_indestructible_object_enabled,omitempty" yaml:"is_indestructible_object_enabled,omitempty" required:"false" doc:"Set to true to enable indestructible object mode on the view. This is supported only if S3 is the only specified protocol. Other limitations also apply."`
+	IsKafkaEncryptedConnAllowed   bool                                     `json:"is_kafka_encrypted_conn_allowed,omitempty" yaml:"is_kafka_encrypted_conn_allowed,omitempty" required:"false" doc:"True if encrypted connection is allowed for Kafka"`
+	IsKafkaUnencryptedConnAllowed bool                                     `json:"is_kafka_unencrypted_conn_allowed,omitempty" yaml:"is_kafka_unencrypted_conn_allowed,omitempty" required:"false" doc:"True if unencrypted connection is allowed for Kafka"`
+	IsSeamless                    bool                                     `json:"is_seamless,omitempty" yaml:"is_seamless,omitempty" required:"false" doc:"Supports seamless failover between replication peers by syncing file handles between the view and remote views on the replicated path on replication peers. This enables NFSv3 client users to retain the same mount point to the view in the event of a failover of the view path to a replication peer. This feature enables NFSv3 client users to retain the same mount point to the view in the event of a failover of the view path to a replication peer. Enabling this option may cause overhead and should only be enabled when the use case is relevant. To complete the configuration for seamless failover between any two peers, a seamless view must be created on each peer."`
+	KafkaEncryptedAuthMechanism   string                                   `json:"kafka_encrypted_auth_mechanism,omitempty" yaml:"kafka_encrypted_auth_mechanism,omitempty" required:"false" doc:"Authentication mechanism for encrypted connection"`
+	KafkaFirstJoinGroupTimeoutSec int64                                    `json:"kafka_first_join_group_timeout_sec,omitempty" yaml:"kafka_first_join_group_timeout_sec,omitempty" required:"false" doc:"Kafka first join group timeout, in seconds"`
+	KafkaIsAuthorizationRequired  bool                                     `json:"kafka_is_authorization_required,omitempty" yaml:"kafka_is_authorization_required,omitempty" required:"false" doc:"True if authorization is required for Kafka"`
+	KafkaRejoinGroupTimeoutSec    int64                                    `json:"kafka_rejoin_group_timeout_sec,omitempty" yaml:"kafka_rejoin_group_timeout_sec,omitempty" required:"false" doc:"Kafka rejoin group timeout, in seconds"`
+	KafkaUnencryptedAuthMechanism string                                   `json:"kafka_unencrypted_auth_mechanism,omitempty" yaml:"kafka_unencrypted_auth_mechanism,omitempty" required:"false" doc:"Authentication mechanism for unencrypted connection"`
+	Locking                       bool                                     `json:"locking,omitempty" yaml:"locking,omitempty" required:"false" doc:"Set to true to enable object locking on an S3 bucket. Object locking cannot be disabled after the view is modified. Must be true if s3_versioning is true."`
+	MaxRetentionPeriod            string                                   `json:"max_retention_period,omitempty" yaml:"max_retention_period,omitempty" required:"false" doc:"Applicable if locking is enabled. Sets a maximum retention period for files that are locked in the view. Files cannot be locked for longer than this period, whether they are locked manually (by setting the atime) or automatically, using auto-commit. Specify as an integer value followed by a letter for the unit (m - minutes, h - hours, d - days, y - years). Example: 2y (2 years)."`
+	MinRetentionPeriod            string                                   `json:"min_retention_period,omitempty" yaml:"min_retention_period,omitempty" required:"false" doc:"Applicable if locking is enabled. Sets a minimum retention period for files that are locked in the view. Files cannot be locked for less than this period, whether locked manually (by setting the atime) or automatically, using auto-commit. Specify as an integer value followed by a letter for the unit (h - hours, d - days, m - months, y - years). Example: 1d (1 day)."`
+	Name                          string                                   `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"View name"`
+	NfsInteropFlags               string                                   `json:"nfs_interop_flags,omitempty" yaml:"nfs_interop_flags,omitempty" required:"false" doc:"Indicates whether the view should support simultaneous access to NFS3/NFS4/SMB protocols."`
+	Path                          string                                   `json:"path,omitempty" yaml:"path,omitempty" required:"false" doc:"View path"`
+	PolicyId                      int64                                    `json:"policy_id,omitempty" yaml:"policy_id,omitempty" required:"false" doc:"View policy ID. Specify to change which view policy the view uses. Every view must be attached to one view policy, which specifies further configurations."`
+	Protocols                     *[]string                                `json:"protocols,omitempty" yaml:"protocols,omitempty" required:"false" doc:"Client protocols enabled for access to the view. 'NFS' enables access from NFS version 3, 'NFS4' enables access from NFS version 4.1 and 4.2, S3' creates an S3 bucket on the view, 'ENDPOINT' creates an S3 endpoint, used as template for views created via S3 RPCs, DATABASE exposes the view as a VAST database. KAFKA enables events related to elements on the view path to be published to the VAST Event Broker. BLOCK exposes the view as a block storage subsystem."`
+	QosPolicy                     string                                   `json:"qos_policy,omitempty" yaml:"qos_policy,omitempty" required:"false" doc:"QoS Policy"`
+	QosPolicyId                   int64                                    `json:"qos_policy_id,omitempty" yaml:"qos_policy_id,omitempty" required:"false" doc:"Associates a QoS policy with the view."`
+	S3LocksRetentionMode          string                                   `json:"s3_locks_retention_mode,omitempty" yaml:"s3_locks_retention_mode,omitempty" required:"false" doc:"The retention mode for new object versions stored in this bucket. You can override this if you upload a new object version with an explicit retention mode and period."`
+	S3ObjectOwnershipRule         string                                   `json:"s3_object_ownership_rule,omitempty" yaml:"s3_object_ownership_rule,omitempty" required:"false" doc:""`
+	S3UnverifiedLookup            bool                                     `json:"s3_unverified_lookup,omitempty" yaml:"s3_unverified_lookup,omitempty" required:"false" doc:"S3 Unverified Lookup"`
+	S3Versioning                  bool                                     `json:"s3_versioning,omitempty" yaml:"s3_versioning,omitempty" required:"false" doc:"Enable S3 Versioning if S3 bucket. Versioning cannot be disabled after the view is modified."`
+	SelectForLiveMonitoring       bool                                     `json:"select_for_live_monitoring,omitempty" yaml:"select_for_live_monitoring,omitempty" required:"false" doc:"Enables live monitoring on the view. Live monitoring can be enabled for up to ten views at one time. Analytics data for views is polled every 5 minutes by default and every 10 seconds with live monitoring."`
+	Share                         string                                   `json:"share,omitempty" yaml:"share,omitempty" required:"false" doc:"SMB share name"`
+	ShareAcl                      ViewUpdateBody_ShareAcl                  `json:"share_acl,omitempty" yaml:"share_acl,omitempty" required:"false" doc:"Share-level ACL details"`
+	SmbEncryptionState            string                                   `json:"smb_encryption_state,omitempty" yaml:"smb_encryption_state,omitempty" required:"false" doc:"Defines the encryption level for SMB"`
+	UserImpersonation             ViewUpdateBody_UserImpersonation         `json:"user_impersonation,omitempty" yaml:"user_impersonation,omitempty" required:"false" doc:""`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -302,6 +407,44 @@ func (r *View) CreateWithContext(ctx context.Context, req *ViewRequestBody) (*Vi
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing view by ID and returns its details
+// summary: Modify a View
+func (r *View) Update(id any, req *ViewUpdateBody) (*ViewDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing view by ID and returns its details using provided context
+// summary: Modify a View
+func (r *View) UpdateWithContext(ctx context.Context, id any, req *ViewUpdateBody) (*ViewDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response ViewDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -993,4 +1136,3 @@ func (r *View) ViewS3corsConfiguration_GET(id any, params *ViewS3corsConfigurati
 // -----------------------------------------------------
 //   - Extra method PATCH /views/{id}/legal_hold/ skipped: PATCH /views/{id}/legal_hold/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /views/{id}/legal_hold/
 //   - Extra method POST /views/{id}/s3cors_configuration/ skipped: POST /views/{id}/s3cors_configuration/ - Array item schema is ambiguous or empty
-//   - UPDATE operation excluded: PATCH/PUT /views/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: CREATE|LIST|READ|DELETE
+// Template: CREATE|LIST|READ|UPDATE|DELETE
 
 package typed
 
@@ -26,15 +26,15 @@ type ViewPolicy struct {
 
 // ViewPolicySearchParams represents the search parameters for ViewPolicy operations
 type ViewPolicySearchParams struct {
-	AppleSid                 bool          `json:"apple_sid,omitempty" yaml:"apple_sid,omitempty" required:"false" doc:"apple sid"`
-	AtimeFrequency           expr.StrField `json:"atime_frequency,omitempty" yaml:"atime_frequency,omitempty" required:"false" doc:"Filter by atime frequency."`
-	Guid                     expr.StrField `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:"Globally unique identifier"`
-	Name                     expr.StrField `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Filter by name."`
-	NfsReturnOpenPermissions bool          `json:"nfs_return_open_permissions,omitempty" yaml:"nfs_return_open_permissions,omitempty" required:"false" doc:"Filter by enabled nfs-return-open-permissions flag"`
-	ServesTenant             expr.StrField `json:"serves_tenant,omitempty" yaml:"serves_tenant,omitempty" required:"false" doc:"Filter by served tenants. Accepts tenant ID or \"all\" for all served tenants."`
-	SmbDirectoryMode         expr.IntField `json:"smb_directory_mode,omitempty" yaml:"smb_directory_mode,omitempty" required:"false" doc:"Filter by smb_directory_mode. smb_directory_mode is the default unix permission bits applied to directories created by SMB clients. It is relevant only to views that are exposed to both SMB and NFS access protocols and have NFS security flavor."`
-	SmbFileMode              expr.IntField `json:"smb_file_mode,omitempty" yaml:"smb_file_mode,omitempty" required:"false" doc:"Filter by smb_file_mode. smb_file_mode is the default unix permission bits applied to files created by SMB clients. It is relevant only to views that are exposed to both SMB and NFS access protocols and have NFS security flavor."`
-	TenantId                 expr.IntField `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Filter by tenant. Specify tenant ID."`
+	AppleSid                 expr.BoolField `json:"apple_sid,omitempty" yaml:"apple_sid,omitempty" required:"false" doc:"apple sid"`
+	AtimeFrequency           expr.StrField  `json:"atime_frequency,omitempty" yaml:"atime_frequency,omitempty" required:"false" doc:"Filter by atime frequency."`
+	Guid                     expr.StrField  `json:"guid,omitempty" yaml:"guid,omitempty" required:"false" doc:"Globally unique identifier"`
+	Name                     expr.StrField  `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Filter by name."`
+	NfsReturnOpenPermissions expr.BoolField `json:"nfs_return_open_permissions,omitempty" yaml:"nfs_return_open_permissions,omitempty" required:"false" doc:"Filter by enabled nfs-return-open-permissions flag"`
+	ServesTenant             expr.StrField  `json:"serves_tenant,omitempty" yaml:"serves_tenant,omitempty" required:"false" doc:"Filter by served tenants. Accepts tenant ID or \"all\" for all served tenants."`
+	SmbDirectoryMode         expr.IntField  `json:"smb_directory_mode,omitempty" yaml:"smb_directory_mode,omitempty" required:"false" doc:"Filter by smb_directory_mode. smb_directory_mode is the default unix permission bits applied to directories created by SMB clients. It is relevant only to views that are exposed to both SMB and NFS access protocols and have NFS security flavor."`
+	SmbFileMode              expr.IntField  `json:"smb_file_mode,omitempty" yaml:"smb_file_mode,omitempty" required:"false" doc:"Filter by smb_file_mode. smb_file_mode is the default unix permission bits applied to files created by SMB clients. It is relevant only to views that are exposed to both SMB and NFS access protocols and have NFS security flavor."`
+	TenantId                 expr.IntField  `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Filter by tenant. Specify tenant ID."`
 
 	// RawData allows passing arbitrary search parameters as key-value pairs.
 	//
@@ -129,6 +129,90 @@ type ViewPolicyRequestBody struct {
 	VipPools                           *[]int64                              `json:"vip_pools,omitempty" yaml:"vip_pools,omitempty" required:"false" doc:"Dedicate VIP Pools to the view policy. Specify VIP Pool IDs in a comma separated list."`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// ViewPolicyUpdateBody_ProtocolsAudit represents a nested type for update body
+type ViewPolicyUpdateBody_ProtocolsAudit struct {
+	CreateDeleteFilesDirsObjects bool `json:"create_delete_files_dirs_objects,omitempty" yaml:"create_delete_files_dirs_objects,omitempty" required:"false" doc:"Audit operations that create or delete files, directories, or objects"`
+	LogDeletedFilesDirs          bool `json:"log_deleted_files_dirs,omitempty" yaml:"log_deleted_files_dirs,omitempty" required:"false" doc:"Log deleted files and directories"`
+	LogFullPath                  bool `json:"log_full_path,omitempty" yaml:"log_full_path,omitempty" required:"false" doc:"Log full Element Store path to the requested resource. Enabled by default. May affect performance. When disabled, the view path is recorded."`
+	LogHostname                  bool `json:"log_hostname,omitempty" yaml:"log_hostname,omitempty" required:"false" doc:"Log hostname"`
+	LogUsername                  bool `json:"log_username,omitempty" yaml:"log_username,omitempty" required:"false" doc:"Log username of requesting user. Disabled by default"`
+	ModifyData                   bool `json:"modify_data,omitempty" yaml:"modify_data,omitempty" required:"false" doc:""`
+	ModifyDataMd                 bool `json:"modify_data_md,omitempty" yaml:"modify_data_md,omitempty" required:"false" doc:"Audit operations that modify data (including operations that change the file size) and metadata"`
+	ReadData                     bool `json:"read_data,omitempty" yaml:"read_data,omitempty" required:"false" doc:"Audit operations that read data and metadata"`
+	ReadDataMd                   bool `json:"read_data_md,omitempty" yaml:"read_data_md,omitempty" required:"false" doc:""`
+	SessionCreateClose           bool `json:"session_create_close,omitempty" yaml:"session_create_close,omitempty" required:"false" doc:"Audit session creation and closing operations for sessions that use Kerberos 5 authentication (krb5, krb5i, or krb5p)"`
+}
+
+// ViewPolicyUpdateBody represents the request body for ViewPolicy Update operations
+// Generated from PATCH request body for resource: /viewpolicies/{id}/
+type ViewPolicyUpdateBody struct {
+	AccessFlavor                       string                              `json:"access_flavor,omitempty" yaml:"access_flavor,omitempty" required:"false" doc:"Applicable with MIXED_LAST_WINS security flavor (Access can be set via NFSv3 regardless of this option)"`
+	AllowedCharacters                  string                              `json:"allowed_characters,omitempty" yaml:"allowed_characters,omitempty" required:"false" doc:"Specifies the policy for which characters are allowed in file names."`
+	AppleSid                           bool                                `json:"apple_sid,omitempty" yaml:"apple_sid,omitempty" required:"false" doc:"For use when connecting from Mac clients to SMB shares, this option enables Security IDs (SIDs) to be returned in Apple compatible representation."`
+	AtimeFrequency                     string                              `json:"atime_frequency,omitempty" yaml:"atime_frequency,omitempty" required:"false" doc:"Frequency for updating the atime attribute of NFS files. atime is updated on read operations if the difference between the current time and the file's atime value is greater than the atime frequency. For example: 300 or 00:00:30 seconds is supported. Zero value is not supported. Default: 3600"`
+	AuthSource                         string                              `json:"auth_source,omitempty" yaml:"auth_source,omitempty" required:"false" doc:"Specifies which source is trusted for the user's group memberships, when users' access to the view is authorized."`
+	ClusterId                          int64                               `json:"cluster_id,omitempty" yaml:"cluster_id,omitempty" required:"false" doc:""`
+	DisableHandleLease                 bool                                `json:"disable_handle_lease,omitempty" yaml:"disable_handle_lease,omitempty" required:"false" doc:""`
+	DisableReadLease                   bool                                `json:"disable_read_lease,omitempty" yaml:"disable_read_lease,omitempty" required:"false" doc:""`
+	DisableWriteLease                  bool                                `json:"disable_write_lease,omitempty" yaml:"disable_write_lease,omitempty" required:"false" doc:""`
+	EnableAccessToSnapshotDirInSubdirs bool                                `json:"enable_access_to_snapshot_dir_in_subdirs,omitempty" yaml:"enable_access_to_snapshot_dir_in_subdirs,omitempty" required:"false" doc:"Specifies whether to make the .snapshot directory accessible in subdirectories of the View."`
+	EnableVisibilityOfSnapshotDir      bool                                `json:"enable_visibility_of_snapshot_dir,omitempty" yaml:"enable_visibility_of_snapshot_dir,omitempty" required:"false" doc:"Specifies whether to make the .snapshot directory visible in subdirectories of the View."`
+	ExposeIdInFsid                     bool                                `json:"expose_id_in_fsid,omitempty" yaml:"expose_id_in_fsid,omitempty" required:"false" doc:""`
+	Flavor                             string                              `json:"flavor,omitempty" yaml:"flavor,omitempty" required:"false" doc:"Sets the security flavor, which determines how file and directory permissions are applied in multiprotocol views"`
+	GidInheritance                     string                              `json:"gid_inheritance,omitempty" yaml:"gid_inheritance,omitempty" required:"false" doc:"GID inheritance. BSD - Inherit the GID from the parent folder. LINUX - Inherit the GID from the user."`
+	InheritParentModeBits              bool                                `json:"inherit_parent_mode_bits,omitempty" yaml:"inherit_parent_mode_bits,omitempty" required:"false" doc:"Enable NFS behavior of inheriting POSIX settings from the parent directory versus configured values."`
+	IsBlockDefaultPolicy               bool                                `json:"is_block_default_policy,omitempty" yaml:"is_block_default_policy,omitempty" required:"false" doc:"Specifies whether to make this View Policy default for BLOCK"`
+	IsS3DefaultPolicy                  bool                                `json:"is_s3_default_policy,omitempty" yaml:"is_s3_default_policy,omitempty" required:"false" doc:"Specifies whether to make the view policy the default policy used for S3 endpoint views."`
+	Name                               string                              `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:""`
+	NfsAllSquash                       *[]string                           `json:"nfs_all_squash,omitempty" yaml:"nfs_all_squash,omitempty" required:"false" doc:"Specify which NFS client hosts have all squash. With all squash, all client users are mapped to nobody for all file and folder management operations on the export. Specify array of hosts separated by commas. Each host can be specified as an IP address, a netgroup key beginning with @, a CIDR subnet or a range of IPs indicated by an IP address with a * as a wildcard in place of any of the 8-bit fields in the IP address."`
+	NfsCaseInsensitive                 bool                                `json:"nfs_case_insensitive,omitempty" yaml:"nfs_case_insensitive,omitempty" required:"false" doc:"Force case insensitivity for NFSv3 and NFSv4"`
+	NfsEnforceMtls                     bool                                `json:"nfs_enforce_mtls,omitempty" yaml:"nfs_enforce_mtls,omitempty" required:"false" doc:"Specifies whether we enforce mTLS authentication over NFS."`
+	NfsEnforceTls                      bool                                `json:"nfs_enforce_tls,omitempty" yaml:"nfs_enforce_tls,omitempty" required:"false" doc:"Accept NFSv3 and NFSv4 client mounts only if they are TLS-encrypted. Use only with Minimal Protection Level set to System or None."`
+	NfsEnforceTlsRelaxed               bool                                `json:"nfs_enforce_tls_relaxed,omitempty" yaml:"nfs_enforce_tls_relaxed,omitempty" required:"false" doc:"Whether to relax TLS enforcement by not requiring TLS for auxiliary NFSv3 sub-protocols | (MOUNT, NLM, NSM, RQUOTA, NFSACL)"`
+	NfsMinimalProtectionLevel          string                              `json:"nfs_minimal_protection_level,omitempty" yaml:"nfs_minimal_protection_level,omitempty" required:"false" doc:"For a policy intended for use with NFSv4-enabled views, sets the Minimal Protection Level for NFSv4 client mounts: 'KRB_AUTH_ONLY' allows client mounts with Kerberos authentication only (using the RPCSEC_GSS authentication service), 'SYSTEM' allows client mounts using either the AUTH_SYS RCP security flavor (the traditional default NFS authentication scheme) or with Kerberos authentication, 'NONE' (default) allows client mounts with the AUTH_NONE (anonymous access), or AUTH_SYS RCP security flavors, or with Kerberos authentication."`
+	NfsNoSquash                        *[]string                           `json:"nfs_no_squash,omitempty" yaml:"nfs_no_squash,omitempty" required:"false" doc:"Specify which NFS client hosts have no squash. With no squash, all operations are supported. Use this option if you trust the root user not to perform operations that will corrupt data. Specify array of hosts separated by commas. Each host can be specified as an IP address, a netgroup key beginning with @, a CIDR subnet or a range of IPs indicated by an IP address with a * as a wildcard in place of any of the 8-bit fields in the IP address."`
+	NfsPosixAcl                        bool                                `json:"nfs_posix_acl,omitempty" yaml:"nfs_posix_acl,omitempty" required:"false" doc:"Enables full support of extended POSIX Access Control Lists (ACL)"`
+	NfsReadOnly                        *[]string                           `json:"nfs_read_only,omitempty" yaml:"nfs_read_only,omitempty" required:"false" doc:"Specify which NFS client hosts can access the view with read-only access. Specify array of hosts separated by commas. Each host can be specified as an IP address, a netgroup key beginning with @, a CIDR subnet or a range of IPs indicated by an IP address with a * as a wildcard in place of any of the 8-bit fields in the IP address."`
+	NfsReadWrite                       *[]string                           `json:"nfs_read_write,omitempty" yaml:"nfs_read_write,omitempty" required:"false" doc:"Specify which NFS client hosts can access the view with read-write access. Specify array of hosts separated by commas. Each host can be specified as an IP address, a netgroup key beginning with @, a CIDR subnet or a range of IPs indicated by an IP address with a * as a wildcard in place of any of the 8-bit fields in the IP address."`
+	NfsReturnOpenPermissions           bool                                `json:"nfs_return_open_permissions,omitempty" yaml:"nfs_return_open_permissions,omitempty" required:"false" doc:"If enabled for NFS-exposed views, the NFS server unilaterally returns open (777) permission for all files and directories when responding to client side access checks"`
+	NfsRootSquash                      *[]string                           `json:"nfs_root_squash,omitempty" yaml:"nfs_root_squash,omitempty" required:"false" doc:"Specify which NFS client hosts have root squash. With root squash, the root user is mapped to nobody for all file and folder management operations on the export. This enables you to prevent the strongest super user from corrupting all user data on the VAST Cluster. Specify array of hosts separated by commas. Each host can be specified as an IP address, a netgroup key beginning with @, a CIDR subnet or a range of IPs indicated by an IP address with a * as a wildcard in place of any of the 8-bit fields in the IP address."`
+	PathLength                         string                              `json:"path_length,omitempty" yaml:"path_length,omitempty" required:"false" doc:"Specifies the policy for limiting file path component name length."`
+	PermissionPerVipPool               map[string]string                   `json:"permission_per_vip_pool,omitempty" yaml:"permission_per_vip_pool,omitempty" required:"false" doc:""`
+	Protocols                          *[]string                           `json:"protocols,omitempty" yaml:"protocols,omitempty" required:"false" doc:"Array of protocols to audit"`
+	ProtocolsAudit                     ViewPolicyUpdateBody_ProtocolsAudit `json:"protocols_audit,omitempty" yaml:"protocols_audit,omitempty" required:"false" doc:"Specify audit options to enable them for all attached views in addition to auditing options that are enabled globably on the cluster."`
+	ReadOnly                           *[]string                           `json:"read_only,omitempty" yaml:"read_only,omitempty" required:"false" doc:"Specify which NFS client hosts can access the view with read-only access. Specify array of hosts separated by commas. Each host can be specified as an IP address, a netgroup key beginning with @, a CIDR subnet or a range of IPs indicated by an IP address with a * as a wildcard in place of any of the 8-bit fields in the IP address."`
+	ReadWrite                          *[]string                           `json:"read_write,omitempty" yaml:"read_write,omitempty" required:"false" doc:"Specify which NFS client hosts can access the view with read-write access. Specify array of hosts separated by commas. Each host can be specified as an IP address, a netgroup key beginning with @, a CIDR subnet or a range of IPs indicated by an IP address with a * as a wildcard in place of any of the 8-bit fields in the IP address."`
+	S3FlavorAllowFreeListing           bool                                `json:"s3_flavor_allow_free_listing,omitempty" yaml:"s3_flavor_allow_free_listing,omitempty" required:"false" doc:"Allow NFS clients freely list bucket views and their subdirectories, regardless of individual object permissions."`
+	S3FlavorDetectFullPathname         bool                                `json:"s3_flavor_detect_full_pathname,omitempty" yaml:"s3_flavor_detect_full_pathname,omitempty" required:"false" doc:"When this flag is enabled in S3 flavor, NFS access to objects is determined based on the full resource names specified in the identity policies. When disabled, only the bucket name is compared to the identity policy."`
+	S3ReadOnly                         *[]string                           `json:"s3_read_only,omitempty" yaml:"s3_read_only,omitempty" required:"false" doc:"Specify which S3 client hosts can access the view with read-only access. Specify array of hosts separated by commas. Each host can be specified as an IP address, a netgroup key beginning with @, a CIDR subnet or a range of IPs indicated by an IP address with a * as a wildcard in place of any of the 8-bit fields in the IP address."`
+	S3ReadWrite                        *[]string                           `json:"s3_read_write,omitempty" yaml:"s3_read_write,omitempty" required:"false" doc:"Specify which S3 client hosts can access the view with read-write access. Specify array of hosts separated by commas. Each host can be specified as an IP address, a netgroup key beginning with @, a CIDR subnet or a range of IPs indicated by an IP address with a * as a wildcard in place of any of the 8-bit fields in the IP address."`
+	S3SpecialCharsSupport              bool                                `json:"s3_special_chars_support,omitempty" yaml:"s3_special_chars_support,omitempty" required:"false" doc:"This will enable object names that contain “//“ or “/../“ and are incompatible with other protocols"`
+	S3Visibility                       *[]string                           `json:"s3_visibility,omitempty" yaml:"s3_visibility,omitempty" required:"false" doc:"Users with permission to list buckets that are created using this policy even if they do not have permission to access those buckets."`
+	S3VisibilityGroups                 *[]string                           `json:"s3_visibility_groups,omitempty" yaml:"s3_visibility_groups,omitempty" required:"false" doc:"Users with permission to list buckets that are created using this policy even if they do not have permission to access those buckets."`
+	SmbDirectoryMode                   int64                               `json:"smb_directory_mode,omitempty" yaml:"smb_directory_mode,omitempty" required:"false" doc:"For multiprotocol views, if the security flavor is NFS, this parameter sets default unix permission bits for directories created by SMB clients. Use three digit numeric notation, each digit representing the user, group and others compontents of the permissions, in that order. Each digit is the sum of the read bit, write bit and execute bit. If reading is permitted, the read bit adds 4 to the component. If writing is permitted, the write bit adds 2 to the component. If execution is permitted, the execute bit adds 1 to the component."`
+	SmbFileMode                        int64                               `json:"smb_file_mode,omitempty" yaml:"smb_file_mode,omitempty" required:"false" doc:"For multiprotocol views, if the security flavor is NFS, this parameter sets default unix permission bits for files created by SMB clients. Use three digit numeric notation, each digit representing the user, group and others compontents of the permissions, in that order. Each digit is the sum of the read bit, write bit and execute bit. If reading is permitted, the read bit adds 4 to the component. If writing is permitted, the write bit adds 2 to the component. If execution is permitted, the execute bit adds 1 to the component."`
+	SmbIsCa                            bool                                `json:"smb_is_ca,omitempty" yaml:"smb_is_ca,omitempty" required:"false" doc:"When enabled, the SMB share exposed by the view is set as continuously available, which allows SMB3 clients to request use of persistent file handles and keep their connections to this share in case of a failover event."`
+	SmbReadOnly                        *[]string                           `json:"smb_read_only,omitempty" yaml:"smb_read_only,omitempty" required:"false" doc:"Specify which SMB client hosts can access the view with read-only access. Specify array of hosts separated by commas. Each host can be specified as an IP address, a netgroup key beginning with @, a CIDR subnet or a range of IPs indicated by an IP address with a * as a wildcard in place of any of the 8-bit fields in the IP address."`
+	SmbReadWrite                       *[]string                           `json:"smb_read_write,omitempty" yaml:"smb_read_write,omitempty" required:"false" doc:"Specify which SMB client hosts can access the view with read-write access. Specify array of hosts separated by commas. Each host can be specified as an IP address, a netgroup key beginning with @, a CIDR subnet or a range of IPs indicated by an IP address with a * as a wildcard in place of any of the 8-bit fields in the IP address."`
+	SmbRecursiveChangeNotify           bool                                `json:"smb_recursive_change_notify,omitempty" yaml:"smb_recursive_change_notify,omitempty" required:"false" doc:"Whether to enable SMB Recursive Change Notify"`
+	TenantId                           int64                               `json:"tenant_id,omitempty" yaml:"tenant_id,omitempty" required:"false" doc:"Tenant ID"`
+	TrashAccess                        *[]string                           `json:"trash_access,omitempty" yaml:"trash_access,omitempty" required:"false" doc:"Specify which NFSv3 client hosts can access the trash folder. Specify array of hosts separated by commas. Each host can be specified as an IP address, a netgroup key beginning with @, a CIDR subnet or a range of IPs indicated by an IP address with a * as a wildcard in place of any of the 8-bit fields in the IP address. Trash folder access must also be enabled for the cluster."`
+	Use32bitFileid                     string                              `json:"use_32bit_fileid,omitempty" yaml:"use_32bit_fileid,omitempty" required:"false" doc:"Sets the VAST Cluster's NFS server to use 32bit file IDs. This setting supports legacy 32-bit applications running over NFS."`
+	UseAuthProvider                    bool                                `json:"use_auth_provider,omitempty" yaml:"use_auth_provider,omitempty" required:"false" doc:"Not in use"`
+	VipPools                           *[]int64                            `json:"vip_pools,omitempty" yaml:"vip_pools,omitempty" required:"false" doc:"Dedicate VIP Pools to the view policy. Specify VIP Pool IDs in a comma separated list."`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -256,6 +340,44 @@ func (r *ViewPolicy) CreateWithContext(ctx context.Context, req *ViewPolicyReque
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing viewpolicy by ID and returns its details
+// summary: Modify a View Policy
+func (r *ViewPolicy) Update(id any, req *ViewPolicyUpdateBody) (*ViewPolicyDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing viewpolicy by ID and returns its details using provided context
+// summary: Modify a View Policy
+func (r *ViewPolicy) UpdateWithContext(ctx context.Context, id any, req *ViewPolicyUpdateBody) (*ViewPolicyDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response ViewPolicyDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -422,4 +544,3 @@ func (r *ViewPolicy) ViewPolicyRemoteMapping_DELETE(id any, peer string) error {
 // GENERATION ISSUES
 // -----------------------------------------------------
 //   - Extra method POST /viewpolicies/{id}/remote_mapping/ skipped: POST /viewpolicies/{id}/remote_mapping/ - No response schema defined in OpenAPI spec. Error: no valid schema found in POST response (200/201/202/204) for resource /viewpolicies/{id}/remote_mapping/
-//   - UPDATE operation excluded: PATCH/PUT /viewpolicies/{id}/ has no response schema and doesn't return 204 NO CONTENT
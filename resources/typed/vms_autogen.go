@@ -1,5 +1,5 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: LIST|READ
+// Template: LIST|READ|UPDATE
 
 package typed
 
@@ -49,6 +49,44 @@ type VmsSearchParams struct {
 	RawData core.Params `json:"-" yaml:"-"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// VmsUpdateBody represents the request body for Vms Update operations
+// Generated from PATCH request body for resource: /vms/{id}/
+type VmsUpdateBody struct {
+	AccessTokenLifetime        string `json:"access_token_lifetime,omitempty" yaml:"access_token_lifetime,omitempty" required:"false" doc:"Validity duration for JWT access token, specify as [DD [HH:[MM:]]]ss"`
+	CapacityBase10             bool   `json:"capacity_base_10,omitempty" yaml:"capacity_base_10,omitempty" required:"false" doc:"Set to True to format capacity properties in base 10 units. Set to False to format capacity properties in base 2 units."`
+	DeleteMgmtDataVip          bool   `json:"delete_mgmt_data_vip,omitempty" yaml:"delete_mgmt_data_vip,omitempty" required:"false" doc:"Delete VMS data Virtual IP"`
+	DisableVmsMetrics          bool   `json:"disable_vms_metrics,omitempty" yaml:"disable_vms_metrics,omitempty" required:"false" doc:"Set to True to disable VMS metrics. Set to False to enable VMS metrics."`
+	EnableIdleTimeout          bool   `json:"enable_idle_timeout,omitempty" yaml:"enable_idle_timeout,omitempty" required:"false" doc:"Enable GUI timeout based on inactivity"`
+	LoginBanner                string `json:"login_banner,omitempty" yaml:"login_banner,omitempty" required:"false" doc:"Custom login banner text for VMS Web UI and CLI"`
+	MgmtDataInterface          string `json:"mgmt_data_interface,omitempty" yaml:"mgmt_data_interface,omitempty" required:"false" doc:"VMS data Virtual Interface"`
+	MgmtDataNetmask            string `json:"mgmt_data_netmask,omitempty" yaml:"mgmt_data_netmask,omitempty" required:"false" doc:"VMS data Virtual Netmask"`
+	MgmtDataVip                string `json:"mgmt_data_vip,omitempty" yaml:"mgmt_data_vip,omitempty" required:"false" doc:"VMS data Virtual IP"`
+	MgmtDataVipGateway         string `json:"mgmt_data_vip_gateway,omitempty" yaml:"mgmt_data_vip_gateway,omitempty" required:"false" doc:"IPv4 address of the gateway to VMS data Virtual IP"`
+	MgmtDataVipGatewayIpv6     string `json:"mgmt_data_vip_gateway_ipv6,omitempty" yaml:"mgmt_data_vip_gateway_ipv6,omitempty" required:"false" doc:"Specifies an IPv6 address of the gateway to the virtual mgmt data IPV6"`
+	MgmtDataVipIpv6            string `json:"mgmt_data_vip_ipv6,omitempty" yaml:"mgmt_data_vip_ipv6,omitempty" required:"false" doc:"VMS data Virtual IP6"`
+	MgmtDataVipPrefixIpv6      string `json:"mgmt_data_vip_prefix_ipv6,omitempty" yaml:"mgmt_data_vip_prefix_ipv6,omitempty" required:"false" doc:"Specifies the prefix of management data IPV6 VIP"`
+	MgmtDataVipVlan            string `json:"mgmt_data_vip_vlan,omitempty" yaml:"mgmt_data_vip_vlan,omitempty" required:"false" doc:"Tags the virtual mgmt data IP with a specific VLAN"`
+	MgmtInnerVip               string `json:"mgmt_inner_vip,omitempty" yaml:"mgmt_inner_vip,omitempty" required:"false" doc:"The virtual IP on the internal network used for mounting the VMS database."`
+	MgmtVip                    string `json:"mgmt_vip,omitempty" yaml:"mgmt_vip,omitempty" required:"false" doc:"The VMS Virtual IP. This is a virtual IP configured on the management interfaces on all CNodes. VAST Management System (VMS) listens on this IP. It is used to access the VMS interfaces."`
+	MinPwdLength               int64  `json:"min_pwd_length,omitempty" yaml:"min_pwd_length,omitempty" required:"false" doc:"Minimum password length for new VMS managers"`
+	MinTlsVersion              string `json:"min_tls_version,omitempty" yaml:"min_tls_version,omitempty" required:"false" doc:"Minimum supported TLS version. By default, VMS does not allow TLS connections with TLS versions older than 1.2. To loosen this restriction, set this property to a lower version value, such as 1.0 or 1.1."`
+	PerformanceBase10          bool   `json:"performance_base_10,omitempty" yaml:"performance_base_10,omitempty" required:"false" doc:"Set to True to format performance properties in base 10 units. Set to False to format performance properties in base 2 units."`
+	PreferredCnodeIds          string `json:"preferred_cnode_ids,omitempty" yaml:"preferred_cnode_ids,omitempty" required:"false" doc:"List of preferred CNodes IDs (CSV)"`
+	RefreshTokenLifetime       string `json:"refresh_token_lifetime,omitempty" yaml:"refresh_token_lifetime,omitempty" required:"false" doc:"Validity duration for JWT refresh token, specify as [DD [HH:[MM:]]]ss"`
+	VmsPerfDebugMetricsEnabled bool   `json:"vms_perf_debug_metrics_enabled,omitempty" yaml:"vms_perf_debug_metrics_enabled,omitempty" required:"false" doc:"Enable VMS performance debug metrics"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -141,6 +179,44 @@ func (r *Vms) GetByIdWithContext(ctx context.Context, id any) (*VmsDetailsModel,
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing vms by ID and returns its details
+// summary: Modify VMS Settings
+func (r *Vms) Update(id any, req *VmsUpdateBody) (*VmsDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing vms by ID and returns its details using provided context
+// summary: Modify VMS Settings
+func (r *Vms) UpdateWithContext(ctx context.Context, id any, req *VmsUpdateBody) (*VmsDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response VmsDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // Exists checks if a vms exists
 func (r *Vms) Exists(req *VmsSearchParams) (bool, error) {
 	return r.ExistsWithContext(r.Untyped.GetCtx(), req)
@@ -721,4 +797,3 @@ func (r *Vms) VmsToggleMaintenanceMode_PATCH(id any, waitTimeout time.Duration)
 //   - Extra method PATCH /vms/{id}/set_ssl_ciphers/ skipped: PATCH /vms/{id}/set_ssl_ciphers/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /vms/{id}/set_ssl_ciphers/
 //   - Extra method PATCH /vms/{id}/set_ssl_port/ skipped: PATCH /vms/{id}/set_ssl_port/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /vms/{id}/set_ssl_port/
 //   - Extra method POST /vms/{id}/network_settings_summary/ skipped: POST /vms/{id}/network_settings_summary/ - Response schema contains ambiguous nested objects (objects with no properties)
-//   - UPDATE operation excluded: PATCH/PUT /vms/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: LIST|READ
+// Template: LIST|READ|UPDATE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -45,6 +46,23 @@ type VTaskSearchParams struct {
 	RawData core.Params `json:"-" yaml:"-"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// VTaskUpdateBody represents the request body for VTask Update operations
+// Generated from PATCH request body for resource: /vtasks/{id}/
+type VTaskUpdateBody struct {
+	State string `json:"state,omitempty" yaml:"state,omitempty" required:"false" doc:"task state in RUNNING/COMPLETED/FAILED/UNKNOWN"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -137,6 +155,44 @@ func (r *VTask) GetByIdWithContext(ctx context.Context, id any) (*VTaskDetailsMo
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing vtask by ID and returns its details
+// summary: Modify Vtask
+func (r *VTask) Update(id any, req *VTaskUpdateBody) (*VTaskDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing vtask by ID and returns its details using provided context
+// summary: Modify Vtask
+func (r *VTask) UpdateWithContext(ctx context.Context, id any, req *VTaskUpdateBody) (*VTaskDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response VTaskDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // Exists checks if a vtask exists
 func (r *VTask) Exists(req *VTaskSearchParams) (bool, error) {
 	return r.ExistsWithContext(r.Untyped.GetCtx(), req)
@@ -169,4 +225,3 @@ func (r *VTask) MustExistsWithContext(ctx context.Context, req *VTaskSearchParam
 // GENERATION ISSUES
 // -----------------------------------------------------
 //   - Extra method PATCH /vtasks/{id}/retry/ skipped: PATCH /vtasks/{id}/retry/ - No response schema defined in OpenAPI spec. Error: no valid schema found in PATCH response (200/201/202/204) for resource /vtasks/{id}/retry/
-//   - UPDATE operation excluded: PATCH/PUT /vtasks/{id}/ has no response schema and doesn't return 204 NO CONTENT
@@ -1,10 +1,11 @@
 // Code generated by generate-typed-resources. DO NOT EDIT.
-// Template: LIST|READ|DELETE
+// Template: LIST|READ|UPDATE|DELETE
 
 package typed
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/vast-data/go-vast-client/core"
 	"github.com/vast-data/go-vast-client/resources/typed/expr"
@@ -44,6 +45,29 @@ type WebHookSearchParams struct {
 	RawData core.Params `json:"-" yaml:"-"`
 }
 
+// -----------------------------------------------------
+// UPDATE BODY
+// -----------------------------------------------------
+
+// WebHookUpdateBody represents the request body for WebHook Update operations
+// Generated from PATCH request body for resource: /webhooks/{id}/
+type WebHookUpdateBody struct {
+	CertificateId int64             `json:"certificate_id,omitempty" yaml:"certificate_id,omitempty" required:"false" doc:"ID of a certificate used to secure the webhook."`
+	Data          string            `json:"data,omitempty" yaml:"data,omitempty" required:"false" doc:"Webhook data"`
+	Enabled       bool              `json:"enabled,omitempty" yaml:"enabled,omitempty" required:"false" doc:"Webhook is enabled"`
+	Headers       map[string]string `json:"headers,omitempty" yaml:"headers,omitempty" required:"false" doc:""`
+	Method        string            `json:"method,omitempty" yaml:"method,omitempty" required:"false" doc:"Webhook method"`
+	Name          string            `json:"name,omitempty" yaml:"name,omitempty" required:"false" doc:"Webhook name"`
+	Url           string            `json:"url,omitempty" yaml:"url,omitempty" required:"false" doc:"Webhook URL"`
+
+	// RawData allows passing arbitrary body fields as key-value pairs.
+	//
+	// Typed fields are omitted when empty; use RawData to send zero values
+	// (e.g., core.Params{"enabled": false}) or fields not covered above.
+	// RawData entries override typed fields with the same key.
+	RawData core.Params `json:"-" yaml:"-"`
+}
+
 // -----------------------------------------------------
 // MODELS
 // -----------------------------------------------------
@@ -136,6 +160,44 @@ func (r *WebHook) GetByIdWithContext(ctx context.Context, id any) (*WebHookDetai
 	return &response, nil
 }
 
+// -----------------------------------------------------
+// UPDATE
+// -----------------------------------------------------
+
+// Update updates an existing webhook by ID and returns its details
+// summary: Modify Webhook
+func (r *WebHook) Update(id any, req *WebHookUpdateBody) (*WebHookDetailsModel, error) {
+	return r.UpdateWithContext(r.Untyped.GetCtx(), id, req)
+}
+
+// UpdateWithContext updates an existing webhook by ID and returns its details using provided context
+// summary: Modify Webhook
+func (r *WebHook) UpdateWithContext(ctx context.Context, id any, req *WebHookUpdateBody) (*WebHookDetailsModel, error) {
+	params, err := core.NewParamsFromStruct(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := r.Untyped.GetResourceMap()[r.GetResourceType()]
+	path := core.BuildResourcePathWithID(resource.GetResourcePath(), id)
+	record, err := core.Request[core.Record](ctx, resource, http.MethodPatch, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The response is not described by the API schema; read the object back when it is not returned
+	if _, ok := record["id"]; !ok {
+		return r.GetByIdWithContext(ctx, id)
+	}
+
+	var response WebHookDetailsModel
+	if err := record.Fill(&response); err != nil {
+		return nil, err
+	}
+
+	return &response, nil
+}
+
 // -----------------------------------------------------
 // DELETE
 // -----------------------------------------------------
@@ -204,4 +266,3 @@ func (r *WebHook) MustExistsWithContext(ctx context.Context, req *WebHookSearchP
 // GENERATION ISSUES
 // -----------------------------------------------------
 //   - CREATE operation excluded: POST webhooks has no response schema and doesn't return 204 NO CONTENT
-//   - UPDATE operation excluded: PATCH/PUT /webhooks/{id}/ has no response schema and doesn't return 204 NO CONTENT
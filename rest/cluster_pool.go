@@ -0,0 +1,358 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vast-data/go-vast-client/core"
+)
+
+// ErrClusterUnreachable is reported by FanOut for clusters marked unreachable by the last health check.
+var ErrClusterUnreachable = errors.New("cluster is unreachable")
+
+// ClusterPool manages the clients of many clusters, keyed by name and labelled for selection:
+//
+//	pool := rest.NewClusterPool()
+//	_ = pool.Add("prod-1", prod1Config, map[string]string{"env": "prod", "site": "nyc"})
+//	_ = pool.Add("lab-1", lab1Config, map[string]string{"env": "lab"})
+//
+//	report, err := pool.FanOut(ctx, rest.MatchLabels(map[string]string{"env": "prod"}),
+//	    func(ctx context.Context, name string, rest *rest.UntypedVMSRest) (core.RecordSet, error) {
+//	        return rest.Views.ListWithContext(ctx, nil)
+//	    })
+//
+// ClusterPool is safe for concurrent use.
+type ClusterPool struct {
+	// Concurrency is the maximum number of clusters queried in parallel (default 8).
+	Concurrency int
+	// Timeout bounds each cluster call of FanOut and HealthCheck (default 1 minute).
+	Timeout time.Duration
+	// HealthCheckFn checks one cluster. The default lists the cluster object.
+	HealthCheckFn func(ctx context.Context, rest *UntypedVMSRest) error
+
+	mu       sync.RWMutex
+	clusters map[string]*poolCluster
+}
+
+// ClusterInfo describes a cluster of the pool.
+type ClusterInfo struct {
+	Name   string
+	Labels map[string]string
+	// Reachable is false when the last health check failed. Clusters are reachable until checked.
+	Reachable bool
+	LastCheck time.Time // zero when never checked
+	LastError error     // error of the last health check
+}
+
+type poolCluster struct {
+	info ClusterInfo
+	rest *UntypedVMSRest
+}
+
+// Selector selects clusters by name and labels. A nil Selector selects all clusters.
+type Selector func(name string, labels map[string]string) bool
+
+// MatchLabels selects clusters having all the given labels.
+func MatchLabels(labels map[string]string) Selector {
+	return func(_ string, clusterLabels map[string]string) bool {
+		for k, v := range labels {
+			if clusterLabels[k] != v {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// MatchNames selects the named clusters.
+func MatchNames(names ...string) Selector {
+	return func(name string, _ map[string]string) bool {
+		for _, n := range names {
+			if n == name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// NewClusterPool returns an empty ClusterPool.
+func NewClusterPool() *ClusterPool {
+	return &ClusterPool{clusters: map[string]*poolCluster{}}
+}
+
+// Add creates a client for config and adds it to the pool under name.
+func (p *ClusterPool) Add(name string, config *core.VMSConfig, labels map[string]string) error {
+	rest, err := NewUntypedVMSRest(config)
+	if err != nil {
+		return fmt.Errorf("cluster %s: %w", name, err)
+	}
+	return p.AddRest(name, rest, labels)
+}
+
+// AddRest adds an existing client to the pool under name.
+func (p *ClusterPool) AddRest(name string, rest *UntypedVMSRest, labels map[string]string) error {
+	if name == "" {
+		return errors.New("cluster name cannot be empty")
+	}
+	copied := make(map[string]string, len(labels))
+	for k, v := range labels {
+		copied[k] = v
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, exists := p.clusters[name]; exists {
+		return fmt.Errorf("cluster %s already in pool", name)
+	}
+	p.clusters[name] = &poolCluster{info: ClusterInfo{Name: name, Labels: copied, Reachable: true}, rest: rest}
+	return nil
+}
+
+// Remove removes the named cluster from the pool.
+func (p *ClusterPool) Remove(name string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.clusters, name)
+}
+
+// Get returns the client of the named cluster.
+func (p *ClusterPool) Get(name string) (*UntypedVMSRest, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	cluster, ok := p.clusters[name]
+	if !ok {
+		return nil, false
+	}
+	return cluster.rest, true
+}
+
+// Clusters returns the clusters matching selector, sorted by name.
+func (p *ClusterPool) Clusters(selector Selector) []ClusterInfo {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	var out []ClusterInfo
+	for _, cluster := range p.clusters {
+		if selector == nil || selector(cluster.info.Name, cluster.info.Labels) {
+			out = append(out, cluster.info)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// FanOutFunc runs against one cluster. ctx carries the per-cluster timeout.
+type FanOutFunc func(ctx context.Context, name string, rest *UntypedVMSRest) (core.RecordSet, error)
+
+// FanOutReport merges the results of FanOut.
+type FanOutReport struct {
+	// Records of all clusters, each tagged with its cluster name under core.ClusterKey,
+	// ordered by cluster name.
+	Records core.RecordSet
+	// PerCluster holds the records of each successful cluster.
+	PerCluster map[string]core.RecordSet
+	// Errors holds the error of each failed or unreachable cluster.
+	Errors map[string]error
+	// Durations holds the time spent on each queried cluster.
+	Durations map[string]time.Duration
+}
+
+// Err joins the errors of the failed clusters, in cluster name order; nil when all succeeded.
+func (r *FanOutReport) Err() error {
+	names := make([]string, 0, len(r.Errors))
+	for name := range r.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	errs := make([]error, 0, len(names))
+	for _, name := range names {
+		errs = append(errs, fmt.Errorf("cluster %s: %w", name, r.Errors[name]))
+	}
+	return errors.Join(errs...)
+}
+
+// String summarizes the report, e.g. "fan-out: 38/40 clusters succeeded, 1520 records; failed: lab-3, prod-7".
+func (r *FanOutReport) String() string {
+	failed := make([]string, 0, len(r.Errors))
+	for name := range r.Errors {
+		failed = append(failed, name)
+	}
+	sort.Strings(failed)
+	total := len(r.PerCluster) + len(r.Errors)
+	s := fmt.Sprintf("fan-out: %d/%d clusters succeeded, %d records", len(r.PerCluster), total, len(r.Records))
+	if len(failed) > 0 {
+		s += "; failed: " + strings.Join(failed, ", ")
+	}
+	return s
+}
+
+// FanOut runs fn on the clusters matching selector with bounded parallelism (Concurrency)
+// and a per-cluster timeout (Timeout). Clusters marked unreachable by the last health check
+// are skipped with ErrClusterUnreachable. The returned error is report.Err().
+func (p *ClusterPool) FanOut(ctx context.Context, selector Selector, fn FanOutFunc) (*FanOutReport, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	report := &FanOutReport{
+		PerCluster: map[string]core.RecordSet{},
+		Errors:     map[string]error{},
+		Durations:  map[string]time.Duration{},
+	}
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		active []*poolCluster
+	)
+	for _, cluster := range p.selected(selector) {
+		if !p.reachable(cluster) {
+			report.Errors[cluster.info.Name] = ErrClusterUnreachable
+			continue
+		}
+		active = append(active, cluster)
+	}
+
+	sem := make(chan struct{}, p.concurrency())
+	for _, cluster := range active {
+		wg.Add(1)
+		go func(cluster *poolCluster) {
+			defer wg.Done()
+			name := cluster.info.Name
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				mu.Lock()
+				report.Errors[name] = ctx.Err()
+				mu.Unlock()
+				return
+			}
+			clusterCtx, cancel := context.WithTimeout(ctx, p.timeout())
+			defer cancel()
+			start := time.Now()
+			records, err := fn(clusterCtx, name, cluster.rest)
+			elapsed := time.Since(start)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Durations[name] = elapsed
+			if err != nil {
+				report.Errors[name] = err
+				return
+			}
+			for _, record := range records {
+				record[core.ClusterKey] = name
+			}
+			report.PerCluster[name] = records
+		}(cluster)
+	}
+	wg.Wait()
+
+	names := make([]string, 0, len(report.PerCluster))
+	for name := range report.PerCluster {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		report.Records = append(report.Records, report.PerCluster[name]...)
+	}
+	return report, report.Err()
+}
+
+// HealthCheck checks the clusters matching selector in parallel and marks the failing ones
+// unreachable (and the others reachable again). It returns the checked clusters.
+func (p *ClusterPool) HealthCheck(ctx context.Context, selector Selector) []ClusterInfo {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	check := p.HealthCheckFn
+	if check == nil {
+		check = defaultHealthCheck
+	}
+	clusters := p.selected(selector)
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, p.concurrency())
+	for _, cluster := range clusters {
+		wg.Add(1)
+		go func(cluster *poolCluster) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			checkCtx, cancel := context.WithTimeout(ctx, p.timeout())
+			defer cancel()
+			err := check(checkCtx, cluster.rest)
+
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			cluster.info.Reachable = err == nil
+			cluster.info.LastCheck = time.Now()
+			cluster.info.LastError = err
+		}(cluster)
+	}
+	wg.Wait()
+	return p.Clusters(func(name string, _ map[string]string) bool {
+		for _, cluster := range clusters {
+			if cluster.info.Name == name {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// StartHealthChecks runs HealthCheck on all clusters every interval until ctx is done.
+func (p *ClusterPool) StartHealthChecks(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			p.HealthCheck(ctx, nil)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (p *ClusterPool) selected(selector Selector) []*poolCluster {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	var out []*poolCluster
+	for _, cluster := range p.clusters {
+		if selector == nil || selector(cluster.info.Name, cluster.info.Labels) {
+			out = append(out, cluster)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].info.Name < out[j].info.Name })
+	return out
+}
+
+func (p *ClusterPool) reachable(cluster *poolCluster) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return cluster.info.Reachable
+}
+
+func (p *ClusterPool) concurrency() int {
+	if p.Concurrency > 0 {
+		return p.Concurrency
+	}
+	return 8
+}
+
+func (p *ClusterPool) timeout() time.Duration {
+	if p.Timeout > 0 {
+		return p.Timeout
+	}
+	return time.Minute
+}
+
+func defaultHealthCheck(ctx context.Context, rest *UntypedVMSRest) error {
+	_, err := rest.Clusters.ListWithContext(ctx, core.Params{"fields": "id"})
+	return err
+}
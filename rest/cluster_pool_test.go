@@ -0,0 +1,171 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vast-data/go-vast-client/core"
+)
+
+func newTestPoolServer(t *testing.T, body string, status int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClusterPool_AddAndSelect(t *testing.T) {
+	server := newTestPoolServer(t, `[]`, http.StatusOK)
+	pool := NewClusterPool()
+	if err := pool.Add("prod-1", testVMSConfig(t, server), map[string]string{"env": "prod"}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := pool.Add("lab-1", testVMSConfig(t, server), map[string]string{"env": "lab"}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := pool.Add("lab-1", testVMSConfig(t, server), nil); err == nil {
+		t.Fatal("expected error for duplicate cluster")
+	}
+
+	if got := pool.Clusters(nil); len(got) != 2 || got[0].Name != "lab-1" || got[1].Name != "prod-1" {
+		t.Fatalf("unexpected clusters: %+v", got)
+	}
+	prod := pool.Clusters(MatchLabels(map[string]string{"env": "prod"}))
+	if len(prod) != 1 || prod[0].Name != "prod-1" || !prod[0].Reachable {
+		t.Fatalf("unexpected selection: %+v", prod)
+	}
+	if _, ok := pool.Get("lab-1"); !ok {
+		t.Fatal("expected lab-1 in pool")
+	}
+	pool.Remove("lab-1")
+	if _, ok := pool.Get("lab-1"); ok {
+		t.Fatal("expected lab-1 removed")
+	}
+}
+
+func TestClusterPool_FanOutMergesAndTags(t *testing.T) {
+	server := newTestPoolServer(t, `[]`, http.StatusOK)
+	pool := NewClusterPool()
+	for _, name := range []string{"c", "a", "b"} {
+		if err := pool.Add(name, testVMSConfig(t, server), nil); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+	}
+
+	report, err := pool.FanOut(context.Background(), nil,
+		func(ctx context.Context, name string, rest *UntypedVMSRest) (core.RecordSet, error) {
+			if name == "b" {
+				return nil, errors.New("boom")
+			}
+			return core.RecordSet{{"id": 1, "name": name + "-view"}}, nil
+		})
+	if err == nil || !strings.Contains(err.Error(), "cluster b: boom") {
+		t.Fatalf("expected joined error for b, got %v", err)
+	}
+	if len(report.Records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(report.Records))
+	}
+	if report.Records[0][core.ClusterKey] != "a" || report.Records[1][core.ClusterKey] != "c" {
+		t.Fatalf("unexpected tags: %v", report.Records)
+	}
+	if len(report.PerCluster) != 2 || len(report.Errors) != 1 {
+		t.Fatalf("unexpected report: %s", report)
+	}
+	if got := report.String(); got != "fan-out: 2/3 clusters succeeded, 2 records; failed: b" {
+		t.Fatalf("unexpected summary: %q", got)
+	}
+}
+
+func TestClusterPool_FanOutBoundsParallelismAndTimeout(t *testing.T) {
+	server := newTestPoolServer(t, `[]`, http.StatusOK)
+	pool := NewClusterPool()
+	pool.Concurrency = 2
+	pool.Timeout = 50 * time.Millisecond
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		if err := pool.Add(name, testVMSConfig(t, server), nil); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+	}
+
+	var running, peak int32
+	report, _ := pool.FanOut(context.Background(), nil,
+		func(ctx context.Context, name string, rest *UntypedVMSRest) (core.RecordSet, error) {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			if name == "e" {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			time.Sleep(5 * time.Millisecond)
+			return core.RecordSet{}, nil
+		})
+	if peak > 2 {
+		t.Fatalf("expected at most 2 concurrent calls, got %d", peak)
+	}
+	if !errors.Is(report.Errors["e"], context.DeadlineExceeded) {
+		t.Fatalf("expected timeout for e, got %v", report.Errors["e"])
+	}
+	if len(report.PerCluster) != 4 {
+		t.Fatalf("expected 4 successful clusters, got %d", len(report.PerCluster))
+	}
+}
+
+func TestClusterPool_HealthCheckMarksUnreachable(t *testing.T) {
+	healthy := newTestPoolServer(t, `[{"id": 1, "name": "cluster"}]`, http.StatusOK)
+	broken := newTestPoolServer(t, `{"detail": "down"}`, http.StatusBadRequest)
+	pool := NewClusterPool()
+	if err := pool.Add("up", testVMSConfig(t, healthy), nil); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := pool.Add("down", testVMSConfig(t, broken), nil); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	checked := pool.HealthCheck(context.Background(), nil)
+	if len(checked) != 2 {
+		t.Fatalf("expected 2 checked clusters, got %d", len(checked))
+	}
+	for _, info := range checked {
+		if info.LastCheck.IsZero() {
+			t.Fatalf("expected LastCheck set for %s", info.Name)
+		}
+		if wantReachable := info.Name == "up"; info.Reachable != wantReachable {
+			t.Fatalf("cluster %s: reachable=%v, err=%v", info.Name, info.Reachable, info.LastError)
+		}
+	}
+
+	var called []string
+	report, err := pool.FanOut(context.Background(), nil,
+		func(ctx context.Context, name string, rest *UntypedVMSRest) (core.RecordSet, error) {
+			called = append(called, name)
+			return core.RecordSet{}, nil
+		})
+	if !errors.Is(err, ErrClusterUnreachable) || !errors.Is(report.Errors["down"], ErrClusterUnreachable) {
+		t.Fatalf("expected down to be skipped as unreachable, got %v", err)
+	}
+	if len(called) != 1 || called[0] != "up" {
+		t.Fatalf("expected only up to be called, got %v", called)
+	}
+
+	pool.HealthCheckFn = func(context.Context, *UntypedVMSRest) error { return nil }
+	pool.HealthCheck(context.Background(), MatchNames("down"))
+	if info := pool.Clusters(MatchNames("down")); !info[0].Reachable || info[0].LastError != nil {
+		t.Fatalf("expected down to be reachable again: %+v", info[0])
+	}
+}
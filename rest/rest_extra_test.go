@@ -1,11 +1,13 @@
 package rest
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/vast-data/go-vast-client/core"
+	"github.com/vast-data/go-vast-client/resources/typed"
 )
 
 func TestUntypedVMSRest_Accessors(t *testing.T) {
@@ -77,3 +79,92 @@ func TestNewTypedVMSRest_InvalidConfig(t *testing.T) {
 		t.Fatal("expected error for invalid config")
 	}
 }
+
+func TestTypedVMSRest_QuotaUpdate(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		patchReply string
+	}{
+		{name: "record returned", patchReply: `{"id": 5, "name": "q1", "hard_limit": 2048}`},
+		{name: "record read back", patchReply: `{}`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var patchBody map[string]any
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.Method {
+				case http.MethodPatch:
+					if err := json.NewDecoder(r.Body).Decode(&patchBody); err != nil {
+						t.Errorf("decode body: %v", err)
+					}
+					_, _ = w.Write([]byte(tc.patchReply))
+				case http.MethodGet:
+					_, _ = w.Write([]byte(`{"id": 5, "name": "q1", "hard_limit": 2048}`))
+				default:
+					t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			rest, err := NewTypedVMSRest(testVMSConfig(t, server))
+			if err != nil {
+				t.Fatalf("NewTypedVMSRest: %v", err)
+			}
+			quota, err := rest.Quotas.Update(5, &typed.QuotaUpdateBody{
+				HardLimit: 2048,
+				RawData:   core.Params{"enable_alarms": false},
+			})
+			if err != nil {
+				t.Fatalf("Update: %v", err)
+			}
+			if quota.Id != 5 || quota.HardLimit != 2048 {
+				t.Fatalf("unexpected quota: %+v", quota)
+			}
+			if patchBody["hard_limit"] != float64(2048) || patchBody["enable_alarms"] != false {
+				t.Fatalf("unexpected PATCH body: %v", patchBody)
+			}
+			if _, ok := patchBody["name"]; ok {
+				t.Fatalf("expected empty typed fields to be omitted: %v", patchBody)
+			}
+		})
+	}
+}
+
+func TestTypedVMSRest_UpdateFromSpec(t *testing.T) {
+	var patched []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			patched = append(patched, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	rest, err := NewTypedVMSRest(testVMSConfig(t, server))
+	if err != nil {
+		t.Fatalf("NewTypedVMSRest: %v", err)
+	}
+	// These resources have no regular update operation but declare PATCH /{resource}/{id}/.
+	updates := []func() error{
+		func() error { _, err := rest.VTasks.Update(1, &typed.VTaskUpdateBody{}); return err },
+		func() error {
+			_, err := rest.EventDefinitions.Update(1, &typed.EventDefinitionUpdateBody{})
+			return err
+		},
+		func() error {
+			_, err := rest.EventDefinitionConfigs.Update(1, &typed.EventDefinitionConfigUpdateBody{})
+			return err
+		},
+		func() error { _, err := rest.CallhomeConfigs.Update(1, &typed.CallhomeConfigsUpdateBody{}); return err },
+		func() error { _, err := rest.Vmses.Update(1, &typed.VmsUpdateBody{}); return err },
+	}
+	for _, update := range updates {
+		if err := update(); err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+	}
+	if len(patched) != len(updates) {
+		t.Fatalf("expected one PATCH per resource, got %v", patched)
+	}
+}
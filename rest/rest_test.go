@@ -209,3 +209,73 @@ func TestNewTypedVMSRest_WiresResources(t *testing.T) {
 		t.Fatal("typed String() returned empty value")
 	}
 }
+
+func TestUntypedVMSRest_RelationTargetsRegistered(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rest, err := NewUntypedVMSRest(testVMSConfig(t, server))
+	if err != nil {
+		t.Fatalf("NewUntypedVMSRest: %v", err)
+	}
+	if _, ok := core.GetRelation("View", "policy"); !ok {
+		t.Fatal("expected View policy relation from +apirelation marker")
+	}
+	for resourceType, relations := range core.RelationRegistry {
+		if _, ok := rest.resourceMap[resourceType]; !ok {
+			t.Errorf("relation source %q not registered in resource map", resourceType)
+		}
+		for _, relation := range relations {
+			if _, ok := rest.resourceMap[relation.ResourceType]; !ok {
+				t.Errorf("%s.%s targets unregistered resource %q", resourceType, relation.Name, relation.ResourceType)
+			}
+		}
+	}
+}
+
+func TestUntypedVMSRest_Resolve(t *testing.T) {
+	var paths []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path+"?"+r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(r.URL.Path, "/tenants/"):
+			_, _ = w.Write([]byte(`[{"id": 5, "name": "t5"}]`))
+		case strings.Contains(r.URL.Path, "/viewpolicies/"):
+			_, _ = w.Write([]byte(`[{"id": 7, "name": "default"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	rest, err := NewUntypedVMSRest(testVMSConfig(t, server))
+	if err != nil {
+		t.Fatalf("NewUntypedVMSRest: %v", err)
+	}
+
+	view := core.Record{"id": 1, "tenant_id": 5, "policy_id": 7}
+	if err := rest.Resolve(context.Background(), view, "tenant"); err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if got := view.Related("tenant").RecordName(); got != "t5" {
+		t.Errorf("tenant = %q, want t5", got)
+	}
+	if err := rest.Resolve(context.Background(), view, "owner"); err == nil {
+		t.Error("expected unknown relation to be rejected")
+	}
+	if err := rest.Views.Resolve(core.RecordSet{view}, "policy"); err != nil {
+		t.Fatalf("Views.Resolve: %v", err)
+	}
+	if got := view.Related("policy").RecordName(); got != "default" {
+		t.Errorf("policy = %q, want default", got)
+	}
+	if view.Related("tenant") == nil {
+		t.Error("expected earlier resolved relation to be kept")
+	}
+	if len(paths) != 2 || !strings.Contains(paths[0], "id__in=5") || !strings.Contains(paths[1], "id__in=7") {
+		t.Errorf("unexpected requests: %v", paths)
+	}
+}
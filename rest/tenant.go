@@ -0,0 +1,30 @@
+package rest
+
+import "sync"
+
+// tenantViews caches the tenant-scoped clients derived by ForTenant.
+// It is shared by a client and all clients derived from it.
+type tenantViews[T any] struct {
+	mu    sync.Mutex
+	views map[string]T
+}
+
+func newTenantViews[T any]() *tenantViews[T] {
+	return &tenantViews[T]{views: map[string]T{}}
+}
+
+// get returns the client of tenant, creating it with create on first use.
+// Failed creations are not cached.
+func (v *tenantViews[T]) get(tenant string, create func() (T, error)) (T, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if view, ok := v.views[tenant]; ok {
+		return view, nil
+	}
+	view, err := create()
+	if err != nil {
+		return view, err
+	}
+	v.views[tenant] = view
+	return view, nil
+}
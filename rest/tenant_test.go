@@ -0,0 +1,146 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/vast-data/go-vast-client/core"
+)
+
+func mustUntypedTenant(t *testing.T, rest *UntypedVMSRest, name string) *UntypedVMSRest {
+	t.Helper()
+	tenantRest, err := rest.ForTenant(name)
+	if err != nil {
+		t.Fatalf("ForTenant(%q): %v", name, err)
+	}
+	return tenantRest
+}
+
+func TestUntypedVMSRest_ForTenant(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		tenants []string
+	)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tenants = append(tenants, r.Header.Get("X-Tenant-Name"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	rest, err := NewUntypedVMSRest(testVMSConfig(t, server))
+	if err != nil {
+		t.Fatalf("NewUntypedVMSRest: %v", err)
+	}
+	tenantA := mustUntypedTenant(t, rest, "tenant-a")
+	if tenantA == rest || mustUntypedTenant(t, rest, "tenant-a") != tenantA {
+		t.Fatal("expected one cached client per tenant")
+	}
+	if mustUntypedTenant(t, tenantA, "tenant-b") != mustUntypedTenant(t, rest, "tenant-b") {
+		t.Fatal("expected tenant clients to share the cache")
+	}
+	if got := tenantA.String(); got != rest.Session.GetConfig().Host+" [type=api-token;tenant=tenant-a]" {
+		t.Fatalf("unexpected identity: %s", got)
+	}
+	if tenantA.Views.Rest != tenantA {
+		t.Fatal("expected tenant resources to point back to the tenant client")
+	}
+
+	if _, err := tenantA.Views.List(nil); err != nil {
+		t.Fatalf("tenant List: %v", err)
+	}
+	if _, err := rest.Views.List(nil); err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	if len(tenants) != 2 || tenants[0] != "tenant-a" || tenants[1] != "" {
+		t.Fatalf("unexpected tenant headers: %q", tenants)
+	}
+}
+
+func TestUntypedVMSRest_ForTenantMiddlewares(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	var calls []string
+	record := func(name string) core.Middleware {
+		return func(ctx context.Context, req *core.MiddlewareRequest, next core.Handler) (core.Renderable, error) {
+			calls = append(calls, name)
+			return next(ctx, req)
+		}
+	}
+	rest, err := NewUntypedVMSRest(testVMSConfig(t, server))
+	if err != nil {
+		t.Fatalf("NewUntypedVMSRest: %v", err)
+	}
+	rest.Views.Use(record("views"))
+	tenant := mustUntypedTenant(t, rest, "tenant-a")
+	rest.Use(record("client"))
+
+	if _, err := tenant.Views.GetById(1); err != nil {
+		t.Fatalf("tenant GetById: %v", err)
+	}
+	if len(calls) != 2 || calls[0] != "client" || calls[1] != "views" {
+		t.Fatalf("expected client and resource middlewares on the tenant client, got %v", calls)
+	}
+}
+
+func TestUntypedVMSRest_ForTenantErrors(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	defer server.Close()
+
+	rest, err := NewUntypedVMSRest(testVMSConfig(t, server))
+	if err != nil {
+		t.Fatalf("NewUntypedVMSRest: %v", err)
+	}
+	custom := newUntypedVMSRest(sessionWrapper{rest.Session}, context.Background())
+	if _, err := custom.ForTenant("tenant-a"); err == nil {
+		t.Fatal("expected an error for sessions other than *core.VMSSession")
+	}
+
+	rest.Session.GetConfig().ApiToken = ""
+	if _, err := rest.ForTenant("tenant-a"); err == nil {
+		t.Fatal("expected an error when the tenant authenticator cannot be created")
+	}
+	rest.Session.GetConfig().ApiToken = "test-token"
+	if _, err := rest.ForTenant("tenant-a"); err != nil {
+		t.Fatalf("failed creations must not be cached: %v", err)
+	}
+}
+
+// sessionWrapper is a core.RESTSession that is not a *core.VMSSession.
+type sessionWrapper struct {
+	core.RESTSession
+}
+
+func TestTypedVMSRest_ForTenant(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	rest, err := NewTypedVMSRest(testVMSConfig(t, server))
+	if err != nil {
+		t.Fatalf("NewTypedVMSRest: %v", err)
+	}
+	tenant, err := rest.ForTenant("tenant-a")
+	if err != nil {
+		t.Fatalf("ForTenant: %v", err)
+	}
+	if again, _ := rest.ForTenant("tenant-a"); again != tenant {
+		t.Fatal("expected one cached typed client per tenant")
+	}
+	if tenant.Untyped != mustUntypedTenant(t, rest.Untyped, "tenant-a") {
+		t.Fatal("expected the typed tenant client to wrap the untyped tenant client")
+	}
+	if tenant.Views == nil || tenant.GetSession().GetConfig().Tenant != "tenant-a" {
+		t.Fatal("expected tenant-scoped typed resources")
+	}
+}
@@ -18,6 +18,7 @@ type TypedVastResourceType interface {
 
 type TypedVMSRest struct {
 	Untyped *UntypedVMSRest
+	tenants *tenantViews[*TypedVMSRest] // Tenant-scoped clients derived by ForTenant
 
 	ActiveDirectories        *typed.ActiveDirectory
 	Alarms                   *typed.Alarm
@@ -144,16 +145,14 @@ func NewTypedVMSRest(config *core.VMSConfig) (*TypedVMSRest, error) {
 	if err != nil {
 		return nil, err
 	}
+	return newTypedVMSRest(untyped), nil
+}
 
+// newTypedVMSRest creates the typed client and its resources on top of untyped.
+func newTypedVMSRest(untyped *UntypedVMSRest) *TypedVMSRest {
 	rest := &TypedVMSRest{
 		Untyped: untyped,
-	}
-
-	// Set context: use provided context or default to background context
-	if config.Context != nil {
-		rest.SetCtx(config.Context)
-	} else {
-		rest.SetCtx(context.Background())
+		tenants: newTenantViews[*TypedVMSRest](),
 	}
 
 	rest.ActiveDirectories = newTypedResource[typed.ActiveDirectory](rest)
@@ -275,7 +274,7 @@ func NewTypedVMSRest(config *core.VMSConfig) (*TypedVMSRest, error) {
 	rest.TlsCertificates = newTypedResource[typed.TlsCertificate](rest)
 	rest.VastdbTables = newTypedResource[typed.VastdbTable](rest)
 
-	return rest, nil
+	return rest
 }
 
 func (rest *TypedVMSRest) GetSession() core.RESTSession {
@@ -343,3 +342,31 @@ func newTypedResource[T TypedVastResourceType](rest *TypedVMSRest) *T {
 	}
 	panic(fmt.Sprintf("Failed to convert instance to type *%s", resourceType))
 }
+
+// Invoke calls the extra method methodName of resourceType by name (see UntypedVMSRest.Invoke).
+func (rest *TypedVMSRest) Invoke(ctx context.Context, resourceType, methodName string, args core.InvokeArgs) (core.Renderable, *core.AsyncResult, error) {
+	return core.InvokeExtraMethod(ctx, rest, resourceType, methodName, args)
+}
+
+// Raw returns a client for endpoints not covered by the typed resources (see UntypedVMSRest.Raw).
+func (rest *TypedVMSRest) Raw() *core.RawClient {
+	return core.NewRawClient(rest)
+}
+
+// Use registers client middlewares wrapping every request (see UntypedVMSRest.Use).
+func (rest *TypedVMSRest) Use(middlewares ...core.Middleware) {
+	rest.Untyped.Use(middlewares...)
+}
+
+// ForTenant returns a typed client acting on behalf of the named tenant (see UntypedVMSRest.ForTenant).
+func (rest *TypedVMSRest) ForTenant(name string) (*TypedVMSRest, error) {
+	return rest.tenants.get(name, func() (*TypedVMSRest, error) {
+		untyped, err := rest.Untyped.ForTenant(name)
+		if err != nil {
+			return nil, err
+		}
+		tenantRest := newTypedVMSRest(untyped)
+		tenantRest.tenants = rest.tenants
+		return tenantRest, nil
+	})
+}
@@ -30,6 +30,7 @@ type UntypedVMSRest struct {
 	ctx         context.Context
 	Session     core.RESTSession
 	resourceMap map[string]core.VastResourceAPIWithContext // Map to store resources by resourceType
+	tenants     *tenantViews[*UntypedVMSRest]              // Tenant-scoped clients derived by ForTenant
 
 	ActiveDirectories        *untyped.ActiveDirectory
 	Alarms                   *untyped.Alarm
@@ -129,12 +130,13 @@ type UntypedVMSRest struct {
 	Tables                   *untyped.Table
 	Tenants                  *untyped.Tenant
 	// +apiall:extraMethod:GET|POST|PATCH=/topics/
-	Topics              *untyped.Topic
-	Users               *untyped.User
-	UserQuotas          *untyped.UserQuota
-	VastAuditLogs       *untyped.VastAuditLog
-	VastDb              *untyped.VastDb
-	Versions            *untyped.Version
+	Topics        *untyped.Topic
+	Users         *untyped.User
+	UserQuotas    *untyped.UserQuota
+	VastAuditLogs *untyped.VastAuditLog
+	VastDb        *untyped.VastDb
+	Versions      *untyped.Version
+	// +apirelation:policy=ViewPolicy
 	Views               *untyped.View
 	ViewPolicies        *untyped.ViewPolicy
 	Vips                *untyped.Vip
@@ -175,17 +177,22 @@ func NewUntypedVMSRest(config *core.VMSConfig) (*UntypedVMSRest, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Set context: use provided context or default to background context
+	ctx := config.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return newUntypedVMSRest(session, ctx), nil
+}
+
+// newUntypedVMSRest creates the client and its resources on top of session.
+func newUntypedVMSRest(session core.RESTSession, ctx context.Context) *UntypedVMSRest {
 	rest := &UntypedVMSRest{
 		Session:     session,
 		resourceMap: make(map[string]core.VastResourceAPIWithContext),
+		tenants:     newTenantViews[*UntypedVMSRest](),
 	}
-
-	// Set context: use provided context or default to background context
-	if config.Context != nil {
-		rest.SetCtx(config.Context)
-	} else {
-		rest.SetCtx(context.Background())
-	}
+	rest.SetCtx(ctx)
 
 	// Fill in each resource, pointing back to the same rest
 	rest.ActiveDirectories = newUntypedResource[untyped.ActiveDirectory](rest, "activedirectory", C, L, R, U, D)
@@ -307,7 +314,7 @@ func NewUntypedVMSRest(config *core.VMSConfig) (*UntypedVMSRest, error) {
 	rest.TlsCertificates = newUntypedResource[untyped.TlsCertificate](rest, "tlscertificates", C, L, R, U, D)
 	rest.VastdbTables = newUntypedResource[untyped.VastdbTable](rest, "vastdbtable")
 
-	return rest, nil
+	return rest
 }
 
 func (rest *UntypedVMSRest) GetSession() core.RESTSession {
@@ -326,6 +333,24 @@ func (rest *UntypedVMSRest) SetCtx(ctx context.Context) {
 	rest.ctx = ctx
 }
 
+// Resolve fetches the objects referenced by record through the named relations
+// and attaches them under core.RelatedKey:
+//
+//	view, _ := rest.Views.GetById(1)
+//	err := rest.Resolve(ctx, view, "tenant", "qos_policy")
+//	tenantName := view.Related("tenant").RecordName()
+//
+// Relation names must be unambiguous across resource types; use the resource's
+// Resolve method (e.g. rest.Views.Resolve) when a name references different types on different resources.
+func (rest *UntypedVMSRest) Resolve(ctx context.Context, record core.Record, relations ...string) error {
+	return core.ResolveRelations(ctx, rest, "", core.RecordSet{record}, relations...)
+}
+
+// ResolveAll is like Resolve but batches the lookups for all records.
+func (rest *UntypedVMSRest) ResolveAll(ctx context.Context, records core.RecordSet, relations ...string) error {
+	return core.ResolveRelations(ctx, rest, "", records, relations...)
+}
+
 // String returns a log-friendly identity of this client: VMS host and auth mode.
 // Examples: "10.0.0.1 [type=api-token]", "vms.example.com [type=bearer-token;user=admin;tenant=foo]"
 func (rest *UntypedVMSRest) String() string {
@@ -397,3 +422,72 @@ func newUntypedResource[T UntypedVastResourceType](rest *UntypedVMSRest, resourc
 	}
 	panic(fmt.Sprintf("Failed to convert instance to type *%s", resourceType))
 }
+
+// Invoke calls the extra method methodName of resourceType by name, resolving its URL
+// template and verb from the extra-method registry (see core.InvokeExtraMethod).
+// It lets scripting layers and CLIs call any generated endpoint without reflection:
+//
+//	response, task, err := rest.Invoke(ctx, "View", "ViewLegalHold_PATCH", core.InvokeArgs{
+//	    PathParams: core.Params{"id": 5},
+//	    Body:       core.Params{"legal_hold_path": "/data"},
+//	})
+func (rest *UntypedVMSRest) Invoke(ctx context.Context, resourceType, methodName string, args core.InvokeArgs) (core.Renderable, *core.AsyncResult, error) {
+	return core.InvokeExtraMethod(ctx, rest, resourceType, methodName, args)
+}
+
+// Raw returns a client for endpoints not covered by the generated resources.
+// Requests go through the same URL building, auth, retries, interceptors, logging
+// and async task detection as resource methods:
+//
+//	response, task, err := rest.Raw().Do(ctx, http.MethodPost, "/clusters/{id}/some_action/",
+//	    nil, core.Params{"force": true}, &core.RawOptions{PathParams: core.Params{"id": 1}})
+//
+// Use core.RawDo to decode the response into a Go type.
+func (rest *UntypedVMSRest) Raw() *core.RawClient {
+	return core.NewRawClient(rest)
+}
+
+// Use registers client middlewares wrapping every request (see core.Middleware).
+// Use rest.<Resource>.Use to register middlewares for a single resource type.
+// Register middlewares before sending requests; Use is not safe for concurrent use with requests.
+func (rest *UntypedVMSRest) Use(middlewares ...core.Middleware) {
+	config := rest.Session.GetConfig()
+	config.Middlewares = append(config.Middlewares, middlewares...)
+}
+
+// ForTenant returns a client acting on behalf of the named tenant. The client shares the HTTP
+// transport (connection pool and MaxConnections limit), the client middlewares and the context
+// of rest, but authenticates with a tenant-scoped identity: a JWT token acquired for the tenant,
+// or the X-Tenant-Name header for API token and basic auth.
+//
+//	tenantRest, err := rest.ForTenant("tenant-a")
+//	views, err := tenantRest.Views.List(nil)
+//
+// Tenant clients are created once per tenant and reused; ForTenant on a tenant client returns
+// the client of the other tenant. Client middlewares of rest apply to tenant clients, including
+// those registered later with rest.Use. Per-resource middlewares (rest.Views.Use) are copied
+// when the tenant client is created.
+func (rest *UntypedVMSRest) ForTenant(name string) (*UntypedVMSRest, error) {
+	return rest.tenants.get(name, func() (*UntypedVMSRest, error) {
+		session, ok := rest.Session.(*core.VMSSession)
+		if !ok {
+			return nil, fmt.Errorf("tenant clients require a *core.VMSSession, got %T", rest.Session)
+		}
+		tenantSession, err := session.ForTenant(name)
+		if err != nil {
+			return nil, err
+		}
+		tenantRest := newUntypedVMSRest(tenantSession, rest.ctx)
+		tenantRest.tenants = rest.tenants
+		for resourceType, resource := range rest.resourceMap {
+			source, ok := resource.(interface{ Middlewares() []core.Middleware })
+			if !ok {
+				continue
+			}
+			if middlewares := source.Middlewares(); len(middlewares) > 0 {
+				tenantRest.resourceMap[resourceType].(interface{ Use(...core.Middleware) }).Use(middlewares...)
+			}
+		}
+		return tenantRest, nil
+	})
+}